@@ -0,0 +1,60 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	"github.com/cosmos-builders/chaos/x/chaos"
+	chaoskeeper "github.com/cosmos-builders/chaos/x/chaos/keeper"
+)
+
+// HandlerOptions extends the SDK's AnteHandler options by requiring the chaos
+// keeper.
+type HandlerOptions struct {
+	ante.HandlerOptions
+
+	ChaosKeeper *chaoskeeper.Keeper
+}
+
+// NewAnteHandler returns the SDK AnteHandler followed by the chaos
+// decorators: the decorator failing the messages of the scheduled faults and
+// the decorator applying the tx fault rules of the chaos parameters. As for
+// any AnteHandler error, the fees of the transactions failed by a scheduled
+// fault are not deducted.
+func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
+	if options.AccountKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "account keeper is required for ante builder")
+	}
+
+	if options.BankKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "bank keeper is required for ante builder")
+	}
+
+	if options.SignModeHandler == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
+	}
+
+	if options.ChaosKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "chaos keeper is required for ante builder")
+	}
+
+	anteDecorators := []sdk.AnteDecorator{
+		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+		ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
+		ante.NewValidateBasicDecorator(),
+		ante.NewTxTimeoutHeightDecorator(),
+		ante.NewValidateMemoDecorator(options.AccountKeeper),
+		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
+		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
+		ante.NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
+		ante.NewValidateSigCountDecorator(options.AccountKeeper),
+		ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
+		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
+		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
+		chaos.NewFailMsgDecorator(*options.ChaosKeeper),
+		chaos.NewTxFaultDecorator(*options.ChaosKeeper),
+	}
+
+	return sdk.ChainAnteDecorators(anteDecorators...), nil
+}
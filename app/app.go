@@ -66,6 +66,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/mint"
 	mintkeeper "github.com/cosmos/cosmos-sdk/x/mint/keeper"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	nftkeeper "github.com/cosmos/cosmos-sdk/x/nft/keeper"
+	nftmodule "github.com/cosmos/cosmos-sdk/x/nft/module"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	paramsclient "github.com/cosmos/cosmos-sdk/x/params/client"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
@@ -82,12 +85,16 @@ import (
 	upgradekeeper "github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	ica "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts"
+	icacontroller "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/controller"
 	icacontrollerkeeper "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/controller/keeper"
 	icacontrollertypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/controller/types"
 	icahost "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/host"
 	icahostkeeper "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/host/keeper"
 	icahosttypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/types"
+	ibcfee "github.com/cosmos/ibc-go/v5/modules/apps/29-fee"
+	ibcfeekeeper "github.com/cosmos/ibc-go/v5/modules/apps/29-fee/keeper"
+	ibcfeetypes "github.com/cosmos/ibc-go/v5/modules/apps/29-fee/types"
 	"github.com/cosmos/ibc-go/v5/modules/apps/transfer"
 	ibctransferkeeper "github.com/cosmos/ibc-go/v5/modules/apps/transfer/keeper"
 	ibctransfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
@@ -98,6 +105,7 @@ import (
 	ibcporttypes "github.com/cosmos/ibc-go/v5/modules/core/05-port/types"
 	ibchost "github.com/cosmos/ibc-go/v5/modules/core/24-host"
 	ibckeeper "github.com/cosmos/ibc-go/v5/modules/core/keeper"
+	ibctestingtypes "github.com/cosmos/ibc-go/v5/testing/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmjson "github.com/tendermint/tendermint/libs/json"
 	"github.com/tendermint/tendermint/libs/log"
@@ -106,6 +114,38 @@ import (
 
 	appparams "github.com/cosmos-builders/chaos/app/params"
 	"github.com/cosmos-builders/chaos/docs"
+	"github.com/cosmos-builders/chaos/x/chaos"
+	chaosclient "github.com/cosmos-builders/chaos/x/chaos/client"
+	chaoskeeper "github.com/cosmos-builders/chaos/x/chaos/keeper"
+	chaostypes "github.com/cosmos-builders/chaos/x/chaos/types"
+	"github.com/cosmos-builders/chaos/x/ibcfault"
+	ibcfaultclient "github.com/cosmos-builders/chaos/x/ibcfault/client"
+	ibcfaultkeeper "github.com/cosmos-builders/chaos/x/ibcfault/keeper"
+	ibcfaulttypes "github.com/cosmos-builders/chaos/x/ibcfault/types"
+	"github.com/cosmos-builders/chaos/x/ibchooks"
+	ibchookskeeper "github.com/cosmos-builders/chaos/x/ibchooks/keeper"
+	ibchookstypes "github.com/cosmos-builders/chaos/x/ibchooks/types"
+	"github.com/cosmos-builders/chaos/x/icaallowlist"
+	icaallowlistclient "github.com/cosmos-builders/chaos/x/icaallowlist/client"
+	icaallowlistkeeper "github.com/cosmos-builders/chaos/x/icaallowlist/keeper"
+	icaallowlisttypes "github.com/cosmos-builders/chaos/x/icaallowlist/types"
+	"github.com/cosmos-builders/chaos/x/icqhost"
+	icqhostclient "github.com/cosmos-builders/chaos/x/icqhost/client"
+	icqhostkeeper "github.com/cosmos-builders/chaos/x/icqhost/keeper"
+	icqhosttypes "github.com/cosmos-builders/chaos/x/icqhost/types"
+	"github.com/cosmos-builders/chaos/x/intertx"
+	intertxkeeper "github.com/cosmos-builders/chaos/x/intertx/keeper"
+	intertxtypes "github.com/cosmos-builders/chaos/x/intertx/types"
+	"github.com/cosmos-builders/chaos/x/nfttransfer"
+	nfttransferkeeper "github.com/cosmos-builders/chaos/x/nfttransfer/keeper"
+	nfttransfertypes "github.com/cosmos-builders/chaos/x/nfttransfer/types"
+	"github.com/cosmos-builders/chaos/x/packetforward"
+	packetforwardkeeper "github.com/cosmos-builders/chaos/x/packetforward/keeper"
+	packetforwardtypes "github.com/cosmos-builders/chaos/x/packetforward/types"
+	"github.com/cosmos-builders/chaos/x/ratelimit"
+	ratelimitclient "github.com/cosmos-builders/chaos/x/ratelimit/client"
+	ratelimitkeeper "github.com/cosmos-builders/chaos/x/ratelimit/keeper"
+	ratelimittypes "github.com/cosmos-builders/chaos/x/ratelimit/types"
 )
 
 const (
@@ -123,6 +163,19 @@ func getGovProposalHandlers() []govclient.ProposalHandler {
 		upgradeclient.LegacyCancelProposalHandler,
 		ibcclientclient.UpdateClientProposalHandler,
 		ibcclientclient.UpgradeProposalHandler,
+		ratelimitclient.AddRateLimitProposalHandler,
+		ratelimitclient.UpdateRateLimitProposalHandler,
+		ratelimitclient.RemoveRateLimitProposalHandler,
+		ratelimitclient.ResetRateLimitProposalHandler,
+		icaallowlistclient.AddAllowedMessagesProposalHandler,
+		icaallowlistclient.RemoveAllowedMessagesProposalHandler,
+		icqhostclient.AddAllowedQueriesProposalHandler,
+		icqhostclient.RemoveAllowedQueriesProposalHandler,
+		ibcfaultclient.AddFaultRuleProposalHandler,
+		ibcfaultclient.RemoveFaultRuleProposalHandler,
+		chaosclient.ScheduleFaultProposalHandler,
+		chaosclient.CancelFaultProposalHandler,
+		chaosclient.KillSwitchProposalHandler,
 	)
 
 	return govProposalHandlers
@@ -150,11 +203,22 @@ var (
 		slashing.AppModuleBasic{},
 		feegrantmodule.AppModuleBasic{},
 		groupmodule.AppModuleBasic{},
+		nftmodule.AppModuleBasic{},
 		ibc.AppModuleBasic{},
 		upgrade.AppModuleBasic{},
 		evidence.AppModuleBasic{},
 		transfer.AppModuleBasic{},
-		ica.AppModuleBasic{},
+		icaAppModuleBasic{},
+		ibcfee.AppModuleBasic{},
+		intertx.AppModuleBasic{},
+		packetforward.AppModuleBasic{},
+		ratelimit.AppModuleBasic{},
+		ibchooks.AppModuleBasic{},
+		icaallowlist.AppModuleBasic{},
+		icqhost.AppModuleBasic{},
+		ibcfault.AppModuleBasic{},
+		nfttransfer.AppModuleBasic{},
+		chaos.AppModuleBasic{},
 		vesting.AppModuleBasic{},
 	)
 
@@ -168,6 +232,8 @@ var (
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:            {authtypes.Burner},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
+		ibcfeetypes.ModuleName:         nil,
+		nft.ModuleName:                 nil,
 	}
 )
 
@@ -194,6 +260,7 @@ type App struct {
 	cdc               *codec.LegacyAmino
 	appCodec          codec.Codec
 	interfaceRegistry types.InterfaceRegistry
+	txConfig          client.TxConfig
 
 	invCheckPeriod uint
 
@@ -203,29 +270,45 @@ type App struct {
 	memKeys map[string]*storetypes.MemoryStoreKey
 
 	// keepers
-	AccountKeeper    authkeeper.AccountKeeper
-	AuthzKeeper      authzkeeper.Keeper
-	BankKeeper       bankkeeper.Keeper
-	CapabilityKeeper *capabilitykeeper.Keeper
-	StakingKeeper    stakingkeeper.Keeper
-	SlashingKeeper   slashingkeeper.Keeper
-	MintKeeper       mintkeeper.Keeper
-	DistrKeeper      distrkeeper.Keeper
-	GovKeeper        govkeeper.Keeper
-	CrisisKeeper     crisiskeeper.Keeper
-	UpgradeKeeper    upgradekeeper.Keeper
-	ParamsKeeper     paramskeeper.Keeper
-	IBCKeeper        *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
-	EvidenceKeeper   evidencekeeper.Keeper
-	TransferKeeper   ibctransferkeeper.Keeper
-	ICAHostKeeper    icahostkeeper.Keeper
-	FeeGrantKeeper   feegrantkeeper.Keeper
-	GroupKeeper      groupkeeper.Keeper
+	AccountKeeper       authkeeper.AccountKeeper
+	AuthzKeeper         authzkeeper.Keeper
+	BankKeeper          bankkeeper.Keeper
+	CapabilityKeeper    *capabilitykeeper.Keeper
+	StakingKeeper       stakingkeeper.Keeper
+	SlashingKeeper      slashingkeeper.Keeper
+	MintKeeper          mintkeeper.Keeper
+	DistrKeeper         distrkeeper.Keeper
+	GovKeeper           govkeeper.Keeper
+	CrisisKeeper        crisiskeeper.Keeper
+	UpgradeKeeper       upgradekeeper.Keeper
+	ParamsKeeper        paramskeeper.Keeper
+	IBCKeeper           *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
+	EvidenceKeeper      evidencekeeper.Keeper
+	TransferKeeper      ibctransferkeeper.Keeper
+	IBCFeeKeeper        ibcfeekeeper.Keeper
+	ICAHostKeeper       icahostkeeper.Keeper
+	ICAControllerKeeper icacontrollerkeeper.Keeper
+	InterTxKeeper       intertxkeeper.Keeper
+	PacketForwardKeeper packetforwardkeeper.Keeper
+	RateLimitKeeper     ratelimitkeeper.Keeper
+	IBCHooksKeeper      ibchookskeeper.Keeper
+	ICAAllowlistKeeper  icaallowlistkeeper.Keeper
+	ICQHostKeeper       icqhostkeeper.Keeper
+	IBCFaultKeeper      ibcfaultkeeper.Keeper
+	NFTTransferKeeper   nfttransferkeeper.Keeper
+	ChaosKeeper         chaoskeeper.Keeper
+	FeeGrantKeeper      feegrantkeeper.Keeper
+	GroupKeeper         groupkeeper.Keeper
+	NFTKeeper           nftkeeper.Keeper
 
 	// make scoped keepers public for test purposes
-	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
-	ScopedTransferKeeper capabilitykeeper.ScopedKeeper
-	ScopedICAHostKeeper  capabilitykeeper.ScopedKeeper
+	ScopedIBCKeeper           capabilitykeeper.ScopedKeeper
+	ScopedTransferKeeper      capabilitykeeper.ScopedKeeper
+	ScopedICAHostKeeper       capabilitykeeper.ScopedKeeper
+	ScopedICAControllerKeeper capabilitykeeper.ScopedKeeper
+	ScopedInterTxKeeper       capabilitykeeper.ScopedKeeper
+	ScopedICQHostKeeper       capabilitykeeper.ScopedKeeper
+	ScopedNFTTransferKeeper   capabilitykeeper.ScopedKeeper
 
 	// mm is the module manager
 	mm *module.Manager
@@ -267,8 +350,11 @@ func New(
 		authtypes.StoreKey, authz.ModuleName, banktypes.StoreKey, stakingtypes.StoreKey,
 		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey, govtypes.StoreKey,
 		paramstypes.StoreKey, ibchost.StoreKey, upgradetypes.StoreKey, feegrant.StoreKey, evidencetypes.StoreKey,
+		nftkeeper.StoreKey,
 		ibctransfertypes.StoreKey, icahosttypes.StoreKey, capabilitytypes.StoreKey, group.StoreKey,
-		icacontrollertypes.StoreKey,
+		icacontrollertypes.StoreKey, ibcfeetypes.StoreKey, packetforwardtypes.StoreKey,
+		ratelimittypes.StoreKey, ibchookstypes.StoreKey, icqhosttypes.StoreKey,
+		ibcfaulttypes.StoreKey, nfttransfertypes.StoreKey, chaostypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -278,6 +364,7 @@ func New(
 		cdc:               cdc,
 		appCodec:          appCodec,
 		interfaceRegistry: interfaceRegistry,
+		txConfig:          encodingConfig.TxConfig,
 		invCheckPeriod:    invCheckPeriod,
 		keys:              keys,
 		tkeys:             tkeys,
@@ -306,6 +393,9 @@ func New(
 	scopedICAControllerKeeper := app.CapabilityKeeper.ScopeToModule(icacontrollertypes.SubModuleName)
 	scopedTransferKeeper := app.CapabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
 	scopedICAHostKeeper := app.CapabilityKeeper.ScopeToModule(icahosttypes.SubModuleName)
+	scopedInterTxKeeper := app.CapabilityKeeper.ScopeToModule(intertxtypes.ModuleName)
+	scopedICQHostKeeper := app.CapabilityKeeper.ScopeToModule(icqhosttypes.ModuleName)
+	scopedNFTTransferKeeper := app.CapabilityKeeper.ScopeToModule(nfttransfertypes.ModuleName)
 
 	// add keepers
 	app.AccountKeeper = authkeeper.NewAccountKeeper(
@@ -393,6 +483,13 @@ func New(
 		app.AccountKeeper,
 	)
 
+	app.NFTKeeper = nftkeeper.NewKeeper(
+		keys[nftkeeper.StoreKey],
+		appCodec,
+		app.AccountKeeper,
+		app.BankKeeper,
+	)
+
 	app.UpgradeKeeper = upgradekeeper.NewKeeper(
 		skipUpgradeHeights,
 		keys[upgradetypes.StoreKey],
@@ -419,12 +516,49 @@ func New(
 		scopedIBCKeeper,
 	)
 
+	// Create the ICS-29 fee keeper, it is used as the ICS4Wrapper of every
+	// application wrapped by the fee middleware below
+	app.IBCFeeKeeper = ibcfeekeeper.NewKeeper(
+		appCodec,
+		keys[ibcfeetypes.StoreKey],
+		app.GetSubspace(ibcfeetypes.ModuleName),
+		app.IBCKeeper.ChannelKeeper, // may be replaced with IBC middleware
+		app.IBCKeeper.ChannelKeeper,
+		&app.IBCKeeper.PortKeeper,
+		app.AccountKeeper,
+		app.BankKeeper,
+	)
+	ibcFeeModule := ibcfee.NewAppModule(app.IBCFeeKeeper)
+
+	// ratelimit bounds the net ICS-20 flow of the (channel, denom) pairs
+	// rate limited by governance
+	app.RateLimitKeeper = ratelimitkeeper.NewKeeper(
+		appCodec,
+		keys[ratelimittypes.StoreKey],
+		app.BankKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCFeeKeeper, // ICS4Wrapper: fee IBC middleware
+	)
+	rateLimitModule := ratelimit.NewAppModule(app.RateLimitKeeper)
+
+	// ibchooks executes the messages held in the memo of received transfers and
+	// notifies the modules registered on the callback router of the outcome of
+	// the transfers they send
+	app.IBCHooksKeeper = ibchookskeeper.NewKeeper(
+		appCodec,
+		keys[ibchookstypes.StoreKey],
+		app.MsgServiceRouter(),
+		ibchookstypes.NewCallbackRouter(),
+		app.RateLimitKeeper, // ICS4Wrapper: rate limit IBC middleware
+	)
+	ibcHooksModule := ibchooks.NewAppModule(app.IBCHooksKeeper)
+
 	// Create Transfer Keepers
 	app.TransferKeeper = ibctransferkeeper.NewKeeper(
 		appCodec,
 		keys[ibctransfertypes.StoreKey],
 		app.GetSubspace(ibctransfertypes.ModuleName),
-		app.IBCKeeper.ChannelKeeper,
+		app.IBCHooksKeeper, // ICS4Wrapper: IBC hooks middleware
 		app.IBCKeeper.ChannelKeeper,
 		&app.IBCKeeper.PortKeeper,
 		app.AccountKeeper,
@@ -432,27 +566,144 @@ func New(
 		scopedTransferKeeper,
 	)
 	transferModule := transfer.NewAppModule(app.TransferKeeper)
-	transferIBCModule := transfer.NewIBCModule(app.TransferKeeper)
+
+	// packetforward re-sends the funds of the packets received with a forwarding
+	// instruction in their memo and writes their acknowledgement asynchronously
+	app.PacketForwardKeeper = packetforwardkeeper.NewKeeper(
+		appCodec,
+		keys[packetforwardtypes.StoreKey],
+		app.TransferKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCHooksKeeper, // ICS4Wrapper: IBC hooks middleware
+		app.BankKeeper,
+	)
+	packetForwardModule := packetforward.NewAppModule(app.PacketForwardKeeper)
+
+	// ibcfault injects faults into the packets received by the transfer, ICA
+	// host, ICQ host and NFT transfer stacks. No fault is injected until a
+	// rule is set through governance or the node-local configuration. It sits
+	// below the fee middleware, which wraps the acknowledgements it returns or
+	// holds on fee enabled channels.
+	localFaultRules, err := ibcfaulttypes.ParseFaultRules(cast.ToStringSlice(appOpts.Get(ibcfaulttypes.ConfigKeyRules)))
+	if err != nil {
+		panic(fmt.Sprintf("invalid %s: %v", ibcfaulttypes.ConfigKeyRules, err))
+	}
+	if len(localFaultRules) != 0 {
+		logger.Info("IBC fault injection enabled by the node-local configuration", "rules", len(localFaultRules))
+	}
+	app.IBCFaultKeeper = ibcfaultkeeper.NewKeeper(
+		appCodec,
+		keys[ibcfaulttypes.StoreKey],
+		app.IBCFeeKeeper, // ICS4Wrapper: fee IBC middleware
+		scopedIBCKeeper,
+		localFaultRules,
+	)
+	ibcFaultModule := ibcfault.NewAppModule(app.IBCFaultKeeper)
+
+	// transfer stack contains (from top to bottom):
+	// - IBC Fee Middleware
+	// - IBC Fault Middleware
+	// - Rate Limit Middleware
+	// - IBC Hooks Middleware
+	// - Packet Forward Middleware
+	// - Transfer
+	var transferIBCModule ibcporttypes.IBCModule
+	transferIBCModule = transfer.NewIBCModule(app.TransferKeeper)
+	transferIBCModule = packetforward.NewIBCMiddleware(
+		transferIBCModule, app.PacketForwardKeeper,
+		packetforward.DefaultRetriesOnTimeout, packetforward.DefaultForwardTimeout,
+	)
+	transferIBCModule = ibchooks.NewIBCMiddleware(transferIBCModule, app.IBCHooksKeeper)
+	transferIBCModule = ratelimit.NewIBCMiddleware(transferIBCModule, app.RateLimitKeeper)
+	transferIBCModule = ibcfault.NewIBCMiddleware(transferIBCModule, app.IBCFaultKeeper)
+	transferIBCModule = ibcfee.NewIBCMiddleware(transferIBCModule, app.IBCFeeKeeper)
 
 	app.ICAHostKeeper = icahostkeeper.NewKeeper(
 		appCodec, keys[icahosttypes.StoreKey],
 		app.GetSubspace(icahosttypes.SubModuleName),
-		app.IBCKeeper.ChannelKeeper,
+		app.IBCFeeKeeper, // ICS4Wrapper: fee IBC middleware
 		app.IBCKeeper.ChannelKeeper,
 		&app.IBCKeeper.PortKeeper,
 		app.AccountKeeper,
 		scopedICAHostKeeper,
 		app.MsgServiceRouter(),
 	)
-	icaControllerKeeper := icacontrollerkeeper.NewKeeper(
+	app.ICAControllerKeeper = icacontrollerkeeper.NewKeeper(
 		appCodec, keys[icacontrollertypes.StoreKey],
 		app.GetSubspace(icacontrollertypes.SubModuleName),
-		app.IBCKeeper.ChannelKeeper, // may be replaced with middleware such as ics29 fee
+		app.IBCFeeKeeper, // ICS4Wrapper: fee IBC middleware
 		app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
 		scopedICAControllerKeeper, app.MsgServiceRouter(),
 	)
-	icaModule := ica.NewAppModule(&icaControllerKeeper, &app.ICAHostKeeper)
-	icaHostIBCModule := icahost.NewIBCModule(app.ICAHostKeeper)
+	icaModule := ica.NewAppModule(&app.ICAControllerKeeper, &app.ICAHostKeeper)
+
+	// icaallowlist manages through governance the messages interchain accounts
+	// are allowed to execute on this chain
+	app.ICAAllowlistKeeper = icaallowlistkeeper.NewKeeper(interfaceRegistry, app.ICAHostKeeper)
+	icaAllowlistModule := icaallowlist.NewAppModule(app.ICAAllowlistKeeper)
+
+	// ICA host stack contains (from top to bottom):
+	// - IBC Fee Middleware
+	// - IBC Fault Middleware
+	// - ICA Host
+	var icaHostIBCModule ibcporttypes.IBCModule
+	icaHostIBCModule = icahost.NewIBCModule(app.ICAHostKeeper)
+	icaHostIBCModule = ibcfault.NewIBCMiddleware(icaHostIBCModule, app.IBCFaultKeeper)
+	icaHostIBCModule = ibcfee.NewIBCMiddleware(icaHostIBCModule, app.IBCFeeKeeper)
+
+	// intertx is the authentication module for the ICA controller: it owns the
+	// channel capabilities and submits txs on behalf of interchain account owners
+	app.InterTxKeeper = intertxkeeper.NewKeeper(appCodec, app.ICAControllerKeeper, scopedInterTxKeeper)
+	interTxModule := intertx.NewAppModule(app.InterTxKeeper)
+
+	// ICA controller stack contains (from top to bottom):
+	// - IBC Fee Middleware
+	// - ICA Controller
+	// - intertx (authentication module)
+	var icaControllerIBCModule ibcporttypes.IBCModule
+	icaControllerIBCModule = intertx.NewIBCModule(app.InterTxKeeper)
+	icaControllerIBCModule = icacontroller.NewIBCMiddleware(icaControllerIBCModule, app.ICAControllerKeeper)
+	icaControllerIBCModule = ibcfee.NewIBCMiddleware(icaControllerIBCModule, app.IBCFeeKeeper)
+
+	// icqhost answers the interchain queries of counterparty chains with the
+	// gRPC queries registered on this chain
+	app.ICQHostKeeper = icqhostkeeper.NewKeeper(
+		appCodec, keys[icqhosttypes.StoreKey],
+		app.GetSubspace(icqhosttypes.ModuleName),
+		&app.IBCKeeper.PortKeeper,
+		scopedICQHostKeeper,
+		app.GRPCQueryRouter(),
+	)
+	icqHostModule := icqhost.NewAppModule(app.ICQHostKeeper)
+
+	// ICQ host stack contains (from top to bottom):
+	// - IBC Fault Middleware
+	// - ICQ Host
+	var icqHostIBCModule ibcporttypes.IBCModule
+	icqHostIBCModule = icqhost.NewIBCModule(app.ICQHostKeeper)
+	icqHostIBCModule = ibcfault.NewIBCMiddleware(icqHostIBCModule, app.IBCFaultKeeper)
+
+	// nfttransfer moves x/nft tokens to and from other chains following the
+	// ICS-721 protocol
+	app.NFTTransferKeeper = nfttransferkeeper.NewKeeper(
+		appCodec, keys[nfttransfertypes.StoreKey],
+		app.GetSubspace(nfttransfertypes.ModuleName),
+		app.IBCFeeKeeper, // ICS4Wrapper: fee IBC middleware
+		app.IBCKeeper.ChannelKeeper,
+		&app.IBCKeeper.PortKeeper,
+		scopedNFTTransferKeeper,
+		app.NFTKeeper,
+	)
+	nftTransferModule := nfttransfer.NewAppModule(app.NFTTransferKeeper)
+
+	// NFT transfer stack contains (from top to bottom):
+	// - IBC Fee Middleware
+	// - IBC Fault Middleware
+	// - NFT Transfer
+	var nftTransferIBCModule ibcporttypes.IBCModule
+	nftTransferIBCModule = nfttransfer.NewIBCModule(app.NFTTransferKeeper)
+	nftTransferIBCModule = ibcfault.NewIBCMiddleware(nftTransferIBCModule, app.IBCFaultKeeper)
+	nftTransferIBCModule = ibcfee.NewIBCMiddleware(nftTransferIBCModule, app.IBCFeeKeeper)
 
 	// Create evidence Keeper for to register the IBC light client misbehaviour evidence route
 	evidenceKeeper := evidencekeeper.NewKeeper(
@@ -464,13 +715,29 @@ func New(
 	// If evidence needs to be handled for the app, set routes in router here and seal
 	app.EvidenceKeeper = *evidenceKeeper
 
+	// chaos runs the block faults scheduled through governance and the tx
+	// fault rules of its parameters. No fault runs until one is scheduled or
+	// the tx faults are enabled.
+	app.ChaosKeeper = chaoskeeper.NewKeeper(
+		appCodec,
+		keys[chaostypes.StoreKey],
+		app.GetSubspace(chaostypes.ModuleName),
+		app.MsgServiceRouter(),
+	)
+	chaosModule := chaos.NewAppModule(app.ChaosKeeper)
+
 	govRouter := govv1beta1.NewRouter()
 	govRouter.
 		AddRoute(govtypes.RouterKey, govv1beta1.ProposalHandler).
 		AddRoute(paramsproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
-		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper))
+		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
+		AddRoute(ratelimittypes.RouterKey, ratelimit.NewRateLimitProposalHandler(app.RateLimitKeeper)).
+		AddRoute(icaallowlisttypes.RouterKey, icaallowlist.NewICAAllowlistProposalHandler(app.ICAAllowlistKeeper)).
+		AddRoute(icqhosttypes.RouterKey, icqhost.NewICQHostProposalHandler(app.ICQHostKeeper)).
+		AddRoute(ibcfaulttypes.RouterKey, ibcfault.NewIBCFaultProposalHandler(app.IBCFaultKeeper)).
+		AddRoute(chaostypes.RouterKey, chaos.NewChaosProposalHandler(app.ChaosKeeper))
 	govConfig := govtypes.DefaultConfig()
 	app.GovKeeper = govkeeper.NewKeeper(
 		appCodec,
@@ -489,8 +756,12 @@ func New(
 
 	// Create static IBC router, add transfer route, then set and seal it
 	ibcRouter := ibcporttypes.NewRouter()
-	ibcRouter.AddRoute(icahosttypes.SubModuleName, icaHostIBCModule).
-		AddRoute(ibctransfertypes.ModuleName, transferIBCModule)
+	ibcRouter.AddRoute(icacontrollertypes.SubModuleName, icaControllerIBCModule).
+		AddRoute(icahosttypes.SubModuleName, icaHostIBCModule).
+		AddRoute(intertxtypes.ModuleName, icaControllerIBCModule).
+		AddRoute(ibctransfertypes.ModuleName, transferIBCModule).
+		AddRoute(icqhosttypes.ModuleName, icqHostIBCModule).
+		AddRoute(nfttransfertypes.ModuleName, nftTransferIBCModule)
 	app.IBCKeeper.SetRouter(ibcRouter)
 
 	/****  Module Options ****/
@@ -514,6 +785,7 @@ func New(
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
 		groupmodule.NewAppModule(appCodec, app.GroupKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
+		nftmodule.NewAppModule(appCodec, app.NFTKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		crisis.NewAppModule(&app.CrisisKeeper, skipGenesisInvariants),
 		gov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
 		mint.NewAppModule(appCodec, app.MintKeeper, app.AccountKeeper, minttypes.DefaultInflationCalculationFn),
@@ -526,6 +798,16 @@ func New(
 		params.NewAppModule(app.ParamsKeeper),
 		transferModule,
 		icaModule,
+		ibcFeeModule,
+		interTxModule,
+		packetForwardModule,
+		rateLimitModule,
+		ibcHooksModule,
+		icaAllowlistModule,
+		icqHostModule,
+		ibcFaultModule,
+		nftTransferModule,
+		chaosModule,
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -548,9 +830,20 @@ func New(
 		ibctransfertypes.ModuleName,
 		ibchost.ModuleName,
 		icatypes.ModuleName,
+		ibcfeetypes.ModuleName,
+		intertxtypes.ModuleName,
+		packetforwardtypes.ModuleName,
+		ratelimittypes.ModuleName,
+		ibchookstypes.ModuleName,
+		icaallowlisttypes.ModuleName,
+		icqhosttypes.ModuleName,
+		ibcfaulttypes.ModuleName,
+		nfttransfertypes.ModuleName,
+		chaostypes.ModuleName,
 		genutiltypes.ModuleName,
 		authz.ModuleName,
 		feegrant.ModuleName,
+		nft.ModuleName,
 		group.ModuleName,
 		paramstypes.ModuleName,
 		vestingtypes.ModuleName,
@@ -563,6 +856,16 @@ func New(
 		ibctransfertypes.ModuleName,
 		ibchost.ModuleName,
 		icatypes.ModuleName,
+		ibcfeetypes.ModuleName,
+		intertxtypes.ModuleName,
+		packetforwardtypes.ModuleName,
+		ratelimittypes.ModuleName,
+		ibchookstypes.ModuleName,
+		icaallowlisttypes.ModuleName,
+		icqhosttypes.ModuleName,
+		ibcfaulttypes.ModuleName,
+		nfttransfertypes.ModuleName,
+		chaostypes.ModuleName,
 		capabilitytypes.ModuleName,
 		authtypes.ModuleName,
 		banktypes.ModuleName,
@@ -573,6 +876,7 @@ func New(
 		evidencetypes.ModuleName,
 		authz.ModuleName,
 		feegrant.ModuleName,
+		nft.ModuleName,
 		group.ModuleName,
 		paramstypes.ModuleName,
 		upgradetypes.ModuleName,
@@ -598,9 +902,20 @@ func New(
 		ibctransfertypes.ModuleName,
 		ibchost.ModuleName,
 		icatypes.ModuleName,
+		ibcfeetypes.ModuleName,
+		intertxtypes.ModuleName,
+		packetforwardtypes.ModuleName,
+		ratelimittypes.ModuleName,
+		ibchookstypes.ModuleName,
+		icaallowlisttypes.ModuleName,
+		icqhosttypes.ModuleName,
+		ibcfaulttypes.ModuleName,
+		nfttransfertypes.ModuleName,
+		chaostypes.ModuleName,
 		evidencetypes.ModuleName,
 		authz.ModuleName,
 		feegrant.ModuleName,
+		nft.ModuleName,
 		group.ModuleName,
 		paramstypes.ModuleName,
 		upgradetypes.ModuleName,
@@ -613,7 +928,9 @@ func New(
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
 
-	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
+	// the Msg services are registered through the chaos wrapper so that the
+	// transactions rejected by the tx fault rules fail
+	app.configurator = module.NewConfigurator(app.appCodec, chaos.NewTxFaultMsgServer(app.MsgServiceRouter()), app.GRPCQueryRouter())
 	app.mm.RegisterServices(app.configurator)
 
 	// create the simulation manager and define the order of the modules for deterministic simulations
@@ -630,9 +947,11 @@ func New(
 		slashing.NewAppModule(appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 		params.NewAppModule(app.ParamsKeeper),
 		groupmodule.NewAppModule(appCodec, app.GroupKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
+		nftmodule.NewAppModule(appCodec, app.NFTKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		evidence.NewAppModule(app.EvidenceKeeper),
 		ibc.NewAppModule(app.IBCKeeper),
 		transferModule,
+		ibcFeeModule,
 	)
 	app.sm.RegisterStoreDecoders()
 
@@ -645,13 +964,16 @@ func New(
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 
-	anteHandler, err := ante.NewAnteHandler(
-		ante.HandlerOptions{
-			AccountKeeper:   app.AccountKeeper,
-			BankKeeper:      app.BankKeeper,
-			SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
-			FeegrantKeeper:  app.FeeGrantKeeper,
-			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+	anteHandler, err := NewAnteHandler(
+		HandlerOptions{
+			HandlerOptions: ante.HandlerOptions{
+				AccountKeeper:   app.AccountKeeper,
+				BankKeeper:      app.BankKeeper,
+				SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
+				FeegrantKeeper:  app.FeeGrantKeeper,
+				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+			},
+			ChaosKeeper: &app.ChaosKeeper,
 		},
 	)
 	if err != nil {
@@ -663,14 +985,24 @@ func New(
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
 
+	app.setupUpgrades(Upgrades)
+
 	if loadLatest {
 		if err := app.LoadLatestVersion(); err != nil {
 			tmos.Exit(err.Error())
 		}
+		if err := app.setupInPlaceTestnet(homePath); err != nil {
+			tmos.Exit(err.Error())
+		}
 	}
 
 	app.ScopedIBCKeeper = scopedIBCKeeper
 	app.ScopedTransferKeeper = scopedTransferKeeper
+	app.ScopedICAHostKeeper = scopedICAHostKeeper
+	app.ScopedICAControllerKeeper = scopedICAControllerKeeper
+	app.ScopedInterTxKeeper = scopedInterTxKeeper
+	app.ScopedICQHostKeeper = scopedICQHostKeeper
+	app.ScopedNFTTransferKeeper = scopedNFTTransferKeeper
 
 	return app
 }
@@ -743,6 +1075,41 @@ func (app *App) InterfaceRegistry() types.InterfaceRegistry {
 	return app.interfaceRegistry
 }
 
+// GetBaseApp returns the BaseApp of the application.
+//
+// NOTE: This is solely to be used for testing purposes.
+func (app *App) GetBaseApp() *baseapp.BaseApp {
+	return app.BaseApp
+}
+
+// GetStakingKeeper returns the staking keeper.
+//
+// NOTE: This is solely to be used for testing purposes.
+func (app *App) GetStakingKeeper() ibctestingtypes.StakingKeeper {
+	return app.StakingKeeper
+}
+
+// GetIBCKeeper returns the IBC keeper.
+//
+// NOTE: This is solely to be used for testing purposes.
+func (app *App) GetIBCKeeper() *ibckeeper.Keeper {
+	return app.IBCKeeper
+}
+
+// GetScopedIBCKeeper returns the scoped IBC keeper.
+//
+// NOTE: This is solely to be used for testing purposes.
+func (app *App) GetScopedIBCKeeper() capabilitykeeper.ScopedKeeper {
+	return app.ScopedIBCKeeper
+}
+
+// GetTxConfig returns the TxConfig of the application.
+//
+// NOTE: This is solely to be used for testing purposes.
+func (app *App) GetTxConfig() client.TxConfig {
+	return app.txConfig
+}
+
 // GetKey returns the KVStoreKey for the provided store key.
 //
 // NOTE: This is solely to be used for testing purposes.
@@ -828,6 +1195,10 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(ibchost.ModuleName)
 	paramsKeeper.Subspace(icacontrollertypes.SubModuleName)
 	paramsKeeper.Subspace(icahosttypes.SubModuleName)
+	paramsKeeper.Subspace(ibcfeetypes.ModuleName)
+	paramsKeeper.Subspace(icqhosttypes.ModuleName)
+	paramsKeeper.Subspace(nfttransfertypes.ModuleName)
+	paramsKeeper.Subspace(chaostypes.ModuleName)
 
 	return paramsKeeper
 }
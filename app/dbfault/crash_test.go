@@ -0,0 +1,368 @@
+package dbfault_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibchost "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v5/testing/simapp/helpers"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos-builders/chaos/app"
+	"github.com/cosmos-builders/chaos/app/dbfault"
+	chaostypes "github.com/cosmos-builders/chaos/x/chaos/types"
+)
+
+const (
+	crashChainID = "crash-1"
+	// crashBlocks is the number of blocks of the chain replayed by the crash
+	// tests.
+	crashBlocks = 20
+)
+
+var genesisTime = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// consistentStores are the stores whose commit is checked after a crash.
+var consistentStores = []string{
+	authtypes.StoreKey,
+	banktypes.StoreKey,
+	stakingtypes.StoreKey,
+	distrtypes.StoreKey,
+	ibchost.StoreKey,
+	chaostypes.StoreKey,
+}
+
+// crashChain is a chain replayed by an app on a goleveldb database that
+// crashes and restarts.
+type crashChain struct {
+	t   *testing.T
+	dir string
+
+	genesis  []byte
+	proposer []byte
+	txs      [][]byte
+	// appHashes are the app hashes of the chain by height, recorded by the
+	// first replay.
+	appHashes map[int64][]byte
+}
+
+func newCrashChain(t *testing.T) *crashChain {
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	validator := tmtypes.NewValidator(pubKey, 1)
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{validator})
+
+	senderPrivKey := secp256k1.GenPrivKey()
+	acc := authtypes.NewBaseAccount(senderPrivKey.PubKey().Address().Bytes(), senderPrivKey.PubKey(), 0, 0)
+	balance := banktypes.Balance{
+		Address: acc.GetAddress().String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100000000000000))),
+	}
+
+	genesis, err := json.Marshal(app.NewGenesisStateWithValSet(t, valSet, []authtypes.GenesisAccount{acc}, balance))
+	require.NoError(t, err)
+
+	// the transactions are signed once, their memos being random
+	txConfig := app.MakeEncodingConfig().TxConfig
+	txs := make([][]byte, crashBlocks)
+	for i := range txs {
+		tx, err := helpers.GenTx(
+			txConfig,
+			[]sdk.Msg{banktypes.NewMsgSend(acc.GetAddress(), sdk.AccAddress(pubKey.Address()), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))},
+			sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)},
+			helpers.DefaultGenTxGas,
+			crashChainID,
+			[]uint64{0},
+			[]uint64{uint64(i)},
+			senderPrivKey,
+		)
+		require.NoError(t, err)
+		txs[i], err = txConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+	}
+
+	return &crashChain{
+		t:         t,
+		dir:       t.TempDir(),
+		genesis:   genesis,
+		proposer:  validator.Address,
+		txs:       txs,
+		appHashes: make(map[int64][]byte),
+	}
+}
+
+// open opens the app of the chain on a faulty database. The app is not
+// created if the database crashes while loading it.
+func (c *crashChain) open(db dbm.DB) *app.App {
+	return app.New(log.NewNopLogger(), db, nil, true, map[int64]bool{}, c.dir, 5, app.MakeEncodingConfig(), app.EmptyAppOptions{})
+}
+
+// run replays the chain from the last committed height of the app until the
+// end height or a crash of its database. It returns the panic of the crash,
+// if any, and the last height whose Commit returned.
+func (c *crashChain) run(config dbfault.Config, end int64) (recovered interface{}, committed int64) {
+	ldb, err := dbm.NewGoLevelDB("application", c.dir)
+	require.NoError(c.t, err)
+	db := dbfault.NewDB(ldb, config)
+	defer func() {
+		recovered = recover()
+		require.NoError(c.t, db.Close())
+	}()
+
+	chainApp := c.open(db)
+	committed = chainApp.LastBlockHeight()
+	if committed == 0 {
+		chainApp.InitChain(abci.RequestInitChain{
+			ChainId:         crashChainID,
+			Validators:      []abci.ValidatorUpdate{},
+			ConsensusParams: app.DefaultConsensusParams,
+			AppStateBytes:   c.genesis,
+		})
+	}
+
+	for height := committed + 1; height <= end; height++ {
+		chainApp.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{
+			ChainID:         crashChainID,
+			Height:          height,
+			Time:            genesisTime.Add(time.Duration(height) * 5 * time.Second),
+			ProposerAddress: c.proposer,
+		}})
+
+		res := chainApp.DeliverTx(abci.RequestDeliverTx{Tx: c.txs[height-1]})
+		if db.Crashed() {
+			// the panics of the transactions are recovered by the app
+			return nil, committed
+		}
+		require.True(c.t, res.IsOK(), res.Log)
+
+		chainApp.EndBlock(abci.RequestEndBlock{Height: height})
+		appHash := chainApp.Commit().Data
+		committed = height
+
+		if expected, ok := c.appHashes[height]; ok {
+			require.Equal(c.t, expected, appHash, "app hash of height %d", height)
+		} else {
+			c.appHashes[height] = appHash
+		}
+	}
+
+	return nil, committed
+}
+
+// lastHeight opens the app of the chain on a healthy database and checks the
+// consistency of its last commit. It returns the last committed height.
+func (c *crashChain) lastHeight() int64 {
+	db, err := dbm.NewGoLevelDB("application", c.dir)
+	require.NoError(c.t, err)
+	defer func() {
+		require.NoError(c.t, db.Close())
+	}()
+
+	chainApp := c.open(db)
+	commitID := chainApp.LastCommitID()
+	if commitID.Version > 0 {
+		require.Equal(c.t, c.appHashes[commitID.Version], commitID.Hash, "app hash of height %d", commitID.Version)
+	}
+	for _, name := range consistentStores {
+		store := chainApp.CommitMultiStore().GetCommitKVStore(chainApp.GetKey(name))
+		require.Equal(c.t, commitID.Version, store.LastCommitID().Version, "version of store %s", name)
+	}
+
+	return commitID.Version
+}
+
+func TestParseFault(t *testing.T) {
+	testCases := []struct {
+		name   string
+		fault  string
+		expect dbfault.Fault
+		expErr bool
+	}{
+		{"fail", "fail@3", dbfault.Fault{Kind: dbfault.KindFail, Batch: 3}, false},
+		{"crash after writes", "crash@120:3", dbfault.Fault{Kind: dbfault.KindCrash, Batch: 120, Writes: 3}, false},
+		{"drop", "drop@45", dbfault.Fault{Kind: dbfault.KindDrop, Batch: 45}, false},
+		{"missing batch", "crash", dbfault.Fault{}, true},
+		{"unknown fault", "explode@3", dbfault.Fault{}, true},
+		{"invalid batch", "crash@first", dbfault.Fault{}, true},
+		{"zero batch", "crash@0", dbfault.Fault{}, true},
+		{"invalid writes", "crash@3:some", dbfault.Fault{}, true},
+		{"negative writes", "crash@3:-1", dbfault.Fault{}, true},
+		{"drop after writes", "drop@3:1", dbfault.Fault{}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fault, err := dbfault.ParseFault(tc.fault)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expect, fault)
+		})
+	}
+}
+
+func TestCrash(t *testing.T) {
+	set := func(db dbm.DB, key, value string, sync bool) {
+		batch := db.NewBatch()
+		defer batch.Close()
+		require.NoError(t, batch.Set([]byte(key), []byte(value)))
+		if sync {
+			require.NoError(t, batch.WriteSync())
+		} else {
+			require.NoError(t, batch.Write())
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		config   dbfault.Config
+		expected map[string]string
+	}{
+		{
+			"unsynced writes are lost",
+			dbfault.Config{Fault: dbfault.Fault{Kind: dbfault.KindCrash, Batch: 4}},
+			map[string]string{"a": "1", "b": "2"},
+		},
+		{
+			"synced writes are lost on fsync lie",
+			dbfault.Config{Fault: dbfault.Fault{Kind: dbfault.KindCrash, Batch: 4}, LieFsync: true},
+			map[string]string{"a": "0"},
+		},
+		{
+			"crash after writes",
+			dbfault.Config{Fault: dbfault.Fault{Kind: dbfault.KindCrash, Batch: 4, Writes: 1}},
+			map[string]string{"a": "1", "b": "2"},
+		},
+		{
+			"dropped write",
+			dbfault.Config{Fault: dbfault.Fault{Kind: dbfault.KindDrop, Batch: 4}},
+			map[string]string{"a": "1", "b": "2"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			memDB := dbm.NewMemDB()
+			require.NoError(t, memDB.Set([]byte("a"), []byte("0")))
+
+			db := dbfault.NewDB(memDB, tc.config)
+			set(db, "a", "1", false)
+			set(db, "b", "2", true)
+			set(db, "c", "3", false)
+
+			func() {
+				defer func() {
+					if tc.config.Fault.Kind == dbfault.KindDrop {
+						require.Nil(t, recover())
+					} else {
+						require.Equal(t, dbfault.Crash{Batch: tc.config.Fault.Batch}, recover())
+					}
+				}()
+				batch := db.NewBatch()
+				defer batch.Close()
+				require.NoError(t, batch.Set([]byte("d"), []byte("4")))
+				require.NoError(t, batch.Set([]byte("e"), []byte("5")))
+				require.NoError(t, batch.Write())
+			}()
+			require.True(t, db.Crashed())
+			require.PanicsWithError(t, dbfault.Crash{Batch: tc.config.Fault.Batch}.Error(), func() {
+				_, _ = db.Get([]byte("a"))
+			})
+
+			stored := make(map[string]string)
+			iterator, err := memDB.Iterator(nil, nil)
+			require.NoError(t, err)
+			for ; iterator.Valid(); iterator.Next() {
+				stored[string(iterator.Key())] = string(iterator.Value())
+			}
+			require.NoError(t, iterator.Close())
+			require.Equal(t, tc.expected, stored)
+		})
+	}
+
+	// failed writes do not crash the database
+	db := dbfault.NewDB(dbm.NewMemDB(), dbfault.Config{Fault: dbfault.Fault{Kind: dbfault.KindFail, Batch: 1, Writes: 1}})
+	batch := db.NewBatch()
+	require.NoError(t, batch.Set([]byte("a"), []byte("1")))
+	require.ErrorIs(t, batch.Set([]byte("b"), []byte("2")), dbfault.ErrInjected)
+	require.ErrorIs(t, batch.Write(), dbfault.ErrInjected)
+	require.NoError(t, batch.Close())
+	require.False(t, db.Crashed())
+	require.NoError(t, db.Set([]byte("c"), []byte("3")))
+}
+
+// TestCrashRecovery crashes the app on random batches, in the middle of its
+// commits, and checks that it recovers its last commit and replays the chain
+// to the same app hashes.
+func TestCrashRecovery(t *testing.T) {
+	chain := newCrashChain(t)
+	recovered, committed := chain.run(dbfault.Config{}, crashBlocks)
+	require.Nil(t, recovered)
+	require.Equal(t, int64(crashBlocks), committed)
+
+	// replay the chain on a new database. The first block is committed
+	// without faults: without a commit to load, the IAVL stores load their
+	// latest version, so a crash in the middle of the first commit leaves
+	// some stores initialized and InitChain cannot run again.
+	chain.dir = t.TempDir()
+	recovered, committed = chain.run(dbfault.Config{}, 1)
+	require.Nil(t, recovered)
+	require.Equal(t, int64(1), committed)
+
+	kinds := []dbfault.Kind{dbfault.KindCrash, dbfault.KindDrop, dbfault.KindFail}
+	rng := rand.New(rand.NewSource(1))
+	crashes := 0
+	height := int64(1)
+	for restart := 0; height < crashBlocks; restart++ {
+		require.Less(t, restart, 100, "the chain does not progress")
+
+		fault := dbfault.Fault{Kind: kinds[restart%len(kinds)], Batch: 1 + rng.Intn(100)}
+		if fault.Kind != dbfault.KindDrop {
+			fault.Writes = rng.Intn(4)
+		}
+		config := dbfault.Config{Fault: fault, LieFsync: restart%4 == 3}
+
+		recovered, committed := chain.run(config, crashBlocks)
+		switch recovered := recovered.(type) {
+		case nil:
+		case dbfault.Crash:
+			crashes++
+		case error:
+			require.ErrorContains(t, recovered, dbfault.ErrInjected.Error())
+			crashes++
+		default:
+			require.Failf(t, "unexpected panic", "%v", recovered)
+		}
+
+		opened := height
+		height = chain.lastHeight()
+		require.LessOrEqual(t, height, committed+1)
+		switch {
+		case config.LieFsync:
+			require.GreaterOrEqual(t, height, opened)
+		case fault.Kind == dbfault.KindDrop:
+			require.GreaterOrEqual(t, height, committed-1)
+		default:
+			require.GreaterOrEqual(t, height, committed)
+		}
+	}
+	require.Positive(t, crashes)
+}
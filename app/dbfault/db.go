@@ -0,0 +1,324 @@
+package dbfault
+
+import (
+	"sync"
+
+	dbm "github.com/tendermint/tm-db"
+)
+
+var (
+	_ dbm.DB    = (*DB)(nil)
+	_ dbm.Batch = (*batch)(nil)
+)
+
+// Config is the configuration of the faults of a DB.
+type Config struct {
+	// Fault is the fault injected into the writes, none if its kind is zero.
+	Fault Fault
+	// LieFsync makes the synced writes as volatile as the unsynced ones.
+	LieFsync bool
+}
+
+// DB injects a fault into the writes of a database, to test the recovery of
+// the application from a crash in the middle of a commit.
+//
+// A crash simulates a power loss: the writes not synced since the database
+// was opened are rolled back, as well as the synced ones if LieFsync is set,
+// then every operation of the database panics with a Crash. Only Close can
+// be called on a crashed database.
+type DB struct {
+	dbm.DB
+
+	config Config
+
+	mtx      sync.Mutex
+	batches  int
+	crash    *Crash
+	unsynced []write
+}
+
+// write is a write of a database, holding the previous value of its key to
+// be rolled back.
+type write struct {
+	key      []byte
+	previous []byte
+}
+
+// NewDB wraps a database with the fault of the config.
+func NewDB(db dbm.DB, config Config) *DB {
+	return &DB{
+		DB:     db,
+		config: config,
+	}
+}
+
+// Crashed returns true if the database crashed.
+func (d *DB) Crashed() bool {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+
+	return d.crash != nil
+}
+
+// assertNotCrashed panics with the crash of the database, if any.
+func (d *DB) assertNotCrashed() {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+
+	d.panicIfCrashed()
+}
+
+// panicIfCrashed panics with the crash of the database, if any. It must be
+// called with the lock held and deferred unlock.
+func (d *DB) panicIfCrashed() {
+	if d.crash != nil {
+		panic(*d.crash)
+	}
+}
+
+// rollback rolls back the unsynced writes and crashes the database. It must
+// be called with the lock held.
+func (d *DB) rollback(number int) error {
+	d.crash = &Crash{Batch: number}
+
+	if len(d.unsynced) == 0 {
+		return nil
+	}
+
+	batch := d.DB.NewBatch()
+	defer batch.Close()
+	for i := len(d.unsynced) - 1; i >= 0; i-- {
+		w := d.unsynced[i]
+		var err error
+		if w.previous == nil {
+			err = batch.Delete(w.key)
+		} else {
+			err = batch.Set(w.key, w.previous)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	d.unsynced = nil
+
+	return batch.WriteSync()
+}
+
+// inject injects the fault of the config into a batch holding a number of
+// writes. It must be called with the lock held and deferred unlock, and
+// panics with a Crash if the database crashed.
+func (d *DB) inject(number, writes int, written bool) error {
+	d.panicIfCrashed()
+
+	fault := d.config.Fault
+	if fault.Batch != number || (!written && writes < fault.Writes) {
+		return nil
+	}
+
+	switch fault.Kind {
+	case KindFail:
+		return ErrInjected
+	case KindCrash:
+		if err := d.rollback(number); err != nil {
+			panic(err)
+		}
+		d.panicIfCrashed()
+	}
+
+	return nil
+}
+
+// volatile returns true if the unsynced writes must be recorded to be
+// rolled back on a crash.
+func (d *DB) volatile() bool {
+	return d.config.Fault.Kind == KindCrash || d.config.Fault.Kind == KindDrop
+}
+
+// Get implements DB.
+func (d *DB) Get(key []byte) ([]byte, error) {
+	d.assertNotCrashed()
+
+	return d.DB.Get(key)
+}
+
+// Has implements DB.
+func (d *DB) Has(key []byte) (bool, error) {
+	d.assertNotCrashed()
+
+	return d.DB.Has(key)
+}
+
+// Iterator implements DB.
+func (d *DB) Iterator(start, end []byte) (dbm.Iterator, error) {
+	d.assertNotCrashed()
+
+	return d.DB.Iterator(start, end)
+}
+
+// ReverseIterator implements DB.
+func (d *DB) ReverseIterator(start, end []byte) (dbm.Iterator, error) {
+	d.assertNotCrashed()
+
+	return d.DB.ReverseIterator(start, end)
+}
+
+// Set implements DB.
+func (d *DB) Set(key, value []byte) error {
+	return d.writeSingle(key, value, false)
+}
+
+// SetSync implements DB.
+func (d *DB) SetSync(key, value []byte) error {
+	return d.writeSingle(key, value, true)
+}
+
+// Delete implements DB.
+func (d *DB) Delete(key []byte) error {
+	return d.writeSingle(key, nil, false)
+}
+
+// DeleteSync implements DB.
+func (d *DB) DeleteSync(key []byte) error {
+	return d.writeSingle(key, nil, true)
+}
+
+// writeSingle writes or deletes, if the value is nil, a key as a batch of one
+// write.
+func (d *DB) writeSingle(key, value []byte, sync bool) error {
+	b := d.NewBatch()
+	defer b.Close()
+
+	var err error
+	if value == nil {
+		err = b.Delete(key)
+	} else {
+		err = b.Set(key, value)
+	}
+	if err != nil {
+		return err
+	}
+
+	if sync {
+		return b.WriteSync()
+	}
+	return b.Write()
+}
+
+// NewBatch implements DB.
+func (d *DB) NewBatch() dbm.Batch {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+
+	d.batches++
+
+	return &batch{
+		Batch:  d.DB.NewBatch(),
+		db:     d,
+		number: d.batches,
+	}
+}
+
+// Close implements DB. It closes the database even if it crashed.
+func (d *DB) Close() error {
+	return d.DB.Close()
+}
+
+// batch injects the fault of its database into its writes.
+type batch struct {
+	dbm.Batch
+
+	db     *DB
+	number int
+	keys   [][]byte
+}
+
+// Set implements Batch.
+func (b *batch) Set(key, value []byte) error {
+	if err := b.add(key); err != nil {
+		return err
+	}
+
+	return b.Batch.Set(key, value)
+}
+
+// Delete implements Batch.
+func (b *batch) Delete(key []byte) error {
+	if err := b.add(key); err != nil {
+		return err
+	}
+
+	return b.Batch.Delete(key)
+}
+
+// add injects the fault of the database before adding a write of a key.
+func (b *batch) add(key []byte) error {
+	b.db.mtx.Lock()
+	defer b.db.mtx.Unlock()
+
+	if err := b.db.inject(b.number, len(b.keys), false); err != nil {
+		return err
+	}
+	b.keys = append(b.keys, append([]byte(nil), key...))
+
+	return nil
+}
+
+// Write implements Batch.
+func (b *batch) Write() error {
+	return b.write(false)
+}
+
+// WriteSync implements Batch.
+func (b *batch) WriteSync() error {
+	return b.write(true)
+}
+
+// write injects the fault of the database before writing the batch, and
+// records its writes to be rolled back on a crash unless they are synced.
+func (b *batch) write(sync bool) error {
+	d := b.db
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+
+	if err := d.inject(b.number, len(b.keys), true); err != nil {
+		return err
+	}
+
+	if d.config.Fault.Kind == KindDrop && d.config.Fault.Batch == b.number {
+		if err := d.rollback(b.number); err != nil {
+			return err
+		}
+		return b.Batch.Close()
+	}
+
+	var writes []write
+	if d.volatile() {
+		writes = make([]write, 0, len(b.keys))
+		for _, key := range b.keys {
+			previous, err := d.DB.Get(key)
+			if err != nil {
+				return err
+			}
+			writes = append(writes, write{key: key, previous: previous})
+		}
+	}
+
+	var err error
+	if sync {
+		err = b.Batch.WriteSync()
+	} else {
+		err = b.Batch.Write()
+	}
+	if err != nil {
+		return err
+	}
+
+	if sync && !d.config.LieFsync {
+		d.unsynced = nil
+	} else {
+		d.unsynced = append(d.unsynced, writes...)
+	}
+	b.keys = nil
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package dbfault
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// FlagUnsafeDBFault is the start flag holding the fault injected into the
+	// writes of the application database.
+	FlagUnsafeDBFault = "unsafe-db-fault"
+	// FlagUnsafeDBLieFsync is the start flag making the synced writes of the
+	// application database lost on an injected crash.
+	FlagUnsafeDBLieFsync = "unsafe-db-lie-fsync"
+)
+
+// ErrInjected is the error returned by the writes failed by a KindFail fault.
+var ErrInjected = errors.New("db fault: injected write failure")
+
+// Kind is the kind of fault injected into the writes of a database.
+type Kind int
+
+const (
+	// KindFail makes the write return ErrInjected. The database remains
+	// usable.
+	KindFail Kind = iota + 1
+	// KindCrash crashes the database before the batch is written.
+	KindCrash
+	// KindDrop reports the batch as written without writing it, then crashes
+	// the database.
+	KindDrop
+)
+
+// kindNames are the names of the faults in the start flag.
+var kindNames = map[Kind]string{
+	KindFail:  "fail",
+	KindCrash: "crash",
+	KindDrop:  "drop",
+}
+
+// String implements the Stringer interface.
+func (k Kind) String() string {
+	if name, ok := kindNames[k]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("Kind(%d)", int(k))
+}
+
+// Fault injects a fault into a batch written to a database. Batches are
+// numbered from 1 in order of creation since the database was opened, and
+// the single writes of the database count as batches of one write.
+type Fault struct {
+	Kind Kind
+	// Batch is the number of the faulty batch.
+	Batch int
+	// Writes is the number of writes added to the batch before a KindFail or
+	// KindCrash fault is injected. If the batch has fewer writes, the fault is
+	// injected when the batch is written.
+	Writes int
+}
+
+// ParseFault parses the fault of the start flag. Faults are given as
+// <fail|crash|drop>@<batch>[:<writes>], e.g. crash@120:3 or drop@45.
+func ParseFault(s string) (Fault, error) {
+	var fault Fault
+
+	name, position, ok := strings.Cut(s, "@")
+	if !ok {
+		return Fault{}, fmt.Errorf("expected <fail|crash|drop>@<batch>[:<writes>], got %q", s)
+	}
+
+	for kind, kindName := range kindNames {
+		if name == kindName {
+			fault.Kind = kind
+		}
+	}
+
+	batch, writes, hasWrites := strings.Cut(position, ":")
+	var err error
+	if fault.Batch, err = strconv.Atoi(batch); err != nil {
+		return Fault{}, fmt.Errorf("invalid batch %q", batch)
+	}
+	if hasWrites {
+		if fault.Writes, err = strconv.Atoi(writes); err != nil {
+			return Fault{}, fmt.Errorf("invalid writes %q", writes)
+		}
+	}
+
+	if err := fault.Validate(); err != nil {
+		return Fault{}, err
+	}
+
+	return fault, nil
+}
+
+// Validate performs a stateless validation of the fault.
+func (f Fault) Validate() error {
+	if _, ok := kindNames[f.Kind]; !ok {
+		return fmt.Errorf("invalid fault %s", f.Kind)
+	}
+	if f.Batch < 1 {
+		return fmt.Errorf("batch must be positive, got %d", f.Batch)
+	}
+	if f.Writes < 0 {
+		return fmt.Errorf("writes cannot be negative, got %d", f.Writes)
+	}
+	if f.Kind == KindDrop && f.Writes != 0 {
+		return fmt.Errorf("writes are not supported by the %s fault", KindDrop)
+	}
+
+	return nil
+}
+
+// Crash is the value of the panics of a crashed database.
+type Crash struct {
+	// Batch is the number of the batch the database crashed on.
+	Batch int
+}
+
+// Error implements the error interface.
+func (c Crash) Error() string {
+	return fmt.Sprintf("db fault: database crashed on batch %d", c.Batch)
+}
@@ -0,0 +1,138 @@
+// Package equivocation forges the evidence of a validator double signing, to
+// exercise the evidence and slashing modules without a byzantine validator.
+package equivocation
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	"github.com/tendermint/tendermint/privval"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// validatorsPerPage is the page size of the validator set queries.
+const validatorsPerPage = 100
+
+// LoadPrivValidatorKey loads the private key of a priv_validator_key.json
+// file. The sign state of the validator is neither read nor updated.
+func LoadPrivValidatorKey(file string) (crypto.PrivKey, error) {
+	bz, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var key privval.FilePVKey
+	if err := tmjson.Unmarshal(bz, &key); err != nil {
+		return nil, fmt.Errorf("cannot read the priv validator key from %s: %w", file, err)
+	}
+	if key.PrivKey == nil {
+		return nil, fmt.Errorf("no private key in %s", file)
+	}
+
+	return key.PrivKey, nil
+}
+
+// Block is the state of the chain an evidence is verified against: the time
+// of the block of the equivocation and the validator set of its height.
+type Block struct {
+	ChainID    string
+	Height     int64
+	Time       time.Time
+	Validators *tmtypes.ValidatorSet
+}
+
+// QueryBlock queries the block of a height and its validator set from a
+// node.
+func QueryBlock(ctx context.Context, client rpcclient.Client, height int64) (Block, error) {
+	block, err := client.Block(ctx, &height)
+	if err != nil {
+		return Block{}, err
+	}
+
+	var validators []*tmtypes.Validator
+	for page, perPage := 1, validatorsPerPage; ; page++ {
+		res, err := client.Validators(ctx, &height, &page, &perPage)
+		if err != nil {
+			return Block{}, err
+		}
+		validators = append(validators, res.Validators...)
+		if len(validators) >= res.Total {
+			break
+		}
+	}
+
+	valSet, err := tmtypes.ValidatorSetFromExistingValidators(validators)
+	if err != nil {
+		return Block{}, err
+	}
+
+	return Block{
+		ChainID:    block.Block.ChainID,
+		Height:     height,
+		Time:       block.Block.Time,
+		Validators: valSet,
+	}, nil
+}
+
+// NewEvidence signs two prevotes of the validator of a private key for two
+// conflicting random blocks at the height of a block and a round, and
+// returns the evidence of the equivocation.
+func NewEvidence(privKey crypto.PrivKey, block Block, round int32) (*tmtypes.DuplicateVoteEvidence, error) {
+	address := privKey.PubKey().Address()
+	index, _ := block.Validators.GetByAddress(address)
+	if index < 0 {
+		return nil, fmt.Errorf("%s is not a validator at height %d", address, block.Height)
+	}
+
+	votes := make([]*tmtypes.Vote, 2)
+	for i := range votes {
+		blockID, err := randomBlockID()
+		if err != nil {
+			return nil, err
+		}
+		vote := &tmtypes.Vote{
+			Type:             tmproto.PrevoteType,
+			Height:           block.Height,
+			Round:            round,
+			BlockID:          blockID,
+			Timestamp:        block.Time,
+			ValidatorAddress: address,
+			ValidatorIndex:   index,
+		}
+		if vote.Signature, err = privKey.Sign(tmtypes.VoteSignBytes(block.ChainID, vote.ToProto())); err != nil {
+			return nil, err
+		}
+		votes[i] = vote
+	}
+
+	evidence := tmtypes.NewDuplicateVoteEvidence(votes[0], votes[1], block.Time, block.Validators)
+	if err := evidence.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return evidence, nil
+}
+
+// randomBlockID returns the ID of a block that was never proposed.
+func randomBlockID() (tmtypes.BlockID, error) {
+	hash := make([]byte, tmhash.Size)
+	partsHash := make([]byte, tmhash.Size)
+	if _, err := rand.Read(hash); err != nil {
+		return tmtypes.BlockID{}, err
+	}
+	if _, err := rand.Read(partsHash); err != nil {
+		return tmtypes.BlockID{}, err
+	}
+
+	return tmtypes.BlockID{
+		Hash:          hash,
+		PartSetHeader: tmtypes.PartSetHeader{Total: 1, Hash: partsHash},
+	}, nil
+}
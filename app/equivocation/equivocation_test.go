@@ -0,0 +1,99 @@
+package equivocation_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/cosmos-builders/chaos/app/equivocation"
+	"github.com/cosmos-builders/chaos/app/scenario"
+)
+
+func TestEquivocation(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping in-process network test in short mode")
+	}
+
+	s, err := scenario.Parse([]byte("validators: 4\nduration: 1m\ntimeout_commit: 300ms\n"))
+	require.NoError(t, err)
+	n, err := scenario.NewNetwork(t.TempDir(), s, log.NewNopLogger())
+	require.NoError(t, err)
+	defer n.Close()
+	require.NoError(t, n.Start())
+
+	byzantine := n.Validators[1]
+	valAddr := sdk.ValAddress(byzantine.Address)
+	waitFor(t, func() bool { return n.Validators[0].Height() >= 3 })
+
+	validator := queryValidator(t, n, valAddr)
+	require.False(t, validator.Jailed)
+
+	client, err := rpchttp.New(n.Validators[0].RPCAddress, "/websocket")
+	require.NoError(t, err)
+	block, err := equivocation.QueryBlock(context.Background(), client, 2)
+	require.NoError(t, err)
+	require.Equal(t, s.ChainID, block.ChainID)
+	require.Equal(t, 4, block.Validators.Size())
+
+	privKey, err := equivocation.LoadPrivValidatorKey(byzantine.PrivValidatorKeyFile())
+	require.NoError(t, err)
+	evidence, err := equivocation.NewEvidence(privKey, block, 0)
+	require.NoError(t, err)
+	require.Equal(t, byzantine.ConsAddress.Bytes(), evidence.VoteA.ValidatorAddress.Bytes())
+	require.NotEqual(t, evidence.VoteA.BlockID, evidence.VoteB.BlockID)
+	require.Equal(t, block.Time, evidence.Timestamp)
+
+	// an evidence whose votes are not both signed is refused
+	otherKey, err := equivocation.LoadPrivValidatorKey(n.Validators[2].PrivValidatorKeyFile())
+	require.NoError(t, err)
+	otherEvidence, err := equivocation.NewEvidence(otherKey, block, 0)
+	require.NoError(t, err)
+	otherEvidence.VoteB.Signature = otherEvidence.VoteA.Signature
+	_, err = client.BroadcastEvidence(context.Background(), otherEvidence)
+	require.Error(t, err)
+
+	_, err = client.BroadcastEvidence(context.Background(), evidence)
+	require.NoError(t, err)
+
+	waitFor(t, func() bool { return queryValidator(t, n, valAddr).Jailed })
+	validator = queryValidator(t, n, valAddr)
+	require.Equal(t, stakingtypes.Unbonding, validator.Status)
+	// the double sign slash fraction is 5%
+	require.True(t, validator.Tokens.LT(sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)), validator.Tokens)
+
+	var signingInfo slashingtypes.QuerySigningInfoResponse
+	require.NoError(t, n.Query(
+		"/cosmos.slashing.v1beta1.Query/SigningInfo",
+		&slashingtypes.QuerySigningInfoRequest{ConsAddress: byzantine.ConsAddress.String()},
+		&signingInfo,
+	))
+	require.True(t, signingInfo.ValSigningInfo.Tombstoned)
+	require.True(t, evidencetypes.DoubleSignJailEndTime.Equal(signingInfo.ValSigningInfo.JailedUntil))
+
+	// the chain goes on without the tombstoned validator
+	height := n.Validators[0].Height()
+	waitFor(t, func() bool { return n.Validators[0].Height() > height+2 })
+}
+
+func queryValidator(t *testing.T, n *scenario.Network, valAddr sdk.ValAddress) stakingtypes.Validator {
+	var res stakingtypes.QueryValidatorResponse
+	require.NoError(t, n.Query(
+		"/cosmos.staking.v1beta1.Query/Validator",
+		&stakingtypes.QueryValidatorRequest{ValidatorAddr: valAddr.String()},
+		&res,
+	))
+	return res.Validator
+}
+
+func waitFor(t *testing.T, condition func() bool) {
+	require.Eventually(t, condition, 30*time.Second, 100*time.Millisecond)
+}
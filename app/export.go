@@ -1,11 +1,20 @@
 package app
 
 import (
+	"bytes"
 	"encoding/json"
-	"log"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -25,7 +34,11 @@ func (app *App) ExportAppStateAndValidators(
 	height := app.LastBlockHeight() + 1
 	if forZeroHeight {
 		height = 0
-		app.prepForZeroHeightGenesis(ctx, jailAllowedAddrs)
+		report, err := app.prepForZeroHeightGenesis(ctx, jailAllowedAddrs)
+		if err != nil {
+			return servertypes.ExportedApp{}, err
+		}
+		app.logZeroHeightReport(report)
 	}
 
 	genState := app.mm.ExportGenesis(ctx, app.appCodec)
@@ -46,10 +59,380 @@ func (app *App) ExportAppStateAndValidators(
 	}, nil
 }
 
+// PartialExportNotice is the notice of a filtered export, which is not a
+// valid genesis.
+const PartialExportNotice = "partial export of the app state: this is not a valid genesis"
+
+// redactedValue replaces the redacted fields of a filtered export.
+const redactedValue = "REDACTED"
+
+// ExportFilter selects the parts of the app state exported by
+// ExportFilteredAppState.
+type ExportFilter struct {
+	// Modules are the exported modules, all of them if empty.
+	Modules []string
+	// Accounts and AccountPrefix limit the accounts of the modules of
+	// accountFilters to the listed addresses and to the addresses starting
+	// with the prefix. All accounts are exported if both are empty.
+	Accounts      []string
+	AccountPrefix string
+	// Redact are the fields replaced by "REDACTED", as <module>.<field>...,
+	// e.g. auth.accounts.pub_key. The fields of the elements of a list are
+	// redacted in every element.
+	Redact []string
+}
+
+// Validate checks the modules, the accounts and the redacted fields of the
+// filter against the modules of the app.
+func (f ExportFilter) Validate(modules map[string]module.AppModule) error {
+	for _, name := range f.Modules {
+		if _, ok := modules[name]; !ok {
+			return fmt.Errorf("unknown module %s", name)
+		}
+	}
+	for _, addr := range f.Accounts {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid account %s: %w", addr, err)
+		}
+	}
+	for _, field := range f.Redact {
+		path := strings.Split(field, ".")
+		if len(path) < 2 {
+			return fmt.Errorf("invalid redacted field %s: expected <module>.<field>", field)
+		}
+		if !f.exports(path[0]) {
+			return fmt.Errorf("invalid redacted field %s: module %s is not exported", field, path[0])
+		}
+		if _, ok := modules[path[0]]; !ok {
+			return fmt.Errorf("invalid redacted field %s: unknown module %s", field, path[0])
+		}
+	}
+	return nil
+}
+
+// IsEmpty returns whether the filter exports the whole app state.
+func (f ExportFilter) IsEmpty() bool {
+	return len(f.Modules) == 0 && !f.filtersAccounts() && len(f.Redact) == 0
+}
+
+func (f ExportFilter) exports(moduleName string) bool {
+	if len(f.Modules) == 0 {
+		return true
+	}
+	for _, name := range f.Modules {
+		if name == moduleName {
+			return true
+		}
+	}
+	return false
+}
+
+func (f ExportFilter) filtersAccounts() bool {
+	return len(f.Accounts) > 0 || f.AccountPrefix != ""
+}
+
+func (f ExportFilter) keepsAccount(addr string) bool {
+	if f.AccountPrefix != "" && strings.HasPrefix(addr, f.AccountPrefix) {
+		return true
+	}
+	for _, account := range f.Accounts {
+		if account == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// PartialExport states which parts of a filtered export are partial.
+type PartialExport struct {
+	Notice string `json:"notice"`
+	// Modules are the exported modules, if not all of them are.
+	Modules []string `json:"modules,omitempty"`
+	// Accounts and AccountPrefix are the accounts kept in the
+	// AccountFilteredModules, the other modules holding all accounts.
+	Accounts               []string `json:"accounts,omitempty"`
+	AccountPrefix          string   `json:"account_prefix,omitempty"`
+	AccountFilteredModules []string `json:"account_filtered_modules,omitempty"`
+	// Redacted are the number of values of each redacted field.
+	Redacted map[string]int `json:"redacted,omitempty"`
+}
+
+// FilteredExport is the app state exported by ExportFilteredAppState.
+type FilteredExport struct {
+	Height   int64                      `json:"height,string"`
+	Partial  PartialExport              `json:"partial"`
+	AppState map[string]json.RawMessage `json:"app_state"`
+}
+
+// accountFilters filter the genesis of the modules holding the state of
+// accounts, keeping the entries of the accounts kept by a filter. The totals,
+// e.g. the supply, are not changed.
+var accountFilters = map[string]func(cdc codec.JSONCodec, bz json.RawMessage, f ExportFilter) (json.RawMessage, error){
+	authtypes.ModuleName: func(cdc codec.JSONCodec, bz json.RawMessage, f ExportFilter) (json.RawMessage, error) {
+		var genState authtypes.GenesisState
+		if err := cdc.UnmarshalJSON(bz, &genState); err != nil {
+			return nil, err
+		}
+		accounts, err := authtypes.UnpackAccounts(genState.Accounts)
+		if err != nil {
+			return nil, err
+		}
+		var kept authtypes.GenesisAccounts
+		for _, acc := range accounts {
+			if f.keepsAccount(acc.GetAddress().String()) {
+				kept = append(kept, acc)
+			}
+		}
+		if genState.Accounts, err = authtypes.PackAccounts(kept); err != nil {
+			return nil, err
+		}
+		return cdc.MarshalJSON(&genState)
+	},
+	banktypes.ModuleName: func(cdc codec.JSONCodec, bz json.RawMessage, f ExportFilter) (json.RawMessage, error) {
+		var genState banktypes.GenesisState
+		if err := cdc.UnmarshalJSON(bz, &genState); err != nil {
+			return nil, err
+		}
+		var kept []banktypes.Balance
+		for _, balance := range genState.Balances {
+			if f.keepsAccount(balance.Address) {
+				kept = append(kept, balance)
+			}
+		}
+		genState.Balances = kept
+		return cdc.MarshalJSON(&genState)
+	},
+	stakingtypes.ModuleName: func(cdc codec.JSONCodec, bz json.RawMessage, f ExportFilter) (json.RawMessage, error) {
+		var genState stakingtypes.GenesisState
+		if err := cdc.UnmarshalJSON(bz, &genState); err != nil {
+			return nil, err
+		}
+		var delegations []stakingtypes.Delegation
+		for _, del := range genState.Delegations {
+			if f.keepsAccount(del.DelegatorAddress) {
+				delegations = append(delegations, del)
+			}
+		}
+		var ubds []stakingtypes.UnbondingDelegation
+		for _, ubd := range genState.UnbondingDelegations {
+			if f.keepsAccount(ubd.DelegatorAddress) {
+				ubds = append(ubds, ubd)
+			}
+		}
+		var reds []stakingtypes.Redelegation
+		for _, red := range genState.Redelegations {
+			if f.keepsAccount(red.DelegatorAddress) {
+				reds = append(reds, red)
+			}
+		}
+		genState.Delegations, genState.UnbondingDelegations, genState.Redelegations = delegations, ubds, reds
+		return cdc.MarshalJSON(&genState)
+	},
+	distrtypes.ModuleName: func(cdc codec.JSONCodec, bz json.RawMessage, f ExportFilter) (json.RawMessage, error) {
+		var genState distrtypes.GenesisState
+		if err := cdc.UnmarshalJSON(bz, &genState); err != nil {
+			return nil, err
+		}
+		var withdrawInfos []distrtypes.DelegatorWithdrawInfo
+		for _, info := range genState.DelegatorWithdrawInfos {
+			if f.keepsAccount(info.DelegatorAddress) {
+				withdrawInfos = append(withdrawInfos, info)
+			}
+		}
+		var startingInfos []distrtypes.DelegatorStartingInfoRecord
+		for _, info := range genState.DelegatorStartingInfos {
+			if f.keepsAccount(info.DelegatorAddress) {
+				startingInfos = append(startingInfos, info)
+			}
+		}
+		genState.DelegatorWithdrawInfos, genState.DelegatorStartingInfos = withdrawInfos, startingInfos
+		return cdc.MarshalJSON(&genState)
+	},
+}
+
+// ExportFilteredAppState exports the parts of the state of the application
+// selected by a filter, for analysis or audits. The export states which parts
+// are partial and cannot be used as the app state of a genesis.
+func (app *App) ExportFilteredAppState(
+	filter ExportFilter, forZeroHeight bool, jailAllowedAddrs []string,
+) (FilteredExport, error) {
+	if err := filter.Validate(app.mm.Modules); err != nil {
+		return FilteredExport{}, err
+	}
+
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+
+	height := app.LastBlockHeight() + 1
+	if forZeroHeight {
+		height = 0
+		report, err := app.prepForZeroHeightGenesis(ctx, jailAllowedAddrs)
+		if err != nil {
+			return FilteredExport{}, err
+		}
+		app.logZeroHeightReport(report)
+	}
+
+	exported := FilteredExport{
+		Height: height,
+		Partial: PartialExport{
+			Notice:        PartialExportNotice,
+			Accounts:      filter.Accounts,
+			AccountPrefix: filter.AccountPrefix,
+		},
+		AppState: make(map[string]json.RawMessage),
+	}
+	if len(filter.Modules) > 0 {
+		exported.Partial.Modules = append([]string(nil), filter.Modules...)
+		sort.Strings(exported.Partial.Modules)
+	}
+
+	for _, moduleName := range app.mm.OrderExportGenesis {
+		if !filter.exports(moduleName) {
+			continue
+		}
+		bz := app.mm.Modules[moduleName].ExportGenesis(ctx, app.appCodec)
+		if bz == nil {
+			exported.AppState[moduleName] = bz
+			continue
+		}
+
+		if accountFilter, ok := accountFilters[moduleName]; ok && filter.filtersAccounts() {
+			var err error
+			if bz, err = accountFilter(app.appCodec, bz, filter); err != nil {
+				return FilteredExport{}, fmt.Errorf("failed to filter the accounts of %s: %w", moduleName, err)
+			}
+			exported.Partial.AccountFilteredModules = append(exported.Partial.AccountFilteredModules, moduleName)
+		}
+
+		for _, field := range filter.Redact {
+			path := strings.Split(field, ".")
+			if path[0] != moduleName {
+				continue
+			}
+			var n int
+			var err error
+			if bz, n, err = redactJSON(bz, path[1:]); err != nil {
+				return FilteredExport{}, fmt.Errorf("failed to redact %s: %w", field, err)
+			}
+			if exported.Partial.Redacted == nil {
+				exported.Partial.Redacted = make(map[string]int)
+			}
+			exported.Partial.Redacted[field] += n
+		}
+
+		exported.AppState[moduleName] = bz
+	}
+	sort.Strings(exported.Partial.AccountFilteredModules)
+
+	return exported, nil
+}
+
+// redactJSON replaces the values of a field of a JSON document, given as the
+// path of its keys, and returns the number of replaced values.
+func redactJSON(bz json.RawMessage, path []string) (json.RawMessage, int, error) {
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	// keep the integers beyond 2^53 exact
+	dec.UseNumber()
+	var doc interface{}
+	if err := dec.Decode(&doc); err != nil {
+		return nil, 0, err
+	}
+
+	var redact func(v interface{}, path []string) int
+	redact = func(v interface{}, path []string) int {
+		switch v := v.(type) {
+		case []interface{}:
+			n := 0
+			for _, elem := range v {
+				n += redact(elem, path)
+			}
+			return n
+		case map[string]interface{}:
+			value, ok := v[path[0]]
+			if !ok {
+				return 0
+			}
+			if len(path) == 1 {
+				v[path[0]] = redactedValue
+				return 1
+			}
+			return redact(value, path[1:])
+		default:
+			return 0
+		}
+	}
+	n := redact(doc, path)
+
+	bz, err := json.Marshal(doc)
+	if err != nil {
+		return nil, 0, err
+	}
+	return bz, n, nil
+}
+
+// ZeroHeightReport lists the changes made to the state by the preparation of
+// a zero height genesis.
+type ZeroHeightReport struct {
+	WithdrawnCommissions []ReportedCoins `yaml:"withdrawn_commissions"`
+	WithdrawnRewards     []ReportedCoins `yaml:"withdrawn_rewards"`
+	// CommunityPoolScraps are the outstanding rewards left to the validators
+	// once withdrawn, sent to the community pool.
+	CommunityPoolScraps []ReportedCoins `yaml:"community_pool_scraps"`
+	// JailedValidators are the validators jailed as missing from the jail
+	// allowlist.
+	JailedValidators []string `yaml:"jailed_validators"`
+	// ResetRedelegations and ResetUnbondingDelegations are the entries whose
+	// creation height was reset to zero.
+	ResetRedelegations        []ResetEntries `yaml:"reset_redelegations"`
+	ResetUnbondingDelegations []ResetEntries `yaml:"reset_unbonding_delegations"`
+}
+
+// ReportedCoins are the coins of a validator or of a delegation.
+type ReportedCoins struct {
+	Delegator string `yaml:"delegator,omitempty"`
+	Validator string `yaml:"validator"`
+	Coins     string `yaml:"coins"`
+}
+
+// ResetEntries are the entries of a redelegation or of an unbonding
+// delegation whose creation height was reset.
+type ResetEntries struct {
+	Delegator string `yaml:"delegator"`
+	// Validator is the source validator of a redelegation.
+	Validator    string `yaml:"validator"`
+	DstValidator string `yaml:"dst_validator,omitempty"`
+	// CreationHeights are the former creation heights of the entries.
+	CreationHeights []int64 `yaml:"creation_heights"`
+}
+
+// ZeroHeightGenesisDryRun returns the changes that the preparation of a zero
+// height genesis would make to the state, without changing it.
+func (app *App) ZeroHeightGenesisDryRun(jailAllowedAddrs []string) (ZeroHeightReport, error) {
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+	// the changes are written to a cache which is dropped
+	cacheCtx, _ := ctx.CacheContext()
+	return app.prepForZeroHeightGenesis(cacheCtx, jailAllowedAddrs)
+}
+
+// logZeroHeightReport logs the changes made to the state by the preparation
+// of a zero height genesis.
+func (app *App) logZeroHeightReport(report ZeroHeightReport) {
+	app.Logger().Info(
+		"prepared state for zero height genesis",
+		"withdrawn_commissions", len(report.WithdrawnCommissions),
+		"withdrawn_rewards", len(report.WithdrawnRewards),
+		"community_pool_scraps", len(report.CommunityPoolScraps),
+		"jailed_validators", len(report.JailedValidators),
+		"reset_redelegations", len(report.ResetRedelegations),
+		"reset_unbonding_delegations", len(report.ResetUnbondingDelegations),
+	)
+}
+
 // prepare for fresh start at zero height
 // NOTE zero height genesis is a temporary feature which will be deprecated
 // in favour of export at a block height
-func (app *App) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs []string) {
+func (app *App) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs []string) (ZeroHeightReport, error) {
+	var report ZeroHeightReport
 	applyAllowedAddrs := false
 
 	// check if there is a allowed address list
@@ -62,31 +445,60 @@ func (app *App) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs []str
 	for _, addr := range jailAllowedAddrs {
 		_, err := sdk.ValAddressFromBech32(addr)
 		if err != nil {
-			log.Fatal(err)
+			return report, fmt.Errorf("invalid jail allowed address %s: %w", addr, err)
 		}
 		allowedAddrsMap[addr] = true
 	}
 
 	/* Just to be safe, assert the invariants on current state. */
-	app.CrisisKeeper.AssertInvariants(ctx)
+	for _, route := range app.CrisisKeeper.Routes() {
+		if msg, broken := route.Invar(ctx); broken {
+			return report, fmt.Errorf("invariant broken: %s", msg)
+		}
+	}
 
 	/* Handle fee distribution state. */
 
 	// withdraw all validator commission
+	var err error
 	app.StakingKeeper.IterateValidators(ctx, func(_ int64, val stakingtypes.ValidatorI) (stop bool) {
-		_, err := app.DistrKeeper.WithdrawValidatorCommission(ctx, val.GetOperator())
+		var commission sdk.Coins
+		commission, err = app.DistrKeeper.WithdrawValidatorCommission(ctx, val.GetOperator())
+		if errors.Is(err, distrtypes.ErrNoValidatorCommission) {
+			err = nil
+			return false
+		}
 		if err != nil {
-			panic(err)
+			err = fmt.Errorf("failed to withdraw the commission of %s: %w", val.GetOperator(), err)
+			return true
+		}
+		if commission.IsZero() {
+			// the accumulated commission is less than a token
+			return false
 		}
+		report.WithdrawnCommissions = append(report.WithdrawnCommissions, ReportedCoins{
+			Validator: val.GetOperator().String(),
+			Coins:     commission.String(),
+		})
 		return false
 	})
+	if err != nil {
+		return report, err
+	}
 
 	// withdraw all delegator rewards
 	dels := app.StakingKeeper.GetAllDelegations(ctx)
 	for _, delegation := range dels {
-		_, err := app.DistrKeeper.WithdrawDelegationRewards(ctx, delegation.GetDelegatorAddr(), delegation.GetValidatorAddr())
+		rewards, err := app.DistrKeeper.WithdrawDelegationRewards(ctx, delegation.GetDelegatorAddr(), delegation.GetValidatorAddr())
 		if err != nil {
-			panic(err)
+			return report, fmt.Errorf("failed to withdraw the rewards of %s from %s: %w", delegation.DelegatorAddress, delegation.ValidatorAddress, err)
+		}
+		if !rewards.IsZero() {
+			report.WithdrawnRewards = append(report.WithdrawnRewards, ReportedCoins{
+				Delegator: delegation.DelegatorAddress,
+				Validator: delegation.ValidatorAddress,
+				Coins:     rewards.String(),
+			})
 		}
 	}
 
@@ -107,23 +519,33 @@ func (app *App) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs []str
 		feePool := app.DistrKeeper.GetFeePool(ctx)
 		feePool.CommunityPool = feePool.CommunityPool.Add(scraps...)
 		app.DistrKeeper.SetFeePool(ctx, feePool)
+		if !scraps.IsZero() {
+			report.CommunityPoolScraps = append(report.CommunityPoolScraps, ReportedCoins{
+				Validator: val.GetOperator().String(),
+				Coins:     scraps.String(),
+			})
+		}
 
-		err := app.DistrKeeper.Hooks().AfterValidatorCreated(ctx, val.GetOperator())
+		err = app.DistrKeeper.Hooks().AfterValidatorCreated(ctx, val.GetOperator())
 		if err != nil {
-			panic(err)
+			err = fmt.Errorf("failed to reinitialize the distribution of %s: %w", val.GetOperator(), err)
+			return true
 		}
 		return false
 	})
+	if err != nil {
+		return report, err
+	}
 
 	// reinitialize all delegations
 	for _, del := range dels {
 		err := app.DistrKeeper.Hooks().BeforeDelegationCreated(ctx, del.GetDelegatorAddr(), del.GetValidatorAddr())
 		if err != nil {
-			panic(err)
+			return report, fmt.Errorf("failed to reinitialize the delegation of %s to %s: %w", del.DelegatorAddress, del.ValidatorAddress, err)
 		}
 		err = app.DistrKeeper.Hooks().AfterDelegationModified(ctx, del.GetDelegatorAddr(), del.GetValidatorAddr())
 		if err != nil {
-			panic(err)
+			return report, fmt.Errorf("failed to reinitialize the delegation of %s to %s: %w", del.DelegatorAddress, del.ValidatorAddress, err)
 		}
 	}
 
@@ -134,18 +556,31 @@ func (app *App) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs []str
 
 	// iterate through redelegations, reset creation height
 	app.StakingKeeper.IterateRedelegations(ctx, func(_ int64, red stakingtypes.Redelegation) (stop bool) {
+		reset := ResetEntries{
+			Delegator:    red.DelegatorAddress,
+			Validator:    red.ValidatorSrcAddress,
+			DstValidator: red.ValidatorDstAddress,
+		}
 		for i := range red.Entries {
+			reset.CreationHeights = append(reset.CreationHeights, red.Entries[i].CreationHeight)
 			red.Entries[i].CreationHeight = 0
 		}
+		report.ResetRedelegations = append(report.ResetRedelegations, reset)
 		app.StakingKeeper.SetRedelegation(ctx, red)
 		return false
 	})
 
 	// iterate through unbonding delegations, reset creation height
 	app.StakingKeeper.IterateUnbondingDelegations(ctx, func(_ int64, ubd stakingtypes.UnbondingDelegation) (stop bool) {
+		reset := ResetEntries{
+			Delegator: ubd.DelegatorAddress,
+			Validator: ubd.ValidatorAddress,
+		}
 		for i := range ubd.Entries {
+			reset.CreationHeights = append(reset.CreationHeights, ubd.Entries[i].CreationHeight)
 			ubd.Entries[i].CreationHeight = 0
 		}
+		report.ResetUnbondingDelegations = append(report.ResetUnbondingDelegations, reset)
 		app.StakingKeeper.SetUnbondingDelegation(ctx, ubd)
 		return false
 	})
@@ -157,14 +592,20 @@ func (app *App) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs []str
 	counter := int16(0)
 
 	for ; iter.Valid(); iter.Next() {
-		addr := sdk.ValAddress(iter.Key()[1:])
+		addr := sdk.ValAddress(stakingtypes.AddressFromValidatorsKey(iter.Key()))
 		validator, found := app.StakingKeeper.GetValidator(ctx, addr)
 		if !found {
-			panic("expected validator, not found")
+			iter.Close()
+			return report, fmt.Errorf("expected validator %s, not found", addr)
 		}
 
 		validator.UnbondingHeight = 0
 		if applyAllowedAddrs && !allowedAddrsMap[addr.String()] {
+			if !validator.Jailed {
+				// as the staking keeper jails a validator
+				app.StakingKeeper.DeleteValidatorByPowerIndex(ctx, validator)
+				report.JailedValidators = append(report.JailedValidators, addr.String())
+			}
 			validator.Jailed = true
 		}
 
@@ -175,7 +616,7 @@ func (app *App) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs []str
 	iter.Close()
 
 	if _, err := app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx); err != nil {
-		panic(err)
+		return report, fmt.Errorf("failed to apply the validator set updates: %w", err)
 	}
 
 	/* Handle slashing state. */
@@ -189,4 +630,6 @@ func (app *App) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs []str
 			return false
 		},
 	)
+
+	return report, nil
 }
@@ -0,0 +1,293 @@
+package app
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+const (
+	// SplitGenesisFile is the file of a genesis split by module holding the
+	// genesis without its app state.
+	SplitGenesisFile = "genesis.json"
+	// SplitAppStateDir is the directory of a genesis split by module holding
+	// the genesis of each module, in <module>.json.
+	SplitAppStateDir = "app_state"
+
+	// appStatePlaceholder stands for the app state in the JSON of a genesis
+	// doc, to be replaced by the streamed app state.
+	appStatePlaceholder = `"__streamed_app_state__"`
+)
+
+// ExportProgress reports the export of the genesis of a module.
+type ExportProgress struct {
+	Module string
+	// Index is the position of the module in the export, from 1 to Total.
+	Index int
+	Total int
+	// Size is the size of the genesis of the module.
+	Size     int
+	Duration time.Duration
+	// HeapAlloc is the allocated heap after the export of the module.
+	HeapAlloc uint64
+}
+
+// StreamExportGenesis writes the genesis of the exported state of the app,
+// whose other fields are those of a genesis doc. The module genesis are
+// exported and written one at a time, so that the state of a single module
+// is held in memory. The output is identical to the genesis written by
+// the export command of the SDK.
+func (app *App) StreamExportGenesis(
+	w io.Writer, doc *tmtypes.GenesisDoc, forZeroHeight bool, jailAllowedAddrs []string, progress func(ExportProgress),
+) error {
+	ctx, err := app.prepareStreamExport(doc, forZeroHeight, jailAllowedAddrs)
+	if err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriter(w)
+	if err := writeGenesis(bw, doc, app.exportedModules(), func(w io.Writer, moduleName string) (int, error) {
+		bz, err := app.exportModuleGenesis(ctx, moduleName)
+		if err != nil {
+			return 0, err
+		}
+		return w.Write(bz)
+	}, progress); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// StreamExportGenesisDir writes the genesis of the exported state of the app
+// to a directory split by module, as StreamExportGenesis: SplitGenesisFile
+// holds the genesis without its app state and SplitAppStateDir the genesis of
+// each module. JoinGenesisDir joins them back into a genesis file.
+func (app *App) StreamExportGenesisDir(
+	dir string, doc *tmtypes.GenesisDoc, forZeroHeight bool, jailAllowedAddrs []string, progress func(ExportProgress),
+) error {
+	ctx, err := app.prepareStreamExport(doc, forZeroHeight, jailAllowedAddrs)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Join(dir, SplitAppStateDir), 0o755); err != nil {
+		return err
+	}
+	bz, err := tmjson.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	if bz, err = sdk.SortJSON(bz); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(dir, SplitGenesisFile), bz, 0o644); err != nil {
+		return err
+	}
+
+	moduleNames := app.exportedModules()
+	for i, moduleName := range moduleNames {
+		start := time.Now()
+		bz, err := app.exportModuleGenesis(ctx, moduleName)
+		if err != nil {
+			return fmt.Errorf("failed to export the genesis of %s: %w", moduleName, err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, SplitAppStateDir, moduleName+".json"), bz, 0o644); err != nil {
+			return err
+		}
+		reportProgress(progress, moduleName, i, len(moduleNames), len(bz), start)
+	}
+
+	return nil
+}
+
+// JoinGenesisDir writes the genesis file of a genesis split by module by
+// StreamExportGenesisDir, identical to the genesis written by
+// StreamExportGenesis.
+func JoinGenesisDir(dir string, w io.Writer) error {
+	bz, err := os.ReadFile(filepath.Join(dir, SplitGenesisFile))
+	if err != nil {
+		return err
+	}
+	// the genesis is not completed, so that it is written as exported
+	var doc tmtypes.GenesisDoc
+	if err := tmjson.Unmarshal(bz, &doc); err != nil {
+		return err
+	}
+
+	entries, err := os.ReadDir(filepath.Join(dir, SplitAppStateDir))
+	if err != nil {
+		return err
+	}
+	var moduleNames []string
+	for _, entry := range entries {
+		if name := entry.Name(); !entry.IsDir() && filepath.Ext(name) == ".json" {
+			moduleNames = append(moduleNames, name[:len(name)-len(".json")])
+		}
+	}
+	sort.Strings(moduleNames)
+
+	bw := bufio.NewWriter(w)
+	if err := writeGenesis(bw, &doc, moduleNames, func(w io.Writer, moduleName string) (int, error) {
+		f, err := os.Open(filepath.Join(dir, SplitAppStateDir, moduleName+".json"))
+		if err != nil {
+			return 0, err
+		}
+		defer f.Close()
+		n, err := io.Copy(w, f)
+		return int(n), err
+	}, nil); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// prepareStreamExport prepares the state of the app for the export and sets
+// the validators, the initial height and the consensus params of a genesis
+// doc, as the export command of the SDK does. It returns the context of the
+// exported state.
+func (app *App) prepareStreamExport(doc *tmtypes.GenesisDoc, forZeroHeight bool, jailAllowedAddrs []string) (sdk.Context, error) {
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+
+	height := app.LastBlockHeight() + 1
+	if forZeroHeight {
+		height = 0
+		report, err := app.prepForZeroHeightGenesis(ctx, jailAllowedAddrs)
+		if err != nil {
+			return sdk.Context{}, err
+		}
+		app.logZeroHeightReport(report)
+	}
+
+	validators, err := staking.WriteValidators(ctx, app.StakingKeeper)
+	if err != nil {
+		return sdk.Context{}, err
+	}
+
+	consensusParams := app.BaseApp.GetConsensusParams(ctx)
+	if doc.ConsensusParams == nil {
+		doc.ConsensusParams = tmtypes.DefaultConsensusParams()
+	}
+	doc.AppState = nil
+	doc.Validators = validators
+	doc.InitialHeight = height
+	doc.ConsensusParams = &tmproto.ConsensusParams{
+		Block: tmproto.BlockParams{
+			MaxBytes:   consensusParams.Block.MaxBytes,
+			MaxGas:     consensusParams.Block.MaxGas,
+			TimeIotaMs: doc.ConsensusParams.Block.TimeIotaMs,
+		},
+		Evidence: tmproto.EvidenceParams{
+			MaxAgeNumBlocks: consensusParams.Evidence.MaxAgeNumBlocks,
+			MaxAgeDuration:  consensusParams.Evidence.MaxAgeDuration,
+			MaxBytes:        consensusParams.Evidence.MaxBytes,
+		},
+		Validator: tmproto.ValidatorParams{
+			PubKeyTypes: consensusParams.Validator.PubKeyTypes,
+		},
+	}
+
+	return ctx, nil
+}
+
+// exportedModules returns the modules of the app state in the order of the
+// keys of the sorted genesis. The genesis export of the modules only reads
+// the state, so that it does not depend on the export order.
+func (app *App) exportedModules() []string {
+	moduleNames := append([]string(nil), app.mm.OrderExportGenesis...)
+	sort.Strings(moduleNames)
+	return moduleNames
+}
+
+// exportModuleGenesis returns the sorted JSON of the genesis of a module.
+func (app *App) exportModuleGenesis(ctx sdk.Context, moduleName string) ([]byte, error) {
+	bz := app.mm.Modules[moduleName].ExportGenesis(ctx, app.appCodec)
+	if bz == nil {
+		// as encoded in the app state map
+		return []byte("null"), nil
+	}
+	return sdk.SortJSON(bz)
+}
+
+// writeGenesis writes the sorted JSON of a genesis doc, the genesis of each
+// module of its app state being written by a function.
+func writeGenesis(
+	w io.Writer, doc *tmtypes.GenesisDoc, moduleNames []string,
+	writeModule func(w io.Writer, moduleName string) (int, error), progress func(ExportProgress),
+) error {
+	doc.AppState = json.RawMessage(appStatePlaceholder)
+	defer func() { doc.AppState = nil }()
+	bz, err := tmjson.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	if bz, err = sdk.SortJSON(bz); err != nil {
+		return err
+	}
+	i := bytes.Index(bz, []byte(appStatePlaceholder))
+	if i < 0 {
+		return fmt.Errorf("app state placeholder not found in the genesis doc")
+	}
+	prefix, suffix := bz[:i], bz[i+len(appStatePlaceholder):]
+
+	if _, err := w.Write(prefix); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, "{"); err != nil {
+		return err
+	}
+	for i, moduleName := range moduleNames {
+		start := time.Now()
+		if i > 0 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		key, err := json.Marshal(moduleName)
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(append(key, ':')); err != nil {
+			return err
+		}
+		n, err := writeModule(w, moduleName)
+		if err != nil {
+			return fmt.Errorf("failed to export the genesis of %s: %w", moduleName, err)
+		}
+		reportProgress(progress, moduleName, i, len(moduleNames), n, start)
+	}
+	if _, err := io.WriteString(w, "}"); err != nil {
+		return err
+	}
+	_, err = w.Write(suffix)
+	return err
+}
+
+func reportProgress(progress func(ExportProgress), moduleName string, i, total, size int, start time.Time) {
+	if progress == nil {
+		return
+	}
+
+	var memStats runtime.MemStats
+	runtime.ReadMemStats(&memStats)
+	progress(ExportProgress{
+		Module:    moduleName,
+		Index:     i + 1,
+		Total:     total,
+		Size:      size,
+		Duration:  time.Since(start),
+		HeapAlloc: memStats.HeapAlloc,
+	})
+}
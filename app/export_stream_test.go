@@ -0,0 +1,110 @@
+package app_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos-builders/chaos/app"
+)
+
+// newGenesisTemplate returns the genesis file of a chain read by the export
+// command.
+func newGenesisTemplate(chainID string) *tmtypes.GenesisDoc {
+	return &tmtypes.GenesisDoc{
+		GenesisTime:     time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		ChainID:         chainID,
+		InitialHeight:   1,
+		ConsensusParams: tmtypes.DefaultConsensusParams(),
+	}
+}
+
+// exportGenesis returns the genesis written by the export command of the SDK.
+func exportGenesis(t *testing.T, chainApp *app.App, doc *tmtypes.GenesisDoc) []byte {
+	exported, err := chainApp.ExportAppStateAndValidators(false, nil)
+	require.NoError(t, err)
+
+	doc.AppState = exported.AppState
+	doc.Validators = exported.Validators
+	doc.InitialHeight = exported.Height
+	doc.ConsensusParams = &tmproto.ConsensusParams{
+		Block: tmproto.BlockParams{
+			MaxBytes:   exported.ConsensusParams.Block.MaxBytes,
+			MaxGas:     exported.ConsensusParams.Block.MaxGas,
+			TimeIotaMs: doc.ConsensusParams.Block.TimeIotaMs,
+		},
+		Evidence: tmproto.EvidenceParams{
+			MaxAgeNumBlocks: exported.ConsensusParams.Evidence.MaxAgeNumBlocks,
+			MaxAgeDuration:  exported.ConsensusParams.Evidence.MaxAgeDuration,
+			MaxBytes:        exported.ConsensusParams.Evidence.MaxBytes,
+		},
+		Validator: tmproto.ValidatorParams{
+			PubKeyTypes: exported.ConsensusParams.Validator.PubKeyTypes,
+		},
+	}
+
+	encoded, err := tmjson.Marshal(doc)
+	require.NoError(t, err)
+	return sdk.MustSortJSON(encoded)
+}
+
+func TestStreamExportGenesis(t *testing.T) {
+	ibctesting.DefaultTestingAppInit = app.SetupTestingApp
+	coordinator := ibctesting.NewCoordinator(t, 2)
+	chainA := coordinator.GetChain(ibctesting.GetChainID(1))
+	path := newTransferPath(chainA, coordinator.GetChain(ibctesting.GetChainID(2)), false)
+	coordinator.Setup(path)
+	chainApp := chainA.App.(*app.App)
+
+	expected := exportGenesis(t, chainApp, newGenesisTemplate(chainA.ChainID))
+
+	var streamed bytes.Buffer
+	var progress []app.ExportProgress
+	require.NoError(t, chainApp.StreamExportGenesis(&streamed, newGenesisTemplate(chainA.ChainID), false, nil, func(p app.ExportProgress) {
+		progress = append(progress, p)
+	}))
+	require.Equal(t, string(expected), streamed.String())
+
+	var genesis struct {
+		AppState map[string]json.RawMessage `json:"app_state"`
+	}
+	require.NoError(t, json.Unmarshal(streamed.Bytes(), &genesis))
+	require.Len(t, progress, len(genesis.AppState))
+	for i, p := range progress {
+		require.Equal(t, i+1, p.Index)
+		require.Equal(t, len(progress), p.Total)
+		require.Equal(t, len(genesis.AppState[p.Module]), p.Size)
+	}
+
+	// the genesis split by module joins into the same genesis
+	dir := t.TempDir()
+	require.NoError(t, chainApp.StreamExportGenesisDir(dir, newGenesisTemplate(chainA.ChainID), false, nil, nil))
+	var joined bytes.Buffer
+	require.NoError(t, app.JoinGenesisDir(dir, &joined))
+	require.Equal(t, string(expected), joined.String())
+
+	// the exported genesis starts a new chain at the next height
+	doc, err := tmtypes.GenesisDocFromJSON(streamed.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, chainA.GetContext().BlockHeight(), doc.InitialHeight)
+	newApp := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, app.DefaultNodeHome, 5, app.MakeEncodingConfig(), app.EmptyAppOptions{})
+	res := newApp.InitChain(abci.RequestInitChain{
+		ChainId:         doc.ChainID,
+		InitialHeight:   doc.InitialHeight,
+		ConsensusParams: app.DefaultConsensusParams,
+		AppStateBytes:   doc.AppState,
+	})
+	require.Len(t, res.Validators, len(doc.Validators))
+}
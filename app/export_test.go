@@ -0,0 +1,148 @@
+package app_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos-builders/chaos/app"
+)
+
+func TestExportFilteredAppState(t *testing.T) {
+	ibctesting.DefaultTestingAppInit = app.SetupTestingApp
+	coordinator := ibctesting.NewCoordinator(t, 1)
+	chain := coordinator.GetChain(ibctesting.GetChainID(1))
+	chainApp := chain.App.(*app.App)
+	cdc := chainApp.AppCodec()
+	account := chain.SenderAccount.GetAddress().String()
+
+	exported, err := chainApp.ExportFilteredAppState(app.ExportFilter{
+		Modules:  []string{stakingtypes.ModuleName, authtypes.ModuleName, banktypes.ModuleName},
+		Accounts: []string{account},
+		Redact:   []string{"auth.accounts.pub_key", "staking.validators.description", "bank.unknown"},
+	}, false, nil)
+	require.NoError(t, err)
+
+	require.Equal(t, chainApp.LastBlockHeight()+1, exported.Height)
+	require.Equal(t, app.PartialExport{
+		Notice:                 app.PartialExportNotice,
+		Modules:                []string{authtypes.ModuleName, banktypes.ModuleName, stakingtypes.ModuleName},
+		Accounts:               []string{account},
+		AccountFilteredModules: []string{authtypes.ModuleName, banktypes.ModuleName, stakingtypes.ModuleName},
+		Redacted: map[string]int{
+			"auth.accounts.pub_key":          1,
+			"staking.validators.description": len(chain.Vals.Validators),
+			"bank.unknown":                   0,
+		},
+	}, exported.Partial)
+	require.Len(t, exported.AppState, 3)
+
+	var authState struct {
+		Accounts []map[string]interface{} `json:"accounts"`
+	}
+	require.NoError(t, json.Unmarshal(exported.AppState[authtypes.ModuleName], &authState))
+	require.Len(t, authState.Accounts, 1)
+	require.Equal(t, account, authState.Accounts[0]["address"])
+	require.Equal(t, "REDACTED", authState.Accounts[0]["pub_key"])
+
+	var bankState banktypes.GenesisState
+	cdc.MustUnmarshalJSON(exported.AppState[banktypes.ModuleName], &bankState)
+	require.Len(t, bankState.Balances, 1)
+	require.Equal(t, account, bankState.Balances[0].Address)
+	// the totals are not filtered
+	require.Equal(t, chainApp.BankKeeper.GetSupply(chainApp.BaseApp.NewContext(true, tmproto.Header{}), bankState.Supply[0].Denom), bankState.Supply[0])
+
+	var stakingState struct {
+		Validators  []map[string]interface{}  `json:"validators"`
+		Delegations []stakingtypes.Delegation `json:"delegations"`
+	}
+	require.NoError(t, json.Unmarshal(exported.AppState[stakingtypes.ModuleName], &stakingState))
+	require.Len(t, stakingState.Validators, len(chain.Vals.Validators))
+	for _, val := range stakingState.Validators {
+		require.Equal(t, "REDACTED", val["description"])
+	}
+	for _, del := range stakingState.Delegations {
+		require.Equal(t, account, del.DelegatorAddress)
+	}
+
+	for name, filter := range map[string]app.ExportFilter{
+		"unknown module":         {Modules: []string{"unknown"}},
+		"invalid account":        {Accounts: []string{"cosmos1invalid"}},
+		"redacted module":        {Redact: []string{"auth"}},
+		"not exported redaction": {Modules: []string{banktypes.ModuleName}, Redact: []string{"auth.accounts"}},
+	} {
+		_, err := chainApp.ExportFilteredAppState(filter, false, nil)
+		require.Error(t, err, name)
+	}
+}
+
+func TestZeroHeightGenesisDryRun(t *testing.T) {
+	ibctesting.DefaultTestingAppInit = app.SetupTestingApp
+	coordinator := ibctesting.NewCoordinator(t, 1)
+	chain := coordinator.GetChain(ibctesting.GetChainID(1))
+	chainApp := chain.App.(*app.App)
+
+	ctx := chain.GetContext()
+	validators := chainApp.StakingKeeper.GetAllValidators(ctx)
+	require.Greater(t, len(validators), 2)
+	delegator := chain.SenderAccount.GetAddress()
+	_, err := chainApp.StakingKeeper.Undelegate(ctx, delegator, validators[0].GetOperator(), sdk.NewDecWithPrec(5, 1))
+	require.NoError(t, err)
+	_, err = chainApp.StakingKeeper.BeginRedelegation(ctx, delegator, validators[1].GetOperator(), validators[2].GetOperator(), sdk.NewDecWithPrec(5, 1))
+	require.NoError(t, err)
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	require.NoError(t, chainApp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, rewards))
+	require.NoError(t, chainApp.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, distrtypes.ModuleName, rewards))
+	chainApp.DistrKeeper.AllocateTokensToValidator(ctx, validators[0], sdk.NewDecCoinsFromCoins(rewards...))
+	coordinator.CommitNBlocks(chain, 3)
+
+	exported, err := chainApp.ExportAppStateAndValidators(false, nil)
+	require.NoError(t, err)
+
+	allowed := validators[len(validators)-1].GetOperator().String()
+	report, err := chainApp.ZeroHeightGenesisDryRun([]string{allowed})
+	require.NoError(t, err)
+
+	require.NotEmpty(t, report.WithdrawnRewards)
+	for _, rewards := range report.WithdrawnRewards {
+		require.Equal(t, delegator.String(), rewards.Delegator)
+	}
+	require.Len(t, report.JailedValidators, len(validators)-1)
+	require.NotContains(t, report.JailedValidators, allowed)
+	require.Equal(t, []app.ResetEntries{{
+		Delegator:       delegator.String(),
+		Validator:       validators[1].OperatorAddress,
+		DstValidator:    validators[2].OperatorAddress,
+		CreationHeights: []int64{ctx.BlockHeight()},
+	}}, report.ResetRedelegations)
+	require.Equal(t, []app.ResetEntries{{
+		Delegator:       delegator.String(),
+		Validator:       validators[0].OperatorAddress,
+		CreationHeights: []int64{ctx.BlockHeight()},
+	}}, report.ResetUnbondingDelegations)
+
+	// the dry run does not change the state
+	afterDryRun, err := chainApp.ExportAppStateAndValidators(false, nil)
+	require.NoError(t, err)
+	require.Equal(t, string(exported.AppState), string(afterDryRun.AppState))
+
+	_, err = chainApp.ZeroHeightGenesisDryRun([]string{"invalid"})
+	require.Error(t, err)
+	_, err = chainApp.ExportAppStateAndValidators(true, []string{"invalid"})
+	require.Error(t, err)
+
+	zeroHeight, err := chainApp.ExportAppStateAndValidators(true, []string{allowed})
+	require.NoError(t, err)
+	require.Equal(t, int64(0), zeroHeight.Height)
+	require.Len(t, zeroHeight.Validators, 1)
+}
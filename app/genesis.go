@@ -4,6 +4,10 @@ import (
 	"encoding/json"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	ica "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts"
+	icatypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/types"
+
+	icaallowlisttypes "github.com/cosmos-builders/chaos/x/icaallowlist/types"
 )
 
 // The genesis state of the blockchain is represented here as a map of raw json
@@ -19,3 +23,18 @@ type GenesisState map[string]json.RawMessage
 func NewDefaultGenesisState(cdc codec.JSONCodec) GenesisState {
 	return ModuleBasics.DefaultGenesis(cdc)
 }
+
+// icaAppModuleBasic overrides the default genesis of the interchain accounts
+// module so that hosts start with a curated message allowlist.
+type icaAppModuleBasic struct {
+	ica.AppModuleBasic
+}
+
+// DefaultGenesis returns the interchain accounts default genesis state with
+// the default host allowlist of the icaallowlist module.
+func (icaAppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	genesis := icatypes.DefaultGenesis()
+	genesis.HostGenesisState.Params.AllowMessages = icaallowlisttypes.DefaultHostAllowMessages
+
+	return cdc.MustMarshalJSON(genesis)
+}
@@ -0,0 +1,145 @@
+package app_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v5/modules/apps/29-fee/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+
+	"github.com/cosmos-builders/chaos/app"
+)
+
+func chaosApp(chain *ibctesting.TestChain) *app.App {
+	return chain.App.(*app.App)
+}
+
+type FeeMiddlewareTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+}
+
+func TestFeeMiddlewareTestSuite(t *testing.T) {
+	suite.Run(t, new(FeeMiddlewareTestSuite))
+}
+
+func (suite *FeeMiddlewareTestSuite) SetupTest() {
+	ibctesting.DefaultTestingAppInit = app.SetupTestingApp
+
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+}
+
+func newTransferPath(chainA, chainB *ibctesting.TestChain, feeEnabled bool) *ibctesting.Path {
+	version := ibctransfertypes.Version
+	if feeEnabled {
+		version = string(ibcfeetypes.ModuleCdc.MustMarshalJSON(&ibcfeetypes.Metadata{
+			FeeVersion: ibcfeetypes.Version,
+			AppVersion: ibctransfertypes.Version,
+		}))
+	}
+
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointA.ChannelConfig.Version = version
+	path.EndpointB.ChannelConfig.Version = version
+
+	return path
+}
+
+func (suite *FeeMiddlewareTestSuite) transfer(path *ibctesting.Path, msgs ...sdk.Msg) channeltypes.Packet {
+	msgTransfer := ibctransfertypes.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+		sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+		suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(),
+		clienttypes.NewHeight(1, 110), 0,
+	)
+
+	res, err := suite.chainA.SendMsgs(append(msgs, msgTransfer)...)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	return packet
+}
+
+func (suite *FeeMiddlewareTestSuite) TestIncentivizedTransfer() {
+	path := newTransferPath(suite.chainA, suite.chainB, true)
+	suite.coordinator.Setup(path)
+
+	appA := chaosApp(suite.chainA)
+	suite.Require().True(appA.IBCFeeKeeper.IsFeeEnabled(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+	suite.Require().True(chaosApp(suite.chainB).IBCFeeKeeper.IsFeeEnabled(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+
+	// the relayer of chain A is paid the ack fee through its payee, the
+	// relayer of chain B is paid the recv fee through its counterparty payee
+	payee := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	counterpartyPayee := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	_, err := suite.chainA.SendMsgs(ibcfeetypes.NewMsgRegisterPayee(
+		path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+		suite.chainA.SenderAccount.GetAddress().String(), payee.String(),
+	))
+	suite.Require().NoError(err)
+
+	_, err = suite.chainB.SendMsgs(ibcfeetypes.NewMsgRegisterCounterpartyPayee(
+		path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID,
+		suite.chainB.SenderAccount.GetAddress().String(), counterpartyPayee.String(),
+	))
+	suite.Require().NoError(err)
+
+	fee := ibcfeetypes.NewFee(
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)),
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 25)),
+	)
+	msgPayPacketFee := ibcfeetypes.NewMsgPayPacketFee(
+		fee, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+		suite.chainA.SenderAccount.GetAddress().String(), nil,
+	)
+
+	packet := suite.transfer(path, msgPayPacketFee)
+
+	packetID := channeltypes.NewPacketID(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	_, found := appA.IBCFeeKeeper.GetFeesInEscrow(suite.chainA.GetContext(), packetID)
+	suite.Require().True(found)
+
+	suite.Require().NoError(path.RelayPacket(packet))
+
+	_, found = appA.IBCFeeKeeper.GetFeesInEscrow(suite.chainA.GetContext(), packetID)
+	suite.Require().False(found)
+
+	ctx := suite.chainA.GetContext()
+	suite.Require().Equal(fee.AckFee, appA.BankKeeper.GetAllBalances(ctx, payee))
+	suite.Require().Equal(fee.RecvFee, appA.BankKeeper.GetAllBalances(ctx, counterpartyPayee))
+	suite.Require().True(appA.BankKeeper.GetAllBalances(ctx, appA.AccountKeeper.GetModuleAddress(ibcfeetypes.ModuleName)).IsZero())
+}
+
+func (suite *FeeMiddlewareTestSuite) TestNonIncentivizedTransfer() {
+	path := newTransferPath(suite.chainA, suite.chainB, false)
+	suite.coordinator.Setup(path)
+
+	suite.Require().False(chaosApp(suite.chainA).IBCFeeKeeper.IsFeeEnabled(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+
+	packet := suite.transfer(path)
+	suite.Require().NoError(path.RelayPacket(packet))
+
+	voucherDenom := ibctransfertypes.ParseDenomTrace(
+		ibctransfertypes.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom),
+	).IBCDenom()
+	balance := chaosApp(suite.chainB).BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), voucherDenom)
+	suite.Require().Equal(sdk.NewInt(100), balance.Amount)
+}
@@ -0,0 +1,185 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	icahosttypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+
+	"github.com/cosmos-builders/chaos/app"
+	intertxtypes "github.com/cosmos-builders/chaos/x/intertx/types"
+)
+
+// IBCTestSuite runs the handshakes and packet flows of the IBC applications
+// between two in-process chaos chains.
+type IBCTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+}
+
+func TestIBCTestSuite(t *testing.T) {
+	suite.Run(t, new(IBCTestSuite))
+}
+
+func (suite *IBCTestSuite) SetupTest() {
+	ibctesting.DefaultTestingAppInit = app.SetupTestingApp
+
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+}
+
+func (suite *IBCTestSuite) requireChannelsOpen(path *ibctesting.Path) {
+	suite.Require().Equal(channeltypes.OPEN, path.EndpointA.GetChannel().State)
+	suite.Require().Equal(channeltypes.OPEN, path.EndpointB.GetChannel().State)
+}
+
+// transfer sends amount of denom from the chain of endpoint to its
+// counterparty and relays the packet.
+func (suite *IBCTestSuite) transfer(path *ibctesting.Path, endpoint *ibctesting.Endpoint, denom string, amount int64) {
+	msg := ibctransfertypes.NewMsgTransfer(
+		endpoint.ChannelConfig.PortID, endpoint.ChannelID,
+		sdk.NewInt64Coin(denom, amount),
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+		endpoint.Counterparty.Chain.SenderAccount.GetAddress().String(),
+		clienttypes.NewHeight(1, 110), 0,
+	)
+
+	res, err := endpoint.Chain.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(path.RelayPacket(packet))
+}
+
+func (suite *IBCTestSuite) TestTransfer() {
+	path := newTransferPath(suite.chainA, suite.chainB, false)
+	suite.coordinator.Setup(path)
+	suite.requireChannelsOpen(path)
+
+	appA, appB := chaosApp(suite.chainA), chaosApp(suite.chainB)
+	escrow := ibctransfertypes.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	voucherDenom := ibctransfertypes.ParseDenomTrace(
+		ibctransfertypes.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom),
+	).IBCDenom()
+
+	// chain A escrows the tokens, chain B mints vouchers
+	suite.transfer(path, path.EndpointA, sdk.DefaultBondDenom, 100)
+	suite.Require().Equal(sdk.NewInt(100), appA.BankKeeper.GetBalance(suite.chainA.GetContext(), escrow, sdk.DefaultBondDenom).Amount)
+	suite.Require().Equal(sdk.NewInt(100), appB.BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), voucherDenom).Amount)
+	suite.Require().Equal(sdk.NewInt(100), appB.BankKeeper.GetSupply(suite.chainB.GetContext(), voucherDenom).Amount)
+
+	// chain B burns the vouchers sent back, chain A unescrows the tokens
+	suite.transfer(path, path.EndpointB, voucherDenom, 100)
+	suite.Require().True(appA.BankKeeper.GetBalance(suite.chainA.GetContext(), escrow, sdk.DefaultBondDenom).IsZero())
+	suite.Require().True(appB.BankKeeper.GetSupply(suite.chainB.GetContext(), voucherDenom).IsZero())
+}
+
+func newICAPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	version := string(icatypes.ModuleCdc.MustMarshalJSON(&icatypes.Metadata{
+		Version:                icatypes.Version,
+		ControllerConnectionId: ibctesting.FirstConnectionID,
+		HostConnectionId:       ibctesting.FirstConnectionID,
+		Encoding:               icatypes.EncodingProtobuf,
+		TxType:                 icatypes.TxTypeSDKMultiMsg,
+	}))
+
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = icatypes.PortID
+	path.EndpointB.ChannelConfig.PortID = icatypes.PortID
+	path.EndpointA.ChannelConfig.Order = channeltypes.ORDERED
+	path.EndpointB.ChannelConfig.Order = channeltypes.ORDERED
+	path.EndpointA.ChannelConfig.Version = version
+	path.EndpointB.ChannelConfig.Version = version
+
+	return path
+}
+
+// submitTx executes msgs through the interchain account of owner and returns
+// the acknowledgement written by the host chain.
+func (suite *IBCTestSuite) submitTx(path *ibctesting.Path, owner string, msgs ...sdk.Msg) channeltypes.Acknowledgement {
+	msg, err := intertxtypes.NewMsgSubmitTx(owner, path.EndpointA.ConnectionID, msgs, uint64(time.Hour))
+	suite.Require().NoError(err)
+
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(path.EndpointB.UpdateClient())
+	res, err = path.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+	bz, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	suite.Require().NoError(path.EndpointA.AcknowledgePacket(packet, bz))
+
+	var ack channeltypes.Acknowledgement
+	suite.Require().NoError(icatypes.ModuleCdc.UnmarshalJSON(bz, &ack))
+
+	return ack
+}
+
+func (suite *IBCTestSuite) TestICAHost() {
+	path := newICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	// the controller channel is opened by registering an interchain account
+	owner := suite.chainA.SenderAccount.GetAddress().String()
+	portID, err := icatypes.NewControllerPortID(owner)
+	suite.Require().NoError(err)
+
+	channelSequence := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextChannelSequence(suite.chainA.GetContext())
+	_, err = suite.chainA.SendMsgs(intertxtypes.NewMsgRegisterAccount(owner, path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.Version))
+	suite.Require().NoError(err)
+
+	path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(channelSequence)
+	path.EndpointA.ChannelConfig.PortID = portID
+	suite.Require().NoError(path.EndpointB.ChanOpenTry())
+	suite.Require().NoError(path.EndpointA.ChanOpenAck())
+	suite.Require().NoError(path.EndpointB.ChanOpenConfirm())
+	suite.requireChannelsOpen(path)
+
+	hostApp := chaosApp(suite.chainB)
+	icaAddress, found := hostApp.ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, portID)
+	suite.Require().True(found)
+	controllerAddress, found := chaosApp(suite.chainA).ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), path.EndpointA.ConnectionID, portID)
+	suite.Require().True(found)
+	suite.Require().Equal(icaAddress, controllerAddress)
+
+	icaAddr, err := sdk.AccAddressFromBech32(icaAddress)
+	suite.Require().NoError(err)
+
+	hostApp.ICAHostKeeper.SetParams(suite.chainB.GetContext(), icahosttypes.NewParams(true, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}))
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000))
+	_, err = suite.chainB.SendMsgs(banktypes.NewMsgSend(suite.chainB.SenderAccount.GetAddress(), icaAddr, amount))
+	suite.Require().NoError(err)
+
+	// allowed messages are executed by the interchain account
+	recipient := suite.chainB.SenderAccounts[1].SenderAccount.GetAddress()
+	ack := suite.submitTx(path, owner, banktypes.NewMsgSend(icaAddr, recipient, amount))
+	suite.Require().True(ack.Success())
+	suite.Require().True(hostApp.BankKeeper.GetAllBalances(suite.chainB.GetContext(), icaAddr).IsZero())
+
+	// other messages are rejected with an error acknowledgement
+	validator := hostApp.StakingKeeper.GetAllValidators(suite.chainB.GetContext())[0].GetOperator()
+	ack = suite.submitTx(path, owner, stakingtypes.NewMsgDelegate(icaAddr, validator, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+	suite.Require().False(ack.Success())
+}
@@ -0,0 +1,134 @@
+// Package inplace rewrites the Tendermint state of a stopped node so that it
+// restarts as the single validator of its chain, to fork the state of a
+// chain into a local testnet.
+package inplace
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	tmcfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/node"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	tmtypes "github.com/tendermint/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+)
+
+// Block is the last block of a forked chain.
+type Block struct {
+	ChainID string
+	Height  int64
+	Time    time.Time
+}
+
+// Fork rewrites the state and the block store of a node so that the
+// validator of a private key, with a voting power, is the single validator of
+// the next blocks:
+//   - the validator sets of the state are replaced by the single validator,
+//   - the commit of the last block is signed by the validator alone, as the
+//     next block holds it,
+//   - the consensus WAL, holding the messages of the former validators, is
+//     removed.
+//
+// The app state must be changed accordingly before the next block, see
+// app.InPlaceTestnet.
+func Fork(config *tmcfg.Config, dbProvider node.DBProvider, privKey crypto.PrivKey, power int64) (Block, error) {
+	blockStoreDB, err := dbProvider(&node.DBContext{ID: "blockstore", Config: config})
+	if err != nil {
+		return Block{}, err
+	}
+	defer blockStoreDB.Close()
+	blockStore := store.NewBlockStore(blockStoreDB)
+
+	stateDB, err := dbProvider(&node.DBContext{ID: "state", Config: config})
+	if err != nil {
+		return Block{}, err
+	}
+	defer stateDB.Close()
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{DiscardABCIResponses: config.Storage.DiscardABCIResponses})
+
+	state, err := stateStore.Load()
+	if err != nil {
+		return Block{}, err
+	}
+	if state.IsEmpty() || state.LastBlockHeight == 0 {
+		return Block{}, fmt.Errorf("no block committed in %s", config.DBDir())
+	}
+	height := state.LastBlockHeight
+	if blockStore.Height() != height {
+		return Block{}, fmt.Errorf("the block store height %d does not match the state height %d, restart the node to sync them", blockStore.Height(), height)
+	}
+	meta := blockStore.LoadBlockMeta(height)
+	seenCommit := blockStore.LoadSeenCommit(height)
+	if meta == nil || seenCommit == nil {
+		return Block{}, fmt.Errorf("block %d not found", height)
+	}
+
+	validator := tmtypes.NewValidator(privKey.PubKey(), power)
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{validator})
+
+	commit, err := signCommit(privKey, state.ChainID, height, seenCommit.Round, state.LastBlockID, valSet)
+	if err != nil {
+		return Block{}, err
+	}
+	if err := blockStore.SaveSeenCommit(height, commit); err != nil {
+		return Block{}, err
+	}
+
+	state.LastValidators = valSet.Copy()
+	state.Validators = valSet.Copy()
+	state.NextValidators = valSet.Copy()
+	state.LastHeightValidatorsChanged = height + 1
+	// saves the validator sets of the last, current and next heights
+	if err := stateStore.Bootstrap(state); err != nil {
+		return Block{}, err
+	}
+
+	if err := os.RemoveAll(filepath.Dir(config.Consensus.WalFile())); err != nil {
+		return Block{}, err
+	}
+
+	return Block{
+		ChainID: state.ChainID,
+		Height:  height,
+		Time:    meta.Header.Time,
+	}, nil
+}
+
+// signCommit returns the commit of a block signed by the single validator of
+// a validator set.
+func signCommit(
+	privKey crypto.PrivKey, chainID string, height int64, round int32, blockID tmtypes.BlockID, valSet *tmtypes.ValidatorSet,
+) (*tmtypes.Commit, error) {
+	vote := &tmtypes.Vote{
+		Type:    tmproto.PrecommitType,
+		Height:  height,
+		Round:   round,
+		BlockID: blockID,
+		// the time of the next block is the time of the commit, which must be
+		// after the time of the last block
+		Timestamp:        tmtime.Now(),
+		ValidatorAddress: privKey.PubKey().Address(),
+		ValidatorIndex:   0,
+	}
+	signature, err := privKey.Sign(tmtypes.VoteSignBytes(chainID, vote.ToProto()))
+	if err != nil {
+		return nil, err
+	}
+
+	commit := tmtypes.NewCommit(height, round, blockID, []tmtypes.CommitSig{{
+		BlockIDFlag:      tmtypes.BlockIDFlagCommit,
+		ValidatorAddress: vote.ValidatorAddress,
+		Timestamp:        vote.Timestamp,
+		Signature:        signature,
+	}})
+	if err := valSet.VerifyCommit(chainID, blockID, height, commit); err != nil {
+		return nil, err
+	}
+	return commit, nil
+}
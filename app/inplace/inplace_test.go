@@ -0,0 +1,115 @@
+package inplace_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/privval"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/cosmos-builders/chaos/app"
+	"github.com/cosmos-builders/chaos/app/inplace"
+	"github.com/cosmos-builders/chaos/app/scenario"
+)
+
+func TestFork(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping in-process network test in short mode")
+	}
+
+	s, err := scenario.Parse([]byte("validators: 2\nduration: 1m\ntimeout_commit: 300ms\n"))
+	require.NoError(t, err)
+	n, err := scenario.NewNetwork(t.TempDir(), s, log.NewNopLogger())
+	require.NoError(t, err)
+	defer n.Close()
+	require.NoError(t, n.Start())
+
+	v := n.Validators[0]
+	waitFor(t, func() bool { return v.Height() >= 3 })
+	n.Stop()
+
+	// the node of the testnet has a consensus key of its own
+	config := v.Config()
+	filePV := privval.GenFilePV(config.PrivValidatorKeyFile(), config.PrivValidatorStateFile())
+	filePV.Save()
+	block, err := inplace.Fork(config, v.DB, filePV.Key.PrivKey, 10)
+	require.NoError(t, err)
+	require.Equal(t, s.ChainID, block.ChainID)
+
+	operator := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	funded := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	fundCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 123456))
+	require.NoError(t, app.WriteInPlaceTestnet(config.RootDir, app.InPlaceTestnet{
+		Height:          block.Height,
+		Time:            block.Time,
+		ChainID:         block.ChainID,
+		ValidatorPubKey: filePV.Key.PubKey.Bytes(),
+		ValidatorPower:  10,
+		Operator:        operator.String(),
+		Moniker:         "testnet",
+		AccountsToFund:  []string{funded.String()},
+		FundCoins:       fundCoins,
+		VotingPeriod:    time.Minute,
+	}))
+
+	// the node restarts alone as the single validator
+	require.NoError(t, n.StartValidator(v))
+	waitFor(t, func() bool { return v.Height() > block.Height+3 })
+
+	var validators stakingtypes.QueryValidatorsResponse
+	require.NoError(t, n.Query(
+		"/cosmos.staking.v1beta1.Query/Validators",
+		&stakingtypes.QueryValidatorsRequest{Status: stakingtypes.Bonded.String()},
+		&validators,
+	))
+	require.Len(t, validators.Validators, 1)
+	require.Equal(t, sdk.ValAddress(operator).String(), validators.Validators[0].OperatorAddress)
+	require.Equal(t, int64(10), validators.Validators[0].ConsensusPower(sdk.DefaultPowerReduction))
+
+	var signingInfo slashingtypes.QuerySigningInfoResponse
+	require.NoError(t, n.Query(
+		"/cosmos.slashing.v1beta1.Query/SigningInfo",
+		&slashingtypes.QuerySigningInfoRequest{ConsAddress: sdk.ConsAddress(filePV.Key.Address).String()},
+		&signingInfo,
+	))
+	require.Equal(t, block.Height, signingInfo.ValSigningInfo.StartHeight)
+	require.Zero(t, signingInfo.ValSigningInfo.MissedBlocksCounter)
+
+	var balance banktypes.QueryAllBalancesResponse
+	require.NoError(t, n.Query(
+		"/cosmos.bank.v1beta1.Query/AllBalances",
+		&banktypes.QueryAllBalancesRequest{Address: funded.String()},
+		&balance,
+	))
+	require.Equal(t, fundCoins, balance.Balances)
+
+	var govParams govv1.QueryParamsResponse
+	require.NoError(t, n.Query(
+		"/cosmos.gov.v1.Query/Params",
+		&govv1.QueryParamsRequest{ParamsType: govv1.ParamVoting},
+		&govParams,
+	))
+	require.Equal(t, time.Minute, *govParams.VotingParams.VotingPeriod)
+
+	// the in-place testnet file is removed once applied
+	require.NoError(t, n.StopValidator(v))
+	require.NoError(t, n.StartValidator(v))
+	_, err = os.Stat(filepath.Join(config.RootDir, "data", app.InPlaceTestnetFile))
+	require.True(t, os.IsNotExist(err), err)
+	height := v.Height()
+	waitFor(t, func() bool { return v.Height() > height+2 })
+}
+
+func waitFor(t *testing.T, condition func() bool) {
+	require.Eventually(t, condition, 30*time.Second, 100*time.Millisecond)
+}
@@ -0,0 +1,535 @@
+package scenario
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	tmcfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/node"
+	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/privval"
+	"github.com/tendermint/tendermint/proxy"
+	tmtypes "github.com/tendermint/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutil "github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos-builders/chaos/app"
+	appparams "github.com/cosmos-builders/chaos/app/params"
+)
+
+var (
+	// accountTokens are the genesis tokens of the validators and of the load
+	// account.
+	accountTokens = sdk.TokensFromConsensusPower(1_000_000_000, sdk.DefaultPowerReduction)
+	// bondedTokens are the tokens bonded by each validator.
+	bondedTokens = sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+)
+
+// Network is an in-process network of validators running App, whose
+// validators can be stopped, restarted and partitioned.
+type Network struct {
+	ChainID    string
+	Validators []*Validator
+	// Load is the account sending the transaction load.
+	Load *Account
+
+	dir            string
+	logger         log.Logger
+	encodingConfig appparams.EncodingConfig
+
+	mtx sync.Mutex
+	// groups are the partition groups of the validators by node ID, nil if
+	// the network is not partitioned.
+	groups map[string]int
+}
+
+// Validator is a validator of a Network.
+type Validator struct {
+	*Account
+
+	Index       int
+	Moniker     string
+	NodeID      string
+	ConsAddress sdk.ConsAddress
+	// RPCAddress is the Tendermint RPC address of the validator, exposed by
+	// the first validator only.
+	RPCAddress string
+
+	consPubKey cryptotypes.PubKey
+	config     *tmcfg.Config
+	dbs        map[string]dbm.DB
+
+	mtx sync.Mutex
+	// node and app are the last started node and app of the validator.
+	node    *node.Node
+	app     *app.App
+	running bool
+}
+
+// Account is an account of the network whose key is held by the runner.
+type Account struct {
+	Address sdk.AccAddress
+	PrivKey cryptotypes.PrivKey
+
+	mtx    sync.Mutex
+	synced bool
+	accNum uint64
+	seq    uint64
+}
+
+func newAccount() *Account {
+	privKey := secp256k1.GenPrivKey()
+	return &Account{
+		Address: sdk.AccAddress(privKey.PubKey().Address()),
+		PrivKey: privKey,
+	}
+}
+
+// NewNetwork initializes the configuration, keys and genesis of the
+// validators of a scenario in a directory. The network is not started.
+func NewNetwork(dir string, s Scenario, logger log.Logger) (*Network, error) {
+	n := &Network{
+		ChainID:        s.ChainID,
+		Validators:     make([]*Validator, s.Validators),
+		Load:           newAccount(),
+		dir:            dir,
+		logger:         logger,
+		encodingConfig: app.MakeEncodingConfig(),
+	}
+
+	p2pAddresses := make([]string, s.Validators)
+	for i := range n.Validators {
+		v, err := n.newValidator(i, s)
+		if err != nil {
+			return nil, err
+		}
+		n.Validators[i] = v
+
+		p2pURL, err := url.Parse(v.config.P2P.ListenAddress)
+		if err != nil {
+			return nil, err
+		}
+		p2pAddresses[i] = fmt.Sprintf("%s@127.0.0.1:%s", v.NodeID, p2pURL.Port())
+	}
+
+	for i, v := range n.Validators {
+		peers := make([]string, 0, len(p2pAddresses)-1)
+		for j, address := range p2pAddresses {
+			if j != i {
+				peers = append(peers, address)
+			}
+		}
+		v.config.P2P.PersistentPeers = strings.Join(peers, ",")
+		tmcfg.WriteConfigFile(filepath.Join(v.config.RootDir, "config", "config.toml"), v.config)
+	}
+
+	genDoc, err := n.genesisDoc(s)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range n.Validators {
+		if err := genDoc.SaveAs(v.config.GenesisFile()); err != nil {
+			return nil, err
+		}
+	}
+
+	return n, nil
+}
+
+func (n *Network) newValidator(index int, s Scenario) (*Validator, error) {
+	moniker := fmt.Sprintf("validator%d", index)
+
+	config := tmcfg.DefaultConfig()
+	config.SetRoot(filepath.Join(n.dir, moniker))
+	config.Moniker = moniker
+	config.Consensus.TimeoutCommit = s.TimeoutCommit
+	config.P2P.AddrBookStrict = false
+	config.P2P.AllowDuplicateIP = true
+	config.P2P.PexReactor = false
+	config.FilterPeers = true
+	config.TxIndex.Indexer = "null"
+	config.Instrumentation.Prometheus = false
+
+	p2pAddress, _, err := server.FreeTCPAddr()
+	if err != nil {
+		return nil, err
+	}
+	config.P2P.ListenAddress = p2pAddress
+
+	// the Tendermint RPC environment is global, only one node of the process
+	// can expose it
+	config.RPC.ListenAddress = ""
+	if index == 0 {
+		if config.RPC.ListenAddress, _, err = server.FreeTCPAddr(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Join(config.RootDir, "config"), 0o755); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Join(config.RootDir, "data"), 0o755); err != nil {
+		return nil, err
+	}
+
+	nodeID, pubKey, err := genutil.InitializeNodeValidatorFiles(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Validator{
+		Account:     newAccount(),
+		Index:       index,
+		Moniker:     moniker,
+		NodeID:      nodeID,
+		ConsAddress: sdk.ConsAddress(pubKey.Address()),
+		RPCAddress:  config.RPC.ListenAddress,
+		consPubKey:  pubKey,
+		config:      config,
+		dbs:         make(map[string]dbm.DB),
+	}, nil
+}
+
+// genesisDoc returns the genesis of the network: the validators are created
+// by genesis transactions and the proposals are voted during the voting
+// period of the scenario.
+func (n *Network) genesisDoc(s Scenario) (*tmtypes.GenesisDoc, error) {
+	cdc := n.encodingConfig.Marshaler
+	genesisState := app.ModuleBasics.DefaultGenesis(cdc)
+
+	accounts := []*Account{n.Load}
+	genTxs := make([]sdk.Tx, len(n.Validators))
+	for i, v := range n.Validators {
+		accounts = append(accounts, v.Account)
+
+		msg, err := stakingtypes.NewMsgCreateValidator(
+			sdk.ValAddress(v.Address),
+			v.consPubKey,
+			sdk.NewCoin(sdk.DefaultBondDenom, bondedTokens),
+			stakingtypes.NewDescription(v.Moniker, "", "", "", ""),
+			stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.OneDec(), sdk.OneDec()),
+			sdk.OneInt(),
+		)
+		if err != nil {
+			return nil, err
+		}
+		if genTxs[i], err = n.signTx(v.Account, 0, 0, msg); err != nil {
+			return nil, err
+		}
+	}
+
+	genAccounts := make([]authtypes.GenesisAccount, len(accounts))
+	balances := make([]banktypes.Balance, len(accounts))
+	for i, account := range accounts {
+		genAccounts[i] = authtypes.NewBaseAccount(account.Address, nil, uint64(i), 0)
+		balances[i] = banktypes.Balance{
+			Address: account.Address.String(),
+			Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, accountTokens)),
+		}
+	}
+
+	var authGenesis authtypes.GenesisState
+	cdc.MustUnmarshalJSON(genesisState[authtypes.ModuleName], &authGenesis)
+	packedAccounts, err := authtypes.PackAccounts(genAccounts)
+	if err != nil {
+		return nil, err
+	}
+	authGenesis.Accounts = append(authGenesis.Accounts, packedAccounts...)
+	genesisState[authtypes.ModuleName] = cdc.MustMarshalJSON(&authGenesis)
+
+	var bankGenesis banktypes.GenesisState
+	cdc.MustUnmarshalJSON(genesisState[banktypes.ModuleName], &bankGenesis)
+	bankGenesis.Balances = append(bankGenesis.Balances, balances...)
+	genesisState[banktypes.ModuleName] = cdc.MustMarshalJSON(&bankGenesis)
+
+	var govGenesis govv1.GenesisState
+	cdc.MustUnmarshalJSON(genesisState[govtypes.ModuleName], &govGenesis)
+	votingPeriod := s.VotingPeriod
+	govGenesis.VotingParams.VotingPeriod = &votingPeriod
+	genesisState[govtypes.ModuleName] = cdc.MustMarshalJSON(&govGenesis)
+
+	if s.SignedBlocksWindow > 0 {
+		var slashingGenesis slashingtypes.GenesisState
+		cdc.MustUnmarshalJSON(genesisState[slashingtypes.ModuleName], &slashingGenesis)
+		slashingGenesis.Params.SignedBlocksWindow = s.SignedBlocksWindow
+		genesisState[slashingtypes.ModuleName] = cdc.MustMarshalJSON(&slashingGenesis)
+	}
+
+	genesisState[genutiltypes.ModuleName] = cdc.MustMarshalJSON(
+		genutiltypes.NewGenesisStateFromTx(n.encodingConfig.TxConfig.TxJSONEncoder(), genTxs),
+	)
+
+	appState, err := json.MarshalIndent(genesisState, "", " ")
+	if err != nil {
+		return nil, err
+	}
+
+	return &tmtypes.GenesisDoc{
+		GenesisTime:     tmtime.Now(),
+		ChainID:         n.ChainID,
+		ConsensusParams: tmtypes.DefaultConsensusParams(),
+		AppState:        appState,
+	}, nil
+}
+
+// Start starts every validator of the network.
+func (n *Network) Start() error {
+	for _, v := range n.Validators {
+		if err := n.StartValidator(v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// StartValidator starts a validator on its data, replaying the blocks its
+// app did not commit.
+func (n *Network) StartValidator(v *Validator) error {
+	if v.Running() {
+		return fmt.Errorf("%s is already running", v.Moniker)
+	}
+
+	logger := n.logger.With("module", v.Moniker)
+
+	db, err := v.DB(&node.DBContext{ID: "application", Config: v.config})
+	if err != nil {
+		return err
+	}
+	chainApp := app.New(
+		logger, db, nil, true, map[int64]bool{}, v.config.RootDir, 0, n.encodingConfig, app.EmptyAppOptions{},
+		func(bapp *baseapp.BaseApp) {
+			bapp.SetIDPeerFilter(n.peerFilter(v))
+		},
+	)
+
+	nodeKey, err := p2p.LoadNodeKey(v.config.NodeKeyFile())
+	if err != nil {
+		return err
+	}
+	tmNode, err := node.NewNode(
+		v.config,
+		privval.LoadFilePV(v.config.PrivValidatorKeyFile(), v.config.PrivValidatorStateFile()),
+		nodeKey,
+		proxy.NewLocalClientCreator(chainApp),
+		node.DefaultGenesisDocProviderFunc(v.config),
+		v.DB,
+		node.DefaultMetricsProvider(v.config.Instrumentation),
+		logger,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := tmNode.Start(); err != nil {
+		return err
+	}
+
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	v.node, v.app, v.running = tmNode, chainApp, true
+
+	return nil
+}
+
+// StopValidator stops a validator. Its data is kept to be restarted.
+func (n *Network) StopValidator(v *Validator) error {
+	if !v.Running() {
+		return fmt.Errorf("%s is not running", v.Moniker)
+	}
+
+	tmNode, _ := v.current()
+	if err := tmNode.Stop(); err != nil {
+		return err
+	}
+	tmNode.Wait()
+
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	v.running = false
+
+	return nil
+}
+
+// Stop stops the running validators of the network.
+func (n *Network) Stop() {
+	for _, v := range n.Validators {
+		if v.Running() {
+			if err := n.StopValidator(v); err != nil {
+				n.logger.Error("failed to stop validator", "validator", v.Moniker, "err", err)
+			}
+		}
+	}
+}
+
+// Close stops the network and closes the databases of the validators, which
+// are reopened if a validator is restarted.
+func (n *Network) Close() {
+	n.Stop()
+
+	for _, v := range n.Validators {
+		for name, db := range v.dbs {
+			if err := db.(keptDB).DB.Close(); err != nil {
+				n.logger.Error("failed to close database", "validator", v.Moniker, "db", name, "err", err)
+			}
+		}
+		v.dbs = make(map[string]dbm.DB)
+	}
+}
+
+// Partition splits the validators into groups of peers, disconnecting the
+// peers of different groups. Validators missing from the groups are
+// isolated.
+func (n *Network) Partition(groups [][]int) {
+	n.mtx.Lock()
+	n.groups = make(map[string]int)
+	for i, group := range groups {
+		for _, index := range group {
+			n.groups[n.Validators[index].NodeID] = i
+		}
+	}
+	n.mtx.Unlock()
+
+	for _, v := range n.Validators {
+		if !v.Running() {
+			continue
+		}
+		tmNode, _ := v.current()
+		for _, peer := range tmNode.Switch().Peers().List() {
+			if !n.connected(v.NodeID, string(peer.ID())) {
+				tmNode.Switch().StopPeerGracefully(peer)
+			}
+		}
+	}
+}
+
+// Heal removes the partition of the network and dials the missing peers.
+func (n *Network) Heal() error {
+	n.mtx.Lock()
+	n.groups = nil
+	n.mtx.Unlock()
+
+	for _, v := range n.Validators {
+		if !v.Running() {
+			continue
+		}
+		tmNode, _ := v.current()
+		peers := strings.Split(v.config.P2P.PersistentPeers, ",")
+		if err := tmNode.Switch().DialPeersAsync(peers); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// connected returns true if two nodes are in the same partition group.
+func (n *Network) connected(nodeID, peerID string) bool {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	if n.groups == nil {
+		return true
+	}
+
+	group, ok := n.groups[nodeID]
+	peerGroup, peerOK := n.groups[peerID]
+	return ok && peerOK && group == peerGroup
+}
+
+// peerFilter returns the filter of the peers of a validator, rejecting the
+// peers of other partition groups.
+func (n *Network) peerFilter(v *Validator) sdk.PeerFilter {
+	return func(peerID string) abci.ResponseQuery {
+		if !n.connected(v.NodeID, peerID) {
+			return abci.ResponseQuery{Code: 1, Log: "partitioned"}
+		}
+		return abci.ResponseQuery{}
+	}
+}
+
+// Running returns true if the validator is running.
+func (v *Validator) Running() bool {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+
+	return v.running
+}
+
+// current returns the last started node and app of the validator, nil if it
+// was never started.
+func (v *Validator) current() (*node.Node, *app.App) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+
+	return v.node, v.app
+}
+
+// Config returns the Tendermint configuration of the node of the validator.
+func (v *Validator) Config() *tmcfg.Config {
+	return v.config
+}
+
+// PrivValidatorKeyFile returns the path of the consensus key file of the
+// validator.
+func (v *Validator) PrivValidatorKeyFile() string {
+	return v.config.PrivValidatorKeyFile()
+}
+
+// Height returns the height of the block store of the validator, 0 if it was
+// never started.
+func (v *Validator) Height() int64 {
+	tmNode, _ := v.current()
+	if tmNode == nil {
+		return 0
+	}
+	return tmNode.BlockStore().Height()
+}
+
+// keptDB is a database kept open when the node closes it, to be reopened by
+// the node when the validator restarts.
+type keptDB struct {
+	dbm.DB
+}
+
+// Close implements DB.
+func (keptDB) Close() error {
+	return nil
+}
+
+// DB is the database provider of the node of the validator, opening each
+// database once. The databases are closed by the network.
+func (v *Validator) DB(ctx *node.DBContext) (dbm.DB, error) {
+	if db, ok := v.dbs[ctx.ID]; ok {
+		return db, nil
+	}
+
+	db, err := dbm.NewGoLevelDB(ctx.ID, ctx.Config.DBDir())
+	if err != nil {
+		return nil, err
+	}
+	v.dbs[ctx.ID] = keptDB{db}
+
+	return v.dbs[ctx.ID], nil
+}
@@ -0,0 +1,108 @@
+package scenario
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Report is the report of the run of a scenario.
+type Report struct {
+	Scenario string        `yaml:"scenario"`
+	ChainID  string        `yaml:"chain_id"`
+	Duration time.Duration `yaml:"duration"`
+	// Height is the highest block of the network.
+	Height int64 `yaml:"height"`
+	// Halted is true if no block was committed during the halt timeout of
+	// the scenario at the end of the run.
+	Halted     bool       `yaml:"halted"`
+	BlockTimes BlockTimes `yaml:"block_times"`
+	// MissedBlocks are the number of blocks missed by each validator.
+	MissedBlocks map[string]int64 `yaml:"missed_blocks"`
+	// Jailed are the validators jailed at the end of the run.
+	Jailed     []string          `yaml:"jailed"`
+	Invariants []InvariantResult `yaml:"invariants"`
+	Load       LoadResult        `yaml:"load"`
+	Actions    []ActionResult    `yaml:"actions"`
+	// Failures are the failed expectations of the scenario.
+	Failures []string `yaml:"failures"`
+	Passed   bool     `yaml:"passed"`
+}
+
+// BlockTimes are the times between the blocks of the network.
+type BlockTimes struct {
+	Average time.Duration `yaml:"average"`
+	Max     time.Duration `yaml:"max"`
+	// MaxHeight is the block committed after the longest time.
+	MaxHeight int64 `yaml:"max_height"`
+}
+
+// InvariantResult is the result of an invariant on the state of the network
+// at the end of the run.
+type InvariantResult struct {
+	Route   string `yaml:"route"`
+	Broken  bool   `yaml:"broken"`
+	Message string `yaml:"message,omitempty"`
+}
+
+// LoadResult counts the transactions of the load accepted and rejected by
+// the mempool.
+type LoadResult struct {
+	Accepted int `yaml:"accepted"`
+	Rejected int `yaml:"rejected"`
+}
+
+// ActionResult is the result of an action of the timeline.
+type ActionResult struct {
+	At     time.Duration `yaml:"at"`
+	Action string        `yaml:"action"`
+	// Started is the time the action started, later than At if the previous
+	// action was slow.
+	Started time.Duration `yaml:"started"`
+	Error   string        `yaml:"error,omitempty"`
+}
+
+// evaluate checks the expectations of a scenario on the report.
+func (r *Report) evaluate(expect Expect) {
+	fail := func(format string, args ...interface{}) {
+		r.Failures = append(r.Failures, fmt.Sprintf(format, args...))
+	}
+
+	if r.Halted != expect.Halted {
+		fail("expected halted %t, got %t", expect.Halted, r.Halted)
+	}
+	if r.Height < expect.MinHeight {
+		fail("expected min height %d, got %d", expect.MinHeight, r.Height)
+	}
+	if expect.MaxBlockTime > 0 && r.BlockTimes.Max > expect.MaxBlockTime {
+		fail("expected max block time %s, got %s at height %d", expect.MaxBlockTime, r.BlockTimes.Max, r.BlockTimes.MaxHeight)
+	}
+	if expect.MaxMissedBlocks != nil {
+		monikers := make([]string, 0, len(r.MissedBlocks))
+		for moniker := range r.MissedBlocks {
+			monikers = append(monikers, moniker)
+		}
+		sort.Strings(monikers)
+		for _, moniker := range monikers {
+			if missed := r.MissedBlocks[moniker]; missed > *expect.MaxMissedBlocks {
+				fail("expected max %d missed blocks, %s missed %d", *expect.MaxMissedBlocks, moniker, missed)
+			}
+		}
+	}
+	if expect.MaxJailed != nil && len(r.Jailed) > *expect.MaxJailed {
+		fail("expected max %d jailed validators, got %d", *expect.MaxJailed, len(r.Jailed))
+	}
+
+	for _, invariant := range r.Invariants {
+		if invariant.Broken {
+			fail("invariant %s is broken", invariant.Route)
+		}
+	}
+	for _, action := range r.Actions {
+		if action.Error != "" {
+			fail("action %s at %s failed: %s", action.Action, action.At, action.Error)
+		}
+	}
+
+	r.Passed = len(r.Failures) == 0
+}
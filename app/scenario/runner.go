@@ -0,0 +1,473 @@
+package scenario
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	sm "github.com/tendermint/tendermint/state"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+)
+
+const (
+	// monitorInterval is the interval between two polls of the heights of
+	// the validators.
+	monitorInterval = 100 * time.Millisecond
+	// transferTimeout is the timeout of the IBC transfers of the timeline.
+	transferTimeout = 10 * time.Minute
+)
+
+// runner runs the timeline of a scenario on a network.
+type runner struct {
+	scenario Scenario
+	network  *Network
+	logger   log.Logger
+	start    time.Time
+
+	mtx sync.Mutex
+	// height is the highest block of the network, committed at lastBlock.
+	height    int64
+	lastBlock time.Time
+	load      LoadResult
+}
+
+// Run runs a scenario on a network initialized in a directory and returns
+// its report. The runner logs to logger and the nodes to nodeLogger.
+func Run(ctx context.Context, s Scenario, dir string, logger, nodeLogger log.Logger) (*Report, error) {
+	n, err := NewNetwork(dir, s, nodeLogger)
+	if err != nil {
+		return nil, err
+	}
+	defer n.Close()
+
+	logger.Info("starting network", "validators", s.Validators, "chain-id", s.ChainID, "rpc", n.Validators[0].RPCAddress)
+	if err := n.Start(); err != nil {
+		return nil, err
+	}
+	// the timeline starts at the first block, once the validators are
+	// connected
+	if err := waitForFirstBlock(ctx, n, s.HaltTimeout); err != nil {
+		return nil, err
+	}
+
+	r := &runner{
+		scenario:  s,
+		network:   n,
+		logger:    logger,
+		start:     time.Now(),
+		lastBlock: time.Now(),
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		r.monitor(runCtx)
+	}()
+	go func() {
+		defer wg.Done()
+		r.sendLoad(runCtx)
+	}()
+
+	report := &Report{
+		Scenario: s.Name,
+		ChainID:  s.ChainID,
+		Duration: s.Duration,
+	}
+
+	for _, action := range s.Actions {
+		if err := r.sleepUntil(ctx, action.At); err != nil {
+			return nil, err
+		}
+
+		result := ActionResult{
+			At:      action.At,
+			Action:  action.Action,
+			Started: time.Since(r.start).Round(time.Millisecond),
+		}
+		if err := r.apply(action); err != nil {
+			result.Error = err.Error()
+			logger.Error("action failed", "action", action.Action, "at", action.At, "err", err)
+		} else {
+			logger.Info("action applied", "action", action.Action, "at", action.At)
+		}
+		report.Actions = append(report.Actions, result)
+	}
+
+	if err := r.sleepUntil(ctx, s.Duration); err != nil {
+		return nil, err
+	}
+	cancel()
+	wg.Wait()
+
+	r.mtx.Lock()
+	report.Height = r.height
+	report.Halted = time.Since(r.lastBlock) > s.HaltTimeout
+	report.Load = r.load
+	r.mtx.Unlock()
+
+	// the most advanced validator holds the blocks of the report
+	reference := n.Validators[0]
+	for _, v := range n.Validators {
+		if v.Height() > reference.Height() {
+			reference = v
+		}
+	}
+	if report.BlockTimes, report.MissedBlocks, err = r.blocks(reference); err != nil {
+		return nil, err
+	}
+	if report.Jailed, err = r.jailed(); err != nil {
+		report.Failures = append(report.Failures, fmt.Sprintf("cannot query the jailed validators: %s", err))
+	}
+
+	n.Stop()
+	report.Invariants = r.invariants(reference)
+
+	report.evaluate(s.Expect)
+	logger.Info("scenario finished", "height", report.Height, "halted", report.Halted, "passed", report.Passed)
+
+	return report, nil
+}
+
+// waitForFirstBlock waits until a validator of the network commits the first
+// block.
+func waitForFirstBlock(ctx context.Context, n *Network, timeout time.Duration) error {
+	ticker := time.NewTicker(monitorInterval)
+	defer ticker.Stop()
+	deadline := time.Now().Add(timeout)
+
+	for {
+		for _, v := range n.Validators {
+			if v.Height() > 0 {
+				return nil
+			}
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("no block committed within %s of the start of the network", timeout)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// sleepUntil sleeps until a time of the scenario.
+func (r *runner) sleepUntil(ctx context.Context, at time.Duration) error {
+	timer := time.NewTimer(time.Until(r.start.Add(at)))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// monitor records the time of the blocks of the network.
+func (r *runner) monitor(ctx context.Context) {
+	ticker := time.NewTicker(monitorInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		var height int64
+		for _, v := range r.network.Validators {
+			if h := v.Height(); h > height {
+				height = h
+			}
+		}
+
+		r.mtx.Lock()
+		if height > r.height {
+			r.height = height
+			r.lastBlock = time.Now()
+		}
+		r.mtx.Unlock()
+	}
+}
+
+// sendLoad sends the bank sends of the load of the scenario.
+func (r *runner) sendLoad(ctx context.Context) {
+	if r.scenario.Load.TPS == 0 {
+		return
+	}
+
+	amount, err := sdk.ParseCoinNormalized(r.scenario.Load.Amount)
+	if err != nil {
+		r.logger.Error("invalid load amount", "err", err)
+		return
+	}
+	msg := banktypes.NewMsgSend(r.network.Load.Address, r.network.Validators[0].Address, sdk.NewCoins(amount))
+
+	ticker := time.NewTicker(time.Duration(float64(time.Second) / r.scenario.Load.TPS))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		err := r.network.SendTx(r.network.Load, msg)
+
+		r.mtx.Lock()
+		if err != nil {
+			r.load.Rejected++
+		} else {
+			r.load.Accepted++
+		}
+		r.mtx.Unlock()
+
+		if err != nil {
+			r.logger.Debug("load transaction rejected", "err", err)
+		}
+	}
+}
+
+// apply applies an action of the timeline.
+func (r *runner) apply(action Action) error {
+	n := r.network
+
+	switch action.Action {
+	case ActionStop:
+		return n.StopValidator(n.Validators[action.Validator])
+	case ActionRestart:
+		return n.StartValidator(n.Validators[action.Validator])
+	case ActionPartition:
+		n.Partition(action.Groups)
+		return nil
+	case ActionHeal:
+		return n.Heal()
+	case ActionParamChange:
+		content := paramproposal.NewParameterChangeProposal(
+			fmt.Sprintf("Change %s/%s", action.Subspace, action.Key),
+			fmt.Sprintf("Scenario %s", r.scenario.Name),
+			[]paramproposal.ParamChange{paramproposal.NewParamChange(action.Subspace, action.Key, action.Value)},
+		)
+		msg, err := govv1.NewLegacyContent(content, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+		if err != nil {
+			return err
+		}
+		return r.propose(msg)
+	case ActionUpgrade:
+		return r.propose(&upgradetypes.MsgSoftwareUpgrade{
+			Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+			Plan: upgradetypes.Plan{
+				Name:   action.Name,
+				Height: action.Height,
+				Info:   action.Info,
+			},
+		})
+	case ActionIBCTransfer:
+		v := n.Validators[action.Validator]
+		amount, err := sdk.ParseCoinNormalized(action.Amount)
+		if err != nil {
+			return err
+		}
+		return n.SendTx(v.Account, ibctransfertypes.NewMsgTransfer(
+			action.Port,
+			action.Channel,
+			amount,
+			v.Address.String(),
+			action.Receiver,
+			clienttypes.ZeroHeight(),
+			uint64(time.Now().Add(transferTimeout).UnixNano()),
+		))
+	default:
+		return fmt.Errorf("unknown action %q", action.Action)
+	}
+}
+
+// propose submits a governance proposal executing a message from the first
+// validator, waits for it to be included and votes yes from every
+// validator.
+func (r *runner) propose(msg sdk.Msg) error {
+	n := r.network
+
+	var params govv1.QueryParamsResponse
+	if err := n.Query("/cosmos.gov.v1.Query/Params", &govv1.QueryParamsRequest{ParamsType: govv1.ParamDeposit}, &params); err != nil {
+		return err
+	}
+	lastID, err := r.lastProposalID()
+	if err != nil {
+		return err
+	}
+
+	proposer := n.Validators[0]
+	submit, err := govv1.NewMsgSubmitProposal([]sdk.Msg{msg}, params.DepositParams.MinDeposit, proposer.Address.String(), "")
+	if err != nil {
+		return err
+	}
+	if err := n.SendTx(proposer.Account, submit); err != nil {
+		return err
+	}
+
+	// the proposal is included within a voting period
+	deadline := time.Now().Add(r.scenario.VotingPeriod)
+	var id uint64
+	for id <= lastID {
+		if time.Now().After(deadline) {
+			return fmt.Errorf("proposal not included after %s", r.scenario.VotingPeriod)
+		}
+		time.Sleep(monitorInterval)
+		if id, err = r.lastProposalID(); err != nil {
+			return err
+		}
+	}
+
+	for _, v := range n.Validators {
+		if err := n.SendTx(v.Account, govv1.NewMsgVote(v.Address, id, govv1.OptionYes, "")); err != nil {
+			return fmt.Errorf("vote of %s on proposal %d: %w", v.Moniker, id, err)
+		}
+	}
+
+	return nil
+}
+
+// lastProposalID returns the ID of the last governance proposal, 0 if none.
+func (r *runner) lastProposalID() (uint64, error) {
+	var res govv1.QueryProposalsResponse
+	if err := r.network.Query("/cosmos.gov.v1.Query/Proposals", &govv1.QueryProposalsRequest{
+		Pagination: &query.PageRequest{Limit: 1, Reverse: true},
+	}, &res); err != nil {
+		return 0, err
+	}
+
+	if len(res.Proposals) == 0 {
+		return 0, nil
+	}
+	return res.Proposals[0].Id, nil
+}
+
+// blocks returns the block times and missed blocks of the blocks of a
+// validator.
+func (r *runner) blocks(v *Validator) (BlockTimes, map[string]int64, error) {
+	monikers := make(map[string]string)
+	missed := make(map[string]int64)
+	for _, validator := range r.network.Validators {
+		monikers[validator.ConsAddress.String()] = validator.Moniker
+		missed[validator.Moniker] = 0
+	}
+
+	tmNode, _ := v.current()
+	blockStore := tmNode.BlockStore()
+	stateStore := sm.NewStore(v.dbs["state"], sm.StoreOptions{})
+
+	var (
+		times    BlockTimes
+		total    time.Duration
+		previous time.Time
+	)
+	base, height := blockStore.Base(), blockStore.Height()
+	for h := base; h <= height; h++ {
+		block := blockStore.LoadBlock(h)
+		if block == nil {
+			continue
+		}
+
+		if h > base {
+			blockTime := block.Time.Sub(previous)
+			total += blockTime
+			if blockTime > times.Max {
+				times.Max, times.MaxHeight = blockTime, h
+			}
+		}
+		previous = block.Time
+
+		// the last commit of the block holds the signatures of the previous one
+		if h == 1 {
+			continue
+		}
+		validators, err := stateStore.LoadValidators(h - 1)
+		if err != nil {
+			return BlockTimes{}, nil, err
+		}
+		for i, sig := range block.LastCommit.Signatures {
+			if sig.Absent() && i < len(validators.Validators) {
+				missed[monikers[sdk.ConsAddress(validators.Validators[i].Address).String()]]++
+			}
+		}
+	}
+	if height > base {
+		times.Average = total / time.Duration(height-base)
+	}
+
+	return times, missed, nil
+}
+
+// jailed returns the monikers of the jailed validators.
+func (r *runner) jailed() ([]string, error) {
+	var res stakingtypes.QueryValidatorsResponse
+	if err := r.network.Query("/cosmos.staking.v1beta1.Query/Validators", &stakingtypes.QueryValidatorsRequest{
+		Pagination: &query.PageRequest{Limit: uint64(len(r.network.Validators))},
+	}, &res); err != nil {
+		return nil, err
+	}
+
+	var jailed []string
+	for _, validator := range res.Validators {
+		if validator.Jailed {
+			jailed = append(jailed, validator.Description.Moniker)
+		}
+	}
+
+	return jailed, nil
+}
+
+// invariants runs the invariants of the app of a stopped validator on its
+// last committed state.
+func (r *runner) invariants(v *Validator) []InvariantResult {
+	_, chainApp := v.current()
+	ctx := chainApp.NewContext(true, tmproto.Header{
+		ChainID: r.scenario.ChainID,
+		Height:  chainApp.LastBlockHeight(),
+	})
+
+	routes := chainApp.CrisisKeeper.Routes()
+	results := make([]InvariantResult, len(routes))
+	for i, route := range routes {
+		results[i] = InvariantResult{Route: route.FullRoute()}
+		func() {
+			defer func() {
+				if err := recover(); err != nil {
+					results[i].Broken, results[i].Message = true, fmt.Sprintf("panic: %v", err)
+				}
+			}()
+			results[i].Message, results[i].Broken = route.Invar(ctx)
+		}()
+		if !results[i].Broken {
+			results[i].Message = ""
+		}
+	}
+	// the invariants are registered in the random order of the modules
+	sort.Slice(results, func(i, j int) bool { return results[i].Route < results[j].Route })
+
+	return results
+}
@@ -0,0 +1,264 @@
+package scenario
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Actions of the timeline of a scenario.
+const (
+	// ActionStop stops a validator.
+	ActionStop = "stop"
+	// ActionRestart restarts a stopped validator on its data.
+	ActionRestart = "restart"
+	// ActionPartition splits the validators into groups of peers.
+	ActionPartition = "partition"
+	// ActionHeal removes the partition of the validators.
+	ActionHeal = "heal"
+	// ActionParamChange submits a parameter change proposal voted by every
+	// validator.
+	ActionParamChange = "param-change"
+	// ActionUpgrade submits a software upgrade proposal voted by every
+	// validator.
+	ActionUpgrade = "upgrade"
+	// ActionIBCTransfer sends an ICS-20 transfer from a validator.
+	ActionIBCTransfer = "ibc-transfer"
+)
+
+// Defaults of the optional fields of a scenario.
+const (
+	DefaultChainID       = "chaos-scenario"
+	DefaultTimeoutCommit = time.Second
+	DefaultVotingPeriod  = 10 * time.Second
+	DefaultHaltTimeout   = 15 * time.Second
+	DefaultTransferPort  = "transfer"
+)
+
+// Scenario is a multi-validator experiment run on an in-process network.
+type Scenario struct {
+	Name    string `yaml:"name"`
+	ChainID string `yaml:"chain_id"`
+	// Validators is the number of validators of the network, bonded with the
+	// same power.
+	Validators int `yaml:"validators"`
+	// Duration is the duration of the run, from the start of the network.
+	Duration      time.Duration `yaml:"duration"`
+	TimeoutCommit time.Duration `yaml:"timeout_commit"`
+	// VotingPeriod is the voting period of the proposals of the timeline.
+	VotingPeriod time.Duration `yaml:"voting_period"`
+	// SignedBlocksWindow is the slashing window of the validators, the
+	// default of the slashing module if zero.
+	SignedBlocksWindow int64 `yaml:"signed_blocks_window"`
+	// HaltTimeout is the time without a new block after which the chain is
+	// reported as halted.
+	HaltTimeout time.Duration `yaml:"halt_timeout"`
+	Load        Load          `yaml:"load"`
+	Actions     []Action      `yaml:"actions"`
+	Expect      Expect        `yaml:"expect"`
+}
+
+// Load is the transaction load sent to the network during the run.
+type Load struct {
+	// TPS is the number of bank sends per second, none if zero.
+	TPS float64 `yaml:"tps"`
+	// Amount is the amount of each bank send.
+	Amount string `yaml:"amount"`
+}
+
+// Action is an action of the timeline of a scenario. The fields used depend
+// on the action.
+type Action struct {
+	// At is the time of the action, from the start of the network.
+	At     time.Duration `yaml:"at"`
+	Action string        `yaml:"action"`
+
+	// Validator is the index of the validator stopped, restarted or sending
+	// an IBC transfer.
+	Validator int `yaml:"validator"`
+	// Groups are the indexes of the validators of each group of a
+	// partition. Validators missing from the groups are isolated.
+	Groups [][]int `yaml:"groups"`
+
+	// Subspace, Key and Value are the parameter changed.
+	Subspace string `yaml:"subspace"`
+	Key      string `yaml:"key"`
+	Value    string `yaml:"value"`
+
+	// Name, Height and Info are the plan of an upgrade.
+	Name   string `yaml:"name"`
+	Height int64  `yaml:"height"`
+	Info   string `yaml:"info"`
+
+	// Port, Channel, Receiver and Amount are the ICS-20 transfer. The channel
+	// must have been opened by a relayer connected to the network.
+	Port     string `yaml:"port"`
+	Channel  string `yaml:"channel"`
+	Receiver string `yaml:"receiver"`
+	Amount   string `yaml:"amount"`
+}
+
+// Expect are the expectations of a scenario on its report. Broken
+// invariants and failed actions always fail the report.
+type Expect struct {
+	// Halted is the expected halt of the chain at the end of the run.
+	Halted bool `yaml:"halted"`
+	// MinHeight is the minimum height reached by the chain.
+	MinHeight int64 `yaml:"min_height"`
+	// MaxBlockTime is the maximum time between two blocks, unchecked if
+	// zero.
+	MaxBlockTime time.Duration `yaml:"max_block_time"`
+	// MaxMissedBlocks is the maximum number of blocks missed by a
+	// validator, unchecked if nil.
+	MaxMissedBlocks *int64 `yaml:"max_missed_blocks"`
+	// MaxJailed is the maximum number of validators jailed at the end of the
+	// run, unchecked if nil.
+	MaxJailed *int `yaml:"max_jailed"`
+}
+
+// LoadFile reads a YAML scenario file, sets the defaults of its optional fields
+// and validates it.
+func LoadFile(path string) (Scenario, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return Scenario{}, err
+	}
+
+	return Parse(bz)
+}
+
+// Parse parses a YAML scenario, sets the defaults of its optional fields and
+// validates it.
+func Parse(bz []byte) (Scenario, error) {
+	var s Scenario
+	if err := yaml.UnmarshalStrict(bz, &s); err != nil {
+		return Scenario{}, fmt.Errorf("invalid scenario: %w", err)
+	}
+
+	s.setDefaults()
+	if err := s.Validate(); err != nil {
+		return Scenario{}, fmt.Errorf("invalid scenario: %w", err)
+	}
+
+	return s, nil
+}
+
+func (s *Scenario) setDefaults() {
+	if s.ChainID == "" {
+		s.ChainID = DefaultChainID
+	}
+	if s.TimeoutCommit == 0 {
+		s.TimeoutCommit = DefaultTimeoutCommit
+	}
+	if s.VotingPeriod == 0 {
+		s.VotingPeriod = DefaultVotingPeriod
+	}
+	if s.HaltTimeout == 0 {
+		s.HaltTimeout = DefaultHaltTimeout
+	}
+	if s.Load.Amount == "" {
+		s.Load.Amount = "1" + sdk.DefaultBondDenom
+	}
+	for i := range s.Actions {
+		if s.Actions[i].Action == ActionIBCTransfer && s.Actions[i].Port == "" {
+			s.Actions[i].Port = DefaultTransferPort
+		}
+	}
+
+	// the timeline runs in order
+	sort.SliceStable(s.Actions, func(i, j int) bool {
+		return s.Actions[i].At < s.Actions[j].At
+	})
+}
+
+// Validate performs a stateless validation of the scenario.
+func (s Scenario) Validate() error {
+	if s.Validators < 1 {
+		return fmt.Errorf("at least one validator is required, got %d", s.Validators)
+	}
+	if s.Duration <= 0 {
+		return fmt.Errorf("duration must be positive, got %s", s.Duration)
+	}
+	if s.TimeoutCommit <= 0 || s.VotingPeriod <= 0 || s.HaltTimeout <= 0 {
+		return fmt.Errorf("timeouts and periods must be positive")
+	}
+	if s.SignedBlocksWindow < 0 {
+		return fmt.Errorf("signed blocks window cannot be negative, got %d", s.SignedBlocksWindow)
+	}
+	if s.Load.TPS < 0 {
+		return fmt.Errorf("load tps cannot be negative, got %v", s.Load.TPS)
+	}
+	if _, err := sdk.ParseCoinNormalized(s.Load.Amount); err != nil {
+		return fmt.Errorf("invalid load amount: %w", err)
+	}
+
+	for i, action := range s.Actions {
+		if err := s.validateAction(action); err != nil {
+			return fmt.Errorf("action %d (%s at %s): %w", i, action.Action, action.At, err)
+		}
+	}
+
+	return nil
+}
+
+func (s Scenario) validateAction(action Action) error {
+	if action.At < 0 || action.At > s.Duration {
+		return fmt.Errorf("time must be within the duration of the scenario")
+	}
+
+	validateIndex := func(index int) error {
+		if index < 0 || index >= s.Validators {
+			return fmt.Errorf("no validator %d in a network of %d validators", index, s.Validators)
+		}
+		return nil
+	}
+
+	switch action.Action {
+	case ActionStop, ActionRestart:
+		return validateIndex(action.Validator)
+	case ActionPartition:
+		if len(action.Groups) == 0 {
+			return fmt.Errorf("groups cannot be empty")
+		}
+		seen := make(map[int]bool)
+		for _, group := range action.Groups {
+			for _, index := range group {
+				if err := validateIndex(index); err != nil {
+					return err
+				}
+				if seen[index] {
+					return fmt.Errorf("validator %d is in several groups", index)
+				}
+				seen[index] = true
+			}
+		}
+		return nil
+	case ActionHeal:
+		return nil
+	case ActionParamChange:
+		if action.Subspace == "" || action.Key == "" || action.Value == "" {
+			return fmt.Errorf("subspace, key and value are required")
+		}
+		return nil
+	case ActionUpgrade:
+		if action.Name == "" || action.Height <= 0 {
+			return fmt.Errorf("name and positive height are required")
+		}
+		return nil
+	case ActionIBCTransfer:
+		if action.Channel == "" || action.Receiver == "" {
+			return fmt.Errorf("channel and receiver are required")
+		}
+		if _, err := sdk.ParseCoinNormalized(action.Amount); err != nil {
+			return fmt.Errorf("invalid amount: %w", err)
+		}
+		return validateIndex(action.Validator)
+	default:
+		return fmt.Errorf("unknown action %q", action.Action)
+	}
+}
@@ -0,0 +1,135 @@
+package scenario_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos-builders/chaos/app/scenario"
+)
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		name     string
+		scenario string
+		expErr   bool
+	}{
+		{"minimal", "validators: 1\nduration: 10s\n", false},
+		{"timeline", `
+name: stop-and-partition
+validators: 4
+duration: 60s
+load:
+  tps: 5
+actions:
+  - at: 20s
+    action: restart
+    validator: 1
+  - at: 10s
+    action: stop
+    validator: 1
+  - at: 30s
+    action: partition
+    groups: [[0, 1], [2, 3]]
+  - at: 40s
+    action: heal
+  - at: 45s
+    action: param-change
+    subspace: staking
+    key: MaxValidators
+    value: "50"
+  - at: 50s
+    action: upgrade
+    name: v2
+    height: 100
+  - at: 55s
+    action: ibc-transfer
+    channel: channel-0
+    receiver: cosmos1receiver
+    amount: 100stake
+expect:
+  max_missed_blocks: 20
+  max_jailed: 0
+`, false},
+		{"no validators", "duration: 10s\n", true},
+		{"no duration", "validators: 1\n", true},
+		{"unknown field", "validators: 1\nduration: 10s\nvalidator: 2\n", true},
+		{"unknown action", "validators: 1\nduration: 10s\nactions:\n  - action: explode\n", true},
+		{"action after the end", "validators: 1\nduration: 10s\nactions:\n  - at: 20s\n    action: heal\n", true},
+		{"unknown validator", "validators: 2\nduration: 10s\nactions:\n  - action: stop\n    validator: 2\n", true},
+		{"overlapping groups", "validators: 2\nduration: 10s\nactions:\n  - action: partition\n    groups: [[0, 1], [1]]\n", true},
+		{"upgrade without height", "validators: 1\nduration: 10s\nactions:\n  - action: upgrade\n    name: v2\n", true},
+		{"transfer without amount", "validators: 1\nduration: 10s\nactions:\n  - action: ibc-transfer\n    channel: channel-0\n    receiver: cosmos1receiver\n", true},
+		{"invalid load amount", "validators: 1\nduration: 10s\nload:\n  amount: many\n", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := scenario.Parse([]byte(tc.scenario))
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	s, err := scenario.Parse([]byte(testCases[1].scenario))
+	require.NoError(t, err)
+	require.Equal(t, scenario.DefaultChainID, s.ChainID)
+	require.Equal(t, scenario.DefaultTimeoutCommit, s.TimeoutCommit)
+	require.Equal(t, scenario.ActionStop, s.Actions[0].Action)
+	require.Equal(t, scenario.ActionRestart, s.Actions[1].Action)
+	require.Equal(t, scenario.DefaultTransferPort, s.Actions[6].Port)
+}
+
+func TestRun(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping in-process network test in short mode")
+	}
+
+	s, err := scenario.Parse([]byte(`
+name: stop-restart-partition
+validators: 4
+duration: 25s
+timeout_commit: 300ms
+voting_period: 5s
+load:
+  tps: 10
+actions:
+  - at: 3s
+    action: stop
+    validator: 3
+  - at: 7s
+    action: restart
+    validator: 3
+  - at: 10s
+    action: param-change
+    subspace: staking
+    key: MaxValidators
+    value: "50"
+  - at: 12s
+    action: partition
+    groups: [[0, 1], [2, 3]]
+  - at: 16s
+    action: heal
+expect:
+  min_height: 10
+  max_jailed: 0
+`))
+	require.NoError(t, err)
+
+	report, err := scenario.Run(context.Background(), s, t.TempDir(), log.NewNopLogger(), log.NewNopLogger())
+	require.NoError(t, err)
+	require.True(t, report.Passed, report.Failures)
+
+	require.False(t, report.Halted)
+	require.Positive(t, report.Load.Accepted)
+	require.Positive(t, report.MissedBlocks["validator3"])
+	require.Greater(t, report.BlockTimes.Max, 3*time.Second)
+	require.NotEmpty(t, report.Invariants)
+	require.Len(t, report.Actions, 5)
+}
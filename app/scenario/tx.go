@@ -0,0 +1,186 @@
+package scenario
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/mempool"
+	"github.com/tendermint/tendermint/node"
+
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+const (
+	// txGas is the gas limit of the transactions of the runner, which pay no
+	// fees.
+	txGas = 500_000
+	// checkTxTimeout is the maximum time waited for CheckTx.
+	checkTxTimeout = 10 * time.Second
+)
+
+// expectedSequence extracts the expected sequence of an ErrWrongSequence.
+var expectedSequence = regexp.MustCompile(`expected (\d+)`)
+
+// signTx signs a transaction of messages by an account.
+func (n *Network) signTx(account *Account, accNum, seq uint64, msgs ...sdk.Msg) (sdk.Tx, error) {
+	txConfig := n.encodingConfig.TxConfig
+	txBuilder := txConfig.NewTxBuilder()
+	if err := txBuilder.SetMsgs(msgs...); err != nil {
+		return nil, err
+	}
+	txBuilder.SetGasLimit(txGas)
+
+	// the signer infos are part of the signed bytes
+	signMode := txConfig.SignModeHandler().DefaultMode()
+	if err := txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey:   account.PrivKey.PubKey(),
+		Data:     &signing.SingleSignatureData{SignMode: signMode},
+		Sequence: seq,
+	}); err != nil {
+		return nil, err
+	}
+
+	sig, err := clienttx.SignWithPrivKey(
+		signMode,
+		authsigning.SignerData{
+			Address:       account.Address.String(),
+			ChainID:       n.ChainID,
+			AccountNumber: accNum,
+			Sequence:      seq,
+			PubKey:        account.PrivKey.PubKey(),
+		},
+		txBuilder, account.PrivKey, txConfig, seq,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := txBuilder.SetSignatures(sig); err != nil {
+		return nil, err
+	}
+
+	return txBuilder.GetTx(), nil
+}
+
+// SendTx signs a transaction of messages by an account and adds it to the
+// mempool of a running validator. The sequence of the account is tracked
+// across the transactions in the mempools.
+func (n *Network) SendTx(account *Account, msgs ...sdk.Msg) error {
+	account.mtx.Lock()
+	defer account.mtx.Unlock()
+
+	if !account.synced {
+		var res authtypes.QueryAccountResponse
+		if err := n.Query("/cosmos.auth.v1beta1.Query/Account", &authtypes.QueryAccountRequest{Address: account.Address.String()}, &res); err != nil {
+			return err
+		}
+		var acc authtypes.AccountI
+		if err := n.encodingConfig.InterfaceRegistry.UnpackAny(res.Account, &acc); err != nil {
+			return err
+		}
+		account.accNum, account.seq, account.synced = acc.GetAccountNumber(), acc.GetSequence(), true
+	}
+
+	tx, err := n.signTx(account, account.accNum, account.seq, msgs...)
+	if err != nil {
+		return err
+	}
+
+	err = n.checkTx(tx)
+	switch {
+	case err == nil:
+		account.seq++
+	case sdkerrors.ErrWrongSequence.Is(err):
+		if match := expectedSequence.FindStringSubmatch(err.Error()); match != nil {
+			account.seq, _ = strconv.ParseUint(match[1], 10, 64)
+		} else {
+			account.synced = false
+		}
+	}
+
+	return err
+}
+
+// checkTx adds a transaction to the mempool of a running validator.
+func (n *Network) checkTx(tx sdk.Tx) error {
+	bz, err := n.encodingConfig.TxConfig.TxEncoder()(tx)
+	if err != nil {
+		return err
+	}
+
+	tmNode, err := n.runningNode()
+	if err != nil {
+		return err
+	}
+
+	responses := make(chan *abci.ResponseCheckTx, 1)
+	if err := tmNode.Mempool().CheckTx(bz, func(res *abci.Response) {
+		responses <- res.GetCheckTx()
+	}, mempool.TxInfo{}); err != nil {
+		return err
+	}
+
+	select {
+	case res := <-responses:
+		if res.Code != abci.CodeTypeOK {
+			return sdkerrors.ABCIError(res.Codespace, res.Code, res.Log)
+		}
+		return nil
+	case <-time.After(checkTxTimeout):
+		return fmt.Errorf("timed out waiting for CheckTx")
+	}
+}
+
+// Query runs a gRPC query on the state of a running validator.
+func (n *Network) Query(path string, req, res codec.ProtoMarshaler) error {
+	bz, err := req.Marshal()
+	if err != nil {
+		return err
+	}
+
+	tmNode, err := n.runningNode()
+	if err != nil {
+		return err
+	}
+
+	queryRes, err := tmNode.ProxyApp().Query().QuerySync(abci.RequestQuery{Path: path, Data: bz})
+	if err != nil {
+		return err
+	}
+	if queryRes.Code != abci.CodeTypeOK {
+		return sdkerrors.ABCIError(queryRes.Codespace, queryRes.Code, queryRes.Log)
+	}
+
+	return res.Unmarshal(queryRes.Value)
+}
+
+// runningNode returns the node of the running validator with the highest
+// block.
+func (n *Network) runningNode() (*node.Node, error) {
+	var (
+		running *node.Node
+		height  int64 = -1
+	)
+	for _, v := range n.Validators {
+		if !v.Running() {
+			continue
+		}
+		if h := v.Height(); h > height {
+			running, _ = v.current()
+			height = h
+		}
+	}
+
+	if running == nil {
+		return nil, fmt.Errorf("no running validator")
+	}
+	return running, nil
+}
@@ -0,0 +1,73 @@
+package storefault
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var _ types.MultiStorePersistentCache = (*StoreCache)(nil)
+
+// StoreCache wraps the stores of the keys with rules when they are loaded by
+// the root multistore. It is set as the inter-block cache of the multistore
+// and wraps the actual inter-block cache, if any.
+//
+// The stores are only wrapped for the execution of blocks and transactions:
+// ABCI store queries, snapshots and pruning use the unwrapped stores.
+type StoreCache struct {
+	parent types.MultiStorePersistentCache
+	rules  map[string][]Rule
+	stores map[types.StoreKey]types.CommitKVStore
+}
+
+// NewStoreCache creates a new StoreCache wrapping the parent inter-block
+// cache, which may be nil.
+func NewStoreCache(parent types.MultiStorePersistentCache, rules []Rule) *StoreCache {
+	storeRules := make(map[string][]Rule)
+	for _, rule := range rules {
+		storeRules[rule.StoreKey] = append(storeRules[rule.StoreKey], rule)
+	}
+
+	return &StoreCache{
+		parent: parent,
+		rules:  storeRules,
+		stores: make(map[types.StoreKey]types.CommitKVStore),
+	}
+}
+
+// GetStoreCache implements MultiStorePersistentCache.
+func (c *StoreCache) GetStoreCache(key types.StoreKey, store types.CommitKVStore) types.CommitKVStore {
+	c.stores[key] = store
+	if c.parent != nil {
+		store = c.parent.GetStoreCache(key, store)
+	}
+
+	rules, ok := c.rules[key.Name()]
+	if !ok {
+		return store
+	}
+
+	return NewStore(store, key.Name(), rules)
+}
+
+// Unwrap implements MultiStorePersistentCache.
+func (c *StoreCache) Unwrap(key types.StoreKey) types.CommitKVStore {
+	return c.stores[key]
+}
+
+// Reset implements MultiStorePersistentCache.
+func (c *StoreCache) Reset() {
+	if c.parent != nil {
+		c.parent.Reset()
+	}
+}
+
+// SetStoreFaults returns the baseapp option injecting the faults of the
+// rules into the stores of their keys. It replaces the inter-block cache
+// option, wrapping the given cache, which may be nil.
+//
+// Store faults make the state of a node diverge from the nodes configured
+// differently. They are only enabled by the test helpers and by the
+// --unsafe-store-faults start flag.
+func SetStoreFaults(interBlockCache types.MultiStorePersistentCache, rules []Rule) func(*baseapp.BaseApp) {
+	return baseapp.SetInterBlockCache(NewStoreCache(interBlockCache, rules))
+}
@@ -0,0 +1,157 @@
+package storefault
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FlagUnsafeStoreFaults is the start flag holding the store fault rules of
+// the node. Store faults make the state of the node diverge from the nodes
+// configured differently.
+const FlagUnsafeStoreFaults = "unsafe-store-faults"
+
+// Kind is the kind of fault injected into the reads of a store.
+type Kind int
+
+const (
+	// KindLatency delays the reads.
+	KindLatency Kind = iota + 1
+	// KindPanic panics with a Panic.
+	KindPanic
+	// KindCorrupt flips the bits of the values read.
+	KindCorrupt
+)
+
+// kindNames are the names of the faults in the rules of the start flag.
+var kindNames = map[Kind]string{
+	KindLatency: "latency",
+	KindPanic:   "panic",
+	KindCorrupt: "corrupt",
+}
+
+// String implements the Stringer interface.
+func (k Kind) String() string {
+	if name, ok := kindNames[k]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("Kind(%d)", int(k))
+}
+
+// Rule injects a fault into the reads of the keys of a store starting with
+// a prefix, on a window of heights.
+type Rule struct {
+	// StoreKey is the name of the store key, e.g. bank.
+	StoreKey string
+	// KeyPrefix is the prefix of the faulty keys, every key if empty.
+	KeyPrefix []byte
+	Kind      Kind
+	// Latency is the delay of the reads of a KindLatency rule.
+	Latency time.Duration
+	// StartHeight is the first height of the window, 0 for no lower bound.
+	StartHeight int64
+	// EndHeight is the last height of the window, 0 for no upper bound.
+	EndHeight int64
+}
+
+// ParseRule parses a rule of the start flag. Rules are given as
+// <store-key>[/<hex-key-prefix>]:<fault>[:<latency>][@<start-height>[-<end-height>]],
+// e.g. bank/02:corrupt@100-200, staking:latency:50ms or ibc:panic@500.
+func ParseRule(s string) (Rule, error) {
+	var rule Rule
+
+	spec, window, hasWindow := strings.Cut(s, "@")
+	if hasWindow {
+		start, end, hasEnd := strings.Cut(window, "-")
+		var err error
+		if rule.StartHeight, err = strconv.ParseInt(start, 10, 64); err != nil {
+			return Rule{}, fmt.Errorf("invalid start height %q", start)
+		}
+		rule.EndHeight = rule.StartHeight
+		if hasEnd {
+			if rule.EndHeight, err = strconv.ParseInt(end, 10, 64); err != nil {
+				return Rule{}, fmt.Errorf("invalid end height %q", end)
+			}
+		}
+	}
+
+	parts := strings.Split(spec, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return Rule{}, fmt.Errorf("expected <store-key>[/<hex-key-prefix>]:<fault>[:<latency>][@<start-height>[-<end-height>]], got %q", s)
+	}
+
+	storeKey, prefix, hasPrefix := strings.Cut(parts[0], "/")
+	rule.StoreKey = storeKey
+	if hasPrefix {
+		var err error
+		if rule.KeyPrefix, err = hex.DecodeString(prefix); err != nil {
+			return Rule{}, fmt.Errorf("invalid key prefix %q: %w", prefix, err)
+		}
+	}
+
+	for kind, name := range kindNames {
+		if parts[1] == name {
+			rule.Kind = kind
+		}
+	}
+
+	if len(parts) == 3 {
+		latency, err := time.ParseDuration(parts[2])
+		if err != nil {
+			return Rule{}, fmt.Errorf("invalid latency %q", parts[2])
+		}
+		rule.Latency = latency
+	}
+
+	if err := rule.Validate(); err != nil {
+		return Rule{}, err
+	}
+
+	return rule, nil
+}
+
+// ParseRules parses the rules of the start flag.
+func ParseRules(rules []string) ([]Rule, error) {
+	storeRules := make([]Rule, 0, len(rules))
+	for _, s := range rules {
+		rule, err := ParseRule(s)
+		if err != nil {
+			return nil, err
+		}
+		storeRules = append(storeRules, rule)
+	}
+
+	return storeRules, nil
+}
+
+// Validate performs a stateless validation of the rule.
+func (r Rule) Validate() error {
+	if r.StoreKey == "" {
+		return fmt.Errorf("store key cannot be empty")
+	}
+	if _, ok := kindNames[r.Kind]; !ok {
+		return fmt.Errorf("invalid fault %s", r.Kind)
+	}
+	if (r.Kind == KindLatency) != (r.Latency > 0) {
+		return fmt.Errorf("latency is required by and only supported by the %s fault", KindLatency)
+	}
+	if r.StartHeight < 0 || r.EndHeight < 0 {
+		return fmt.Errorf("heights cannot be negative")
+	}
+	if r.EndHeight != 0 && r.EndHeight < r.StartHeight {
+		return fmt.Errorf("end height %d is before start height %d", r.EndHeight, r.StartHeight)
+	}
+
+	return nil
+}
+
+// Matches returns true if the rule applies to the read of a key at a height.
+func (r Rule) Matches(key []byte, height int64) bool {
+	return bytes.HasPrefix(key, r.KeyPrefix) &&
+		(r.StartHeight == 0 || height >= r.StartHeight) &&
+		(r.EndHeight == 0 || height <= r.EndHeight)
+}
@@ -0,0 +1,134 @@
+package storefault
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var _ types.CommitKVStore = (*Store)(nil)
+
+// Panic is the value of the panics injected by a KindPanic rule.
+type Panic struct {
+	StoreKey string
+	Key      []byte
+	Height   int64
+}
+
+// Error implements the error interface.
+func (p Panic) Error() string {
+	return fmt.Sprintf("store fault: panic reading key %X of store %s at height %d", p.Key, p.StoreKey, p.Height)
+}
+
+// Store injects the faults of its rules into the reads of a CommitKVStore:
+// Get, Has and the values of iterators. Writes are not faulty, since they
+// are only flushed to the store on commit, outside of module code.
+type Store struct {
+	types.CommitKVStore
+
+	storeKey string
+	rules    []Rule
+}
+
+// NewStore wraps a CommitKVStore with the rules of its store key.
+func NewStore(parent types.CommitKVStore, storeKey string, rules []Rule) *Store {
+	return &Store{
+		CommitKVStore: parent,
+		storeKey:      storeKey,
+		rules:         rules,
+	}
+}
+
+// height returns the height of the block being executed, the next version
+// of the store.
+func (s *Store) height() int64 {
+	return s.LastCommitID().Version + 1
+}
+
+// read injects the faults of the rules matching a key into the read of its
+// value.
+func (s *Store) read(key, value []byte) []byte {
+	height := s.height()
+	for _, rule := range s.rules {
+		if !rule.Matches(key, height) {
+			continue
+		}
+
+		switch rule.Kind {
+		case KindLatency:
+			time.Sleep(rule.Latency)
+		case KindPanic:
+			panic(Panic{StoreKey: s.storeKey, Key: key, Height: height})
+		case KindCorrupt:
+			if value != nil {
+				corrupted := make([]byte, len(value))
+				for i, b := range value {
+					corrupted[i] = ^b
+				}
+				value = corrupted
+			}
+		}
+	}
+
+	return value
+}
+
+// Get implements KVStore.
+func (s *Store) Get(key []byte) []byte {
+	return s.read(key, s.CommitKVStore.Get(key))
+}
+
+// Has implements KVStore.
+func (s *Store) Has(key []byte) bool {
+	return s.read(key, nil) == nil && s.CommitKVStore.Has(key)
+}
+
+// Iterator implements KVStore.
+func (s *Store) Iterator(start, end []byte) types.Iterator {
+	return &iterator{Iterator: s.CommitKVStore.Iterator(start, end), store: s}
+}
+
+// ReverseIterator implements KVStore.
+func (s *Store) ReverseIterator(start, end []byte) types.Iterator {
+	return &iterator{Iterator: s.CommitKVStore.ReverseIterator(start, end), store: s}
+}
+
+// CacheWrap implements CacheWrapper.
+func (s *Store) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements CacheWrapper.
+func (s *Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// CacheWrapWithListeners implements CacheWrapper.
+func (s *Store) CacheWrapWithListeners(storeKey types.StoreKey, listeners []types.WriteListener) types.CacheWrap {
+	return cachekv.NewStore(listenkv.NewStore(s, storeKey, listeners))
+}
+
+// SetInitialVersion sets the initial version of the wrapped store, if
+// supported.
+func (s *Store) SetInitialVersion(version int64) {
+	if store, ok := s.CommitKVStore.(types.StoreWithInitialVersion); ok {
+		store.SetInitialVersion(version)
+	}
+}
+
+// iterator injects the faults of a store into the values of an iterator.
+type iterator struct {
+	types.Iterator
+
+	store *Store
+}
+
+// Value implements Iterator.
+func (it *iterator) Value() []byte {
+	return it.store.read(it.Key(), it.Iterator.Value())
+}
@@ -0,0 +1,201 @@
+package storefault_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+	"github.com/cosmos/ibc-go/v5/testing/simapp"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos-builders/chaos/app"
+	"github.com/cosmos-builders/chaos/app/storefault"
+)
+
+// recipient is the account whose balances cannot be read.
+var recipient = sdk.AccAddress([]byte("recipient___________"))
+
+func TestParseRules(t *testing.T) {
+	testCases := []struct {
+		name   string
+		rule   string
+		expect storefault.Rule
+		expErr bool
+	}{
+		{"store", "bank:panic", storefault.Rule{StoreKey: "bank", Kind: storefault.KindPanic}, false},
+		{"prefix", "bank/02ab:corrupt", storefault.Rule{StoreKey: "bank", KeyPrefix: []byte{0x02, 0xab}, Kind: storefault.KindCorrupt}, false},
+		{"latency", "staking:latency:50ms", storefault.Rule{StoreKey: "staking", Kind: storefault.KindLatency, Latency: 50 * time.Millisecond}, false},
+		{"height", "ibc:panic@500", storefault.Rule{StoreKey: "ibc", Kind: storefault.KindPanic, StartHeight: 500, EndHeight: 500}, false},
+		{"window", "bank/02:corrupt@100-200", storefault.Rule{StoreKey: "bank", KeyPrefix: []byte{0x02}, Kind: storefault.KindCorrupt, StartHeight: 100, EndHeight: 200}, false},
+		{"open window", "bank:corrupt@100-0", storefault.Rule{StoreKey: "bank", Kind: storefault.KindCorrupt, StartHeight: 100}, false},
+		{"missing fault", "bank", storefault.Rule{}, true},
+		{"unknown fault", "bank:explode", storefault.Rule{}, true},
+		{"empty store key", ":panic", storefault.Rule{}, true},
+		{"invalid prefix", "bank/zz:panic", storefault.Rule{}, true},
+		{"missing latency", "bank:latency", storefault.Rule{}, true},
+		{"unexpected latency", "bank:panic:50ms", storefault.Rule{}, true},
+		{"invalid latency", "bank:latency:soon", storefault.Rule{}, true},
+		{"invalid height", "bank:panic@soon", storefault.Rule{}, true},
+		{"inverted window", "bank:panic@200-100", storefault.Rule{}, true},
+		{"negative height", "bank:panic@-1", storefault.Rule{}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rules, err := storefault.ParseRules([]string{tc.rule})
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, []storefault.Rule{tc.expect}, rules)
+		})
+	}
+}
+
+// StoreFaultTestSuite injects faults into the reads of the bank store of a
+// single chain.
+type StoreFaultTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+	chain       *ibctesting.TestChain
+}
+
+func TestStoreFaultTestSuite(t *testing.T) {
+	suite.Run(t, new(StoreFaultTestSuite))
+}
+
+func (suite *StoreFaultTestSuite) SetupTest() {
+	ibctesting.DefaultTestingAppInit = app.SetupTestingAppWithStoreFaults(
+		storefault.Rule{StoreKey: banktypes.StoreKey, KeyPrefix: []byte("corrupt/"), Kind: storefault.KindCorrupt},
+		storefault.Rule{StoreKey: banktypes.StoreKey, KeyPrefix: []byte("panic/"), Kind: storefault.KindPanic},
+		storefault.Rule{StoreKey: banktypes.StoreKey, KeyPrefix: []byte("latency/"), Kind: storefault.KindLatency, Latency: 100 * time.Millisecond},
+		storefault.Rule{StoreKey: banktypes.StoreKey, KeyPrefix: []byte("window/"), Kind: storefault.KindCorrupt, StartHeight: 6, EndHeight: 7},
+		storefault.Rule{StoreKey: banktypes.StoreKey, KeyPrefix: banktypes.CreateAccountBalancesPrefix(recipient), Kind: storefault.KindPanic},
+	)
+	suite.T().Cleanup(func() {
+		ibctesting.DefaultTestingAppInit = app.SetupTestingApp
+	})
+
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 1)
+	suite.chain = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+}
+
+func (suite *StoreFaultTestSuite) bankStore() sdk.KVStore {
+	return suite.chain.GetContext().KVStore(suite.chain.App.(*app.App).GetKey(banktypes.StoreKey))
+}
+
+// commit writes the pairs to the bank store and commits them.
+func (suite *StoreFaultTestSuite) commit(pairs ...[]byte) {
+	store := suite.bankStore()
+	for i := 0; i < len(pairs); i += 2 {
+		store.Set(pairs[i], pairs[i+1])
+	}
+	suite.chain.NextBlock()
+}
+
+func (suite *StoreFaultTestSuite) TestCorrupt() {
+	key := []byte("corrupt/key")
+	suite.commit(key, []byte{0x00, 0x0f}, []byte("other/key"), []byte{0x00, 0x0f})
+
+	store := suite.bankStore()
+	suite.Require().Equal([]byte{0xff, 0xf0}, store.Get(key))
+	suite.Require().Equal([]byte{0x00, 0x0f}, store.Get([]byte("other/key")))
+	suite.Require().True(store.Has(key))
+	suite.Require().Nil(store.Get([]byte("corrupt/missing")))
+
+	iterator := store.Iterator(key, nil)
+	suite.Require().True(iterator.Valid())
+	suite.Require().Equal(key, iterator.Key())
+	suite.Require().Equal([]byte{0xff, 0xf0}, iterator.Value())
+	suite.Require().NoError(iterator.Close())
+
+	// queries read the unwrapped store
+	res := suite.chain.App.GetBaseApp().Query(abci.RequestQuery{
+		Path: "/store/" + banktypes.StoreKey + "/key",
+		Data: key,
+	})
+	suite.Require().Equal(uint32(0), res.Code, res.Log)
+	suite.Require().Equal([]byte{0x00, 0x0f}, res.Value)
+}
+
+func (suite *StoreFaultTestSuite) TestPanic() {
+	key := []byte("panic/key")
+	suite.commit(key, []byte("value"))
+
+	store := suite.bankStore()
+	for _, read := range []func(){
+		func() { store.Get(key) },
+		func() { store.Has(key) },
+		func() {
+			iterator := store.ReverseIterator(key, nil)
+			defer iterator.Close()
+			iterator.Value()
+		},
+	} {
+		suite.Require().PanicsWithError(storefault.Panic{
+			StoreKey: banktypes.StoreKey,
+			Key:      key,
+			Height:   suite.chain.CurrentHeader.Height,
+		}.Error(), read)
+	}
+
+	// values written in the current block are read from the cache
+	store.Set([]byte("panic/other"), []byte("value"))
+	suite.Require().Equal([]byte("value"), store.Get([]byte("panic/other")))
+}
+
+func (suite *StoreFaultTestSuite) TestLatency() {
+	key := []byte("latency/key")
+	suite.commit(key, []byte("value"))
+
+	start := time.Now()
+	suite.Require().Equal([]byte("value"), suite.bankStore().Get(key))
+	suite.Require().GreaterOrEqual(time.Since(start), 100*time.Millisecond)
+}
+
+func (suite *StoreFaultTestSuite) TestHeightWindow() {
+	key := []byte("window/key")
+	suite.commit(key, []byte{0x00})
+
+	for suite.chain.CurrentHeader.Height < 9 {
+		expected := []byte{0x00}
+		if height := suite.chain.CurrentHeader.Height; height >= 6 && height <= 7 {
+			expected = []byte{0xff}
+		}
+		suite.Require().Equal(expected, suite.bankStore().Get(key), "height %d", suite.chain.CurrentHeader.Height)
+		suite.chain.NextBlock()
+	}
+}
+
+func (suite *StoreFaultTestSuite) TestTransaction() {
+	msg := banktypes.NewMsgSend(
+		suite.chain.SenderAccount.GetAddress(),
+		recipient,
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+	)
+
+	_, _, err := simapp.SignAndDeliver(
+		suite.T(),
+		suite.chain.TxConfig,
+		suite.chain.App.GetBaseApp(),
+		suite.chain.GetContext().BlockHeader(),
+		[]sdk.Msg{msg},
+		suite.chain.ChainID,
+		[]uint64{suite.chain.SenderAccount.GetAccountNumber()},
+		[]uint64{suite.chain.SenderAccount.GetSequence()},
+		true, false, suite.chain.SenderPrivKey,
+	)
+	suite.Require().ErrorIs(err, sdkerrors.ErrPanic)
+	suite.Require().Contains(err.Error(), "store fault")
+
+	// the chain keeps producing blocks
+	suite.chain.NextBlock()
+}
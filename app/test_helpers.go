@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
@@ -15,13 +16,18 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	tmtypes "github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos-builders/chaos/app/storefault"
 )
 
+var _ ibctesting.TestingApp = (*App)(nil)
+
 // DefaultConsensusParams defines the default Tendermint consensus params used in
 // App testing.
 var DefaultConsensusParams = &abci.ConsensusParams{
@@ -41,16 +47,34 @@ var DefaultConsensusParams = &abci.ConsensusParams{
 	},
 }
 
-func setup(withGenesis bool, invCheckPeriod uint) (*App, GenesisState) {
+func setup(withGenesis bool, invCheckPeriod uint, baseAppOptions ...func(*baseapp.BaseApp)) (*App, GenesisState) {
 	db := dbm.NewMemDB()
 	encCdc := MakeEncodingConfig()
-	app := New(log.NewNopLogger(), db, nil, true, map[int64]bool{}, DefaultNodeHome, invCheckPeriod, encCdc, EmptyAppOptions{})
+	app := New(log.NewNopLogger(), db, nil, true, map[int64]bool{}, DefaultNodeHome, invCheckPeriod, encCdc, EmptyAppOptions{}, baseAppOptions...)
 	if withGenesis {
 		return app, NewDefaultGenesisState(encCdc.Marshaler)
 	}
 	return app, GenesisState{}
 }
 
+// SetupTestingApp initializes a new App and its default genesis state for the
+// chains of an ibctesting coordinator. A Nop logger is set in App.
+//
+//	ibctesting.DefaultTestingAppInit = app.SetupTestingApp
+func SetupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	return setup(true, 5)
+}
+
+// SetupTestingAppWithStoreFaults returns a SetupTestingApp injecting the
+// faults of the rules into the stores of the App.
+//
+//	ibctesting.DefaultTestingAppInit = app.SetupTestingAppWithStoreFaults(rules...)
+func SetupTestingAppWithStoreFaults(rules ...storefault.Rule) func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	return func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+		return setup(true, 5, storefault.SetStoreFaults(nil, rules))
+	}
+}
+
 // Setup initializes a new App. A Nop logger is set in App.
 func Setup(t *testing.T, isCheckTx bool) *App {
 	t.Helper()
@@ -140,6 +164,15 @@ func genesisStateWithValSet(t *testing.T,
 	return genesisState
 }
 
+// NewGenesisStateWithValSet returns the default genesis state of the App with
+// a validator set and genesis accounts, as set up by SetupWithGenesisValSet.
+func NewGenesisStateWithValSet(t *testing.T, valSet *tmtypes.ValidatorSet, genAccs []authtypes.GenesisAccount, balances ...banktypes.Balance) GenesisState {
+	t.Helper()
+
+	app, genesisState := setup(true, 5)
+	return genesisStateWithValSet(t, app, genesisState, valSet, genAccs, balances...)
+}
+
 // SetupWithGenesisValSet initializes a new SimApp with a validator set and genesis accounts
 // that also act as delegators. For simplicity, each validator is bonded with a delegation
 // of one consensus engine unit in the default token of the simapp from first genesis
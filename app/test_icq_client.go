@@ -0,0 +1,172 @@
+package app
+
+import (
+	"fmt"
+	"time"
+
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	icqhosttypes "github.com/cosmos-builders/chaos/x/icqhost/types"
+)
+
+// ICQControllerPortID is the port the ICQTestClient sends interchain queries
+// from.
+const ICQControllerPortID = "icqcontroller"
+
+// ICQTestClient is the controller end of an interchain query channel between
+// two chains of an ibctesting coordinator. chaos only hosts interchain
+// queries, so the client drives the IBC keepers of the controller chain
+// directly instead of going through an application.
+type ICQTestClient struct {
+	Path *ibctesting.Path
+
+	chanCap *capabilitytypes.Capability
+}
+
+// NewICQTestClient connects controller to hostChain and opens an interchain
+// query channel from controller to the icqhost port of hostChain.
+func NewICQTestClient(coordinator *ibctesting.Coordinator, controller, hostChain *ibctesting.TestChain) (*ICQTestClient, error) {
+	path := ibctesting.NewPath(controller, hostChain)
+	path.EndpointA.ChannelConfig.PortID = ICQControllerPortID
+	path.EndpointB.ChannelConfig.PortID = icqhosttypes.PortID
+	path.EndpointA.ChannelConfig.Order = channeltypes.UNORDERED
+	path.EndpointB.ChannelConfig.Order = channeltypes.UNORDERED
+	path.EndpointA.ChannelConfig.Version = icqhosttypes.Version
+	path.EndpointB.ChannelConfig.Version = icqhosttypes.Version
+	coordinator.SetupConnections(path)
+
+	client := &ICQTestClient{Path: path}
+	if err := client.openChannel(); err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
+
+func (c *ICQTestClient) openChannel() error {
+	controller, hostEnd := c.Path.EndpointA, c.Path.EndpointB
+	chain := controller.Chain
+	ctx := chain.GetContext()
+	ibcKeeper := chain.App.GetIBCKeeper()
+
+	portCap, found := chain.App.GetScopedIBCKeeper().GetCapability(ctx, host.PortPath(ICQControllerPortID))
+	if !found {
+		portCap = ibcKeeper.PortKeeper.BindPort(ctx, ICQControllerPortID)
+	}
+
+	counterparty := channeltypes.NewCounterparty(hostEnd.ChannelConfig.PortID, "")
+	connectionHops := []string{controller.ConnectionID}
+	channelID, chanCap, err := ibcKeeper.ChannelKeeper.ChanOpenInit(
+		ctx, controller.ChannelConfig.Order, connectionHops, controller.ChannelConfig.PortID, portCap, counterparty, controller.ChannelConfig.Version,
+	)
+	if err != nil {
+		return err
+	}
+	ibcKeeper.ChannelKeeper.WriteOpenInitChannel(
+		ctx, controller.ChannelConfig.PortID, channelID, controller.ChannelConfig.Order, connectionHops, counterparty, controller.ChannelConfig.Version,
+	)
+	chain.Coordinator.CommitBlock(chain)
+
+	controller.ChannelID = channelID
+	c.chanCap = chanCap
+
+	if err := hostEnd.ChanOpenTry(); err != nil {
+		return err
+	}
+
+	if err := controller.UpdateClient(); err != nil {
+		return err
+	}
+	proof, proofHeight := hostEnd.Chain.QueryProof(host.ChannelKey(hostEnd.ChannelConfig.PortID, hostEnd.ChannelID))
+
+	ctx = chain.GetContext()
+	hostVersion := hostEnd.GetChannel().Version
+	if err := ibcKeeper.ChannelKeeper.ChanOpenAck(
+		ctx, controller.ChannelConfig.PortID, controller.ChannelID, c.chanCap, hostVersion, hostEnd.ChannelID, proof, proofHeight,
+	); err != nil {
+		return err
+	}
+	ibcKeeper.ChannelKeeper.WriteOpenAckChannel(ctx, controller.ChannelConfig.PortID, controller.ChannelID, hostVersion, hostEnd.ChannelID)
+	chain.Coordinator.CommitBlock(chain)
+
+	return hostEnd.ChanOpenConfirm()
+}
+
+// Query sends reqs to the host chain, relays the packet and its
+// acknowledgement and returns the responses of the host. An error
+// acknowledgement is returned as an error.
+func (c *ICQTestClient) Query(reqs ...abci.RequestQuery) ([]abci.ResponseQuery, error) {
+	ack, err := c.SendQuery(reqs...)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ack.Success() {
+		return nil, fmt.Errorf("interchain query failed: %s", ack.GetError())
+	}
+
+	var packetAck icqhosttypes.InterchainQueryPacketAck
+	if err := icqhosttypes.ModuleCdc.UnmarshalJSON(ack.GetResult(), &packetAck); err != nil {
+		return nil, err
+	}
+
+	return icqhosttypes.DeserializeCosmosResponse(packetAck.Data)
+}
+
+// SendQuery sends reqs to the host chain, relays the packet and its
+// acknowledgement and returns the acknowledgement.
+func (c *ICQTestClient) SendQuery(reqs ...abci.RequestQuery) (channeltypes.Acknowledgement, error) {
+	controller, hostEnd := c.Path.EndpointA, c.Path.EndpointB
+	chain := controller.Chain
+	channelKeeper := chain.App.GetIBCKeeper().ChannelKeeper
+
+	data, err := icqhosttypes.NewInterchainQueryPacketData(reqs, "")
+	if err != nil {
+		return channeltypes.Acknowledgement{}, err
+	}
+
+	sequence, _ := channelKeeper.GetNextSequenceSend(chain.GetContext(), controller.ChannelConfig.PortID, controller.ChannelID)
+	packet := channeltypes.NewPacket(
+		data.GetBytes(), sequence,
+		controller.ChannelConfig.PortID, controller.ChannelID,
+		hostEnd.ChannelConfig.PortID, hostEnd.ChannelID,
+		clienttypes.ZeroHeight(), uint64(hostEnd.Chain.CurrentHeader.Time.Add(time.Hour).UnixNano()),
+	)
+	if err := channelKeeper.SendPacket(chain.GetContext(), c.chanCap, packet); err != nil {
+		return channeltypes.Acknowledgement{}, err
+	}
+	chain.Coordinator.CommitBlock(chain)
+
+	if err := hostEnd.UpdateClient(); err != nil {
+		return channeltypes.Acknowledgement{}, err
+	}
+	res, err := hostEnd.RecvPacketWithResult(packet)
+	if err != nil {
+		return channeltypes.Acknowledgement{}, err
+	}
+	ackBz, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	if err != nil {
+		return channeltypes.Acknowledgement{}, err
+	}
+
+	if err := controller.UpdateClient(); err != nil {
+		return channeltypes.Acknowledgement{}, err
+	}
+	proof, proofHeight := hostEnd.Chain.QueryProof(host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+	if err := channelKeeper.AcknowledgePacket(chain.GetContext(), c.chanCap, packet, ackBz, proof, proofHeight); err != nil {
+		return channeltypes.Acknowledgement{}, err
+	}
+	chain.Coordinator.CommitBlock(chain)
+
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(ackBz, &ack); err != nil {
+		return channeltypes.Acknowledgement{}, err
+	}
+
+	return ack, nil
+}
@@ -0,0 +1,290 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/cosmos/ibc-go/v5/testing/simapp/helpers"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos-builders/chaos/app/upgrades"
+)
+
+const (
+	upgradeChainID = "upgrade-1"
+	// upgradeBlockTime is the time between two blocks of an UpgradeChain.
+	upgradeBlockTime = 5 * time.Second
+	// upgradeVotingPeriod is the voting period of the proposals of an
+	// UpgradeChain.
+	upgradeVotingPeriod = 4 * upgradeBlockTime
+)
+
+// UpgradeChain is a single validator chain run by an App on an on-disk
+// database, to test a software upgrade before running it on a testnet:
+//
+//	chain := app.NewUpgradeChain(t, "v2")
+//	chain.ProposeUpgrade(10)
+//	chain.RequireHalt()
+//	chain.Upgrade(v2.Upgrade)
+//	chain.RequireInvariants()
+//
+// The App of the current binary plays the old binary, without the handler of
+// the upgrade, and the new binary once the handler is registered.
+type UpgradeChain struct {
+	t *testing.T
+	// Dir is the home of the App, holding its database and the upgrade-info
+	// file.
+	Dir string
+	// App is the App running the chain.
+	App *App
+	// Plan is the plan of the upgrade, once proposed.
+	Plan upgradetypes.Plan
+
+	db       dbm.DB
+	name     string
+	registry []upgrades.Upgrade
+	proposer []byte
+	// the delegator of the validator submits and votes the proposals
+	delegator cryptotypes.PrivKey
+	sequence  uint64
+}
+
+// NewUpgradeChain starts an UpgradeChain on a new database and commits its
+// first block. The handler of the upgrade of a name is removed from the
+// registered upgrades until the chain is upgraded.
+func NewUpgradeChain(t *testing.T, name string) *UpgradeChain {
+	t.Helper()
+
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	validator := tmtypes.NewValidator(pubKey, 1)
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{validator})
+
+	delegator := secp256k1.GenPrivKey()
+	acc := authtypes.NewBaseAccount(delegator.PubKey().Address().Bytes(), delegator.PubKey(), 0, 0)
+	balance := banktypes.Balance{
+		Address: acc.GetAddress().String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100000000000000))),
+	}
+
+	var registry []upgrades.Upgrade
+	for _, u := range Upgrades {
+		if u.Name != name {
+			registry = append(registry, u)
+		}
+	}
+
+	c := &UpgradeChain{
+		t:         t,
+		Dir:       t.TempDir(),
+		name:      name,
+		registry:  registry,
+		proposer:  validator.Address,
+		delegator: delegator,
+	}
+	c.open(registry)
+	t.Cleanup(func() { require.NoError(t, c.db.Close()) })
+
+	genesisState := NewGenesisStateWithValSet(t, valSet, []authtypes.GenesisAccount{acc}, balance)
+	var govGenesis govv1.GenesisState
+	c.App.AppCodec().MustUnmarshalJSON(genesisState[govtypes.ModuleName], &govGenesis)
+	votingPeriod := upgradeVotingPeriod
+	govGenesis.VotingParams.VotingPeriod = &votingPeriod
+	genesisState[govtypes.ModuleName] = c.App.AppCodec().MustMarshalJSON(&govGenesis)
+
+	stateBytes, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+	c.App.InitChain(abci.RequestInitChain{
+		ChainId:         upgradeChainID,
+		Time:            c.blockTime(0),
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	c.NextBlock()
+
+	return c
+}
+
+// open opens the App of the chain on its database with a registry of
+// upgrades.
+func (c *UpgradeChain) open(registry []upgrades.Upgrade) {
+	if c.db != nil {
+		require.NoError(c.t, c.db.Close())
+	}
+	db, err := dbm.NewGoLevelDB("application", filepath.Join(c.Dir, "data"))
+	require.NoError(c.t, err)
+	c.db = db
+
+	registered := Upgrades
+	Upgrades = registry
+	defer func() { Upgrades = registered }()
+	c.App = New(log.NewNopLogger(), db, nil, true, map[int64]bool{}, c.Dir, 5, MakeEncodingConfig(), EmptyAppOptions{})
+}
+
+func (c *UpgradeChain) blockTime(height int64) time.Time {
+	return time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC).Add(time.Duration(height) * upgradeBlockTime)
+}
+
+func (c *UpgradeChain) beginBlock() {
+	c.App.BeginBlock(abci.RequestBeginBlock{Header: c.header()})
+}
+
+func (c *UpgradeChain) endBlock() {
+	c.App.EndBlock(abci.RequestEndBlock{Height: c.App.LastBlockHeight() + 1})
+	c.App.Commit()
+}
+
+// NextBlock commits an empty block.
+func (c *UpgradeChain) NextBlock() {
+	c.beginBlock()
+	c.endBlock()
+}
+
+// Update commits a block whose state is modified by a function, e.g. to
+// set up the state written by the old binary.
+func (c *UpgradeChain) Update(update func(ctx sdk.Context)) {
+	c.beginBlock()
+	update(c.App.BaseApp.NewContext(false, c.header()))
+	c.endBlock()
+}
+
+// Context returns a context on the last committed state.
+func (c *UpgradeChain) Context() sdk.Context {
+	return c.App.BaseApp.NewContext(true, c.header())
+}
+
+// header returns the header of the next block.
+func (c *UpgradeChain) header() tmproto.Header {
+	height := c.App.LastBlockHeight() + 1
+	return tmproto.Header{
+		ChainID:         upgradeChainID,
+		Height:          height,
+		Time:            c.blockTime(height),
+		ProposerAddress: c.proposer,
+	}
+}
+
+// Deliver commits a block with a transaction of messages signed by the
+// delegator of the validator.
+func (c *UpgradeChain) Deliver(msgs ...sdk.Msg) {
+	txConfig := MakeEncodingConfig().TxConfig
+	tx, err := helpers.GenTx(
+		txConfig,
+		msgs,
+		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)},
+		helpers.DefaultGenTxGas,
+		upgradeChainID,
+		[]uint64{0},
+		[]uint64{c.sequence},
+		c.delegator,
+	)
+	require.NoError(c.t, err)
+	bz, err := txConfig.TxEncoder()(tx)
+	require.NoError(c.t, err)
+
+	c.beginBlock()
+	res := c.App.DeliverTx(abci.RequestDeliverTx{Tx: bz})
+	require.True(c.t, res.IsOK(), res.Log)
+	c.sequence++
+	c.endBlock()
+}
+
+// ProposeUpgrade submits the governance proposal of a MsgSoftwareUpgrade
+// planning the upgrade of the chain at a height, votes it and commits blocks
+// until it passes.
+func (c *UpgradeChain) ProposeUpgrade(height int64) {
+	c.Plan = upgradetypes.Plan{Name: c.name, Height: height, Info: fmt.Sprintf("upgrade %s at %d", c.name, height)}
+	proposer := sdk.AccAddress(c.delegator.PubKey().Address())
+
+	ctx := c.Context()
+	proposalID, err := c.App.GovKeeper.GetProposalID(ctx)
+	require.NoError(c.t, err)
+	msg, err := govv1.NewMsgSubmitProposal(
+		[]sdk.Msg{&upgradetypes.MsgSoftwareUpgrade{
+			Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+			Plan:      c.Plan,
+		}},
+		c.App.GovKeeper.GetDepositParams(ctx).MinDeposit,
+		proposer.String(),
+		"",
+	)
+	require.NoError(c.t, err)
+	c.Deliver(msg)
+	c.Deliver(govv1.NewMsgVote(proposer, proposalID, govv1.OptionYes, ""))
+
+	for i := 0; i < int(upgradeVotingPeriod/upgradeBlockTime); i++ {
+		c.NextBlock()
+	}
+	require.Less(c.t, c.App.LastBlockHeight(), height, "the upgrade height is reached before the end of the voting period")
+
+	plan, found := c.App.UpgradeKeeper.GetUpgradePlan(c.Context())
+	require.True(c.t, found, "the upgrade proposal did not pass")
+	require.Equal(c.t, c.Plan, plan)
+}
+
+// RequireHalt commits the blocks until the height of the upgrade and
+// checks that the chain halts at this height with the panic of the upgrade
+// and writes its upgrade-info file.
+func (c *UpgradeChain) RequireHalt() {
+	for c.App.LastBlockHeight()+1 < c.Plan.Height {
+		c.NextBlock()
+	}
+
+	require.PanicsWithValue(c.t, upgrade.BuildUpgradeNeededMsg(c.Plan), c.beginBlock)
+
+	bz, err := os.ReadFile(filepath.Join(c.Dir, "data", upgradetypes.UpgradeInfoFilename))
+	require.NoError(c.t, err)
+	var upgradeInfo upgradetypes.Plan
+	require.NoError(c.t, json.Unmarshal(bz, &upgradeInfo))
+	require.Equal(c.t, c.Plan.Name, upgradeInfo.Name)
+	require.Equal(c.t, c.Plan.Height, upgradeInfo.Height)
+}
+
+// Upgrade reopens the database of the halted chain with the handler of the
+// upgrade registered, as the new binary, and commits the block of the
+// upgrade. It checks that the upgrade is done and that the module versions
+// are those of the new binary.
+func (c *UpgradeChain) Upgrade(upgrade upgrades.Upgrade) {
+	require.Equal(c.t, c.Plan.Name, upgrade.Name)
+	c.open(append(c.registry, upgrade))
+	require.Equal(c.t, c.Plan.Height-1, c.App.LastBlockHeight())
+
+	c.NextBlock()
+
+	ctx := c.Context()
+	require.Equal(c.t, c.Plan.Height, c.App.UpgradeKeeper.GetDoneHeight(ctx, c.Plan.Name))
+	_, found := c.App.UpgradeKeeper.GetUpgradePlan(ctx)
+	require.False(c.t, found)
+	require.Equal(c.t, c.App.mm.GetVersionMap(), c.App.UpgradeKeeper.GetModuleVersionMap(ctx))
+}
+
+// RequireInvariants checks the invariants of the last committed state.
+func (c *UpgradeChain) RequireInvariants() {
+	ctx := c.Context()
+	for _, route := range c.App.CrisisKeeper.Routes() {
+		msg, broken := route.Invar(ctx)
+		require.False(c.t, broken, msg)
+	}
+}
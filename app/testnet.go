@@ -0,0 +1,266 @@
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+// InPlaceTestnetFile is the file of the data directory holding the
+// InPlaceTestnet applied to the state when the app loads its height.
+const InPlaceTestnetFile = "in-place-testnet.json"
+
+// inPlaceTestnetFundTokens are the bond denom tokens sent to the funded
+// accounts of an InPlaceTestnet without fund coins.
+var inPlaceTestnetFundTokens = sdk.NewInt(1_000_000_000_000)
+
+// InPlaceTestnet is the fork of the state of a chain into a single validator
+// testnet, to rehearse upgrades and incidents on real state. The Tendermint
+// state of the node is rewritten by the in-place-testnet command, while the
+// app state is changed by New when it loads the height of the fork, so that
+// the changes are committed with the next block. A testnet of several
+// validators is not supported, since the node signs the forked commit alone.
+type InPlaceTestnet struct {
+	// Height and Time are the height and the time of the last block of the
+	// chain.
+	Height  int64     `json:"height"`
+	Time    time.Time `json:"time"`
+	ChainID string    `json:"chain_id"`
+	// ValidatorPubKey is the ed25519 consensus public key of the single
+	// validator of the testnet, whose power is ValidatorPower.
+	ValidatorPubKey []byte `json:"validator_pub_key"`
+	ValidatorPower  int64  `json:"validator_power"`
+	// Operator is the account operating the validator. Its self delegation
+	// is minted.
+	Operator string `json:"operator"`
+	Moniker  string `json:"moniker"`
+	// AccountsToFund are the test accounts receiving FundCoins, minted.
+	AccountsToFund []string  `json:"accounts_to_fund"`
+	FundCoins      sdk.Coins `json:"fund_coins"`
+	// VotingPeriod is the voting period of the governance proposals,
+	// including the proposals in voting period.
+	VotingPeriod time.Duration `json:"voting_period"`
+}
+
+// Validate checks the addresses, the key and the values of the testnet.
+func (t InPlaceTestnet) Validate() error {
+	if t.Height <= 0 {
+		return fmt.Errorf("height must be positive")
+	}
+	if len(t.ValidatorPubKey) != ed25519.PubKeySize {
+		return fmt.Errorf("invalid ed25519 validator public key: expected %d bytes, got %d", ed25519.PubKeySize, len(t.ValidatorPubKey))
+	}
+	if t.ValidatorPower <= 0 {
+		return fmt.Errorf("validator power must be positive")
+	}
+	if _, err := sdk.AccAddressFromBech32(t.Operator); err != nil {
+		return fmt.Errorf("invalid operator %s: %w", t.Operator, err)
+	}
+	for _, addr := range t.AccountsToFund {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid account to fund %s: %w", addr, err)
+		}
+	}
+	if err := t.FundCoins.Validate(); err != nil {
+		return fmt.Errorf("invalid fund coins: %w", err)
+	}
+	if t.VotingPeriod <= 0 {
+		return fmt.Errorf("voting period must be positive")
+	}
+	return nil
+}
+
+// WriteInPlaceTestnet writes the InPlaceTestnet of the node of a home
+// directory, applied when the app is started.
+func WriteInPlaceTestnet(homePath string, t InPlaceTestnet) error {
+	if err := t.Validate(); err != nil {
+		return err
+	}
+	bz, err := json.MarshalIndent(t, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(homePath, "data", InPlaceTestnetFile), bz, 0o600)
+}
+
+// ReadInPlaceTestnet reads the InPlaceTestnet of the node of a home
+// directory, nil if there is none.
+func ReadInPlaceTestnet(homePath string) (*InPlaceTestnet, error) {
+	bz, err := os.ReadFile(filepath.Join(homePath, "data", InPlaceTestnetFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var t InPlaceTestnet
+	if err := json.Unmarshal(bz, &t); err != nil {
+		return nil, fmt.Errorf("cannot read %s: %w", InPlaceTestnetFile, err)
+	}
+	if err := t.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", InPlaceTestnetFile, err)
+	}
+	return &t, nil
+}
+
+// setupInPlaceTestnet applies the InPlaceTestnet of the home directory to the
+// loaded state if the app is at the height of the fork. The changes are
+// written to the uncommitted state, and applied again on restart until the
+// next block is committed. The file is removed once they are committed.
+func (app *App) setupInPlaceTestnet(homePath string) error {
+	t, err := ReadInPlaceTestnet(homePath)
+	if err != nil || t == nil {
+		return err
+	}
+
+	switch height := app.LastBlockHeight(); {
+	case height > t.Height:
+		app.Logger().Info("in-place testnet applied", "height", t.Height)
+		return os.Remove(filepath.Join(homePath, "data", InPlaceTestnetFile))
+	case height < t.Height:
+		return fmt.Errorf("cannot apply the in-place testnet of height %d at height %d", t.Height, height)
+	}
+
+	ctx := app.NewUncachedContext(false, tmproto.Header{ChainID: t.ChainID, Height: t.Height, Time: t.Time})
+	// the changes are written once all of them succeeded
+	cacheCtx, write := ctx.CacheContext()
+	if err := app.ApplyInPlaceTestnet(cacheCtx, *t); err != nil {
+		return fmt.Errorf("failed to apply the in-place testnet: %w", err)
+	}
+	write()
+
+	app.Logger().Info("applying in-place testnet", "height", t.Height, "validator", t.Moniker, "funded_accounts", len(t.AccountsToFund))
+	return nil
+}
+
+// ApplyInPlaceTestnet changes the state of a chain into the state of a single
+// validator testnet:
+//   - the validators are jailed and unbonded, and the validator of the
+//     testnet is created with a minted self delegation and bonded,
+//   - its slashing signing info is reset and it is the previous proposer of
+//     the distribution,
+//   - the test accounts are funded with minted coins,
+//   - the voting period of the proposals is shortened.
+//
+// The validator set updates are not returned to Tendermint, whose validator
+// set is rewritten to the single validator.
+func (app *App) ApplyInPlaceTestnet(ctx sdk.Context, t InPlaceTestnet) error {
+	if err := t.Validate(); err != nil {
+		return err
+	}
+	pubKey := &ed25519.PubKey{Key: t.ValidatorPubKey}
+	consAddr := sdk.ConsAddress(pubKey.Address())
+	operator := sdk.MustAccAddressFromBech32(t.Operator)
+	valAddr := sdk.ValAddress(operator)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	if val, found := app.StakingKeeper.GetValidatorByConsAddr(ctx, consAddr); found {
+		return fmt.Errorf("the consensus key of the testnet validator is used by %s", val.OperatorAddress)
+	}
+
+	/* Handle staking state. */
+
+	for _, val := range app.StakingKeeper.GetAllValidators(ctx) {
+		if val.Jailed {
+			continue
+		}
+		valConsAddr, err := val.GetConsAddr()
+		if err != nil {
+			return err
+		}
+		app.StakingKeeper.Jail(ctx, valConsAddr)
+	}
+
+	selfDelegation := sdk.NewCoin(bondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, t.ValidatorPower))
+	if err := app.mintCoins(ctx, operator, sdk.NewCoins(selfDelegation)); err != nil {
+		return err
+	}
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		valAddr,
+		pubKey,
+		selfDelegation,
+		stakingtypes.NewDescription(t.Moniker, "", "", "", ""),
+		stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2)),
+		sdk.OneInt(),
+	)
+	if err != nil {
+		return err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	if _, err := stakingkeeper.NewMsgServerImpl(app.StakingKeeper).CreateValidator(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return fmt.Errorf("failed to create the testnet validator: %w", err)
+	}
+
+	// the jailed validators are unbonded and the testnet validator bonded
+	if _, err := app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx); err != nil {
+		return err
+	}
+	bonded := app.StakingKeeper.GetLastValidators(ctx)
+	if len(bonded) != 1 || bonded[0].OperatorAddress != valAddr.String() {
+		return fmt.Errorf("expected the testnet validator to be the single bonded validator, got %d validators", len(bonded))
+	}
+
+	/* Handle slashing state. */
+
+	app.SlashingKeeper.SetValidatorSigningInfo(ctx, consAddr, slashingtypes.NewValidatorSigningInfo(
+		consAddr, t.Height, 0, time.Unix(0, 0), false, 0,
+	))
+
+	/* Handle fee distribution state. */
+
+	app.DistrKeeper.SetPreviousProposerConsAddr(ctx, consAddr)
+
+	/* Handle bank state. */
+
+	fundCoins := t.FundCoins
+	if fundCoins.Empty() {
+		fundCoins = sdk.NewCoins(sdk.NewCoin(bondDenom, inPlaceTestnetFundTokens))
+	}
+	for _, addr := range t.AccountsToFund {
+		if err := app.mintCoins(ctx, sdk.MustAccAddressFromBech32(addr), fundCoins); err != nil {
+			return err
+		}
+	}
+
+	/* Handle gov state. */
+
+	votingParams := app.GovKeeper.GetVotingParams(ctx)
+	votingParams.VotingPeriod = &t.VotingPeriod
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+
+	votingEndTime := ctx.BlockTime().Add(t.VotingPeriod)
+	for _, proposal := range app.GovKeeper.GetProposals(ctx) {
+		if proposal.Status != govv1.StatusVotingPeriod || !proposal.VotingEndTime.After(votingEndTime) {
+			continue
+		}
+		app.GovKeeper.RemoveFromActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+		proposal.VotingEndTime = &votingEndTime
+		app.GovKeeper.InsertActiveProposalQueue(ctx, proposal.Id, votingEndTime)
+		app.GovKeeper.SetProposal(ctx, *proposal)
+	}
+
+	return nil
+}
+
+// mintCoins mints coins to an account.
+func (app *App) mintCoins(ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins) error {
+	if err := app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins); err != nil {
+		return err
+	}
+	return app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr, coins)
+}
@@ -0,0 +1,67 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+
+	"github.com/cosmos-builders/chaos/app"
+)
+
+func TestApplyInPlaceTestnet(t *testing.T) {
+	ibctesting.DefaultTestingAppInit = app.SetupTestingApp
+	coordinator := ibctesting.NewCoordinator(t, 1)
+	chain := coordinator.GetChain(ibctesting.GetChainID(1))
+	chainApp := chain.App.(*app.App)
+	ctx := chain.GetContext()
+
+	formerValidators := chainApp.StakingKeeper.GetLastValidators(ctx)
+	consPubKey := ed25519.GenPrivKey().PubKey()
+	operator := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	funded := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	testnet := app.InPlaceTestnet{
+		Height:          ctx.BlockHeight(),
+		Time:            ctx.BlockTime(),
+		ChainID:         ctx.ChainID(),
+		ValidatorPubKey: consPubKey.Bytes(),
+		ValidatorPower:  10,
+		Operator:        operator.String(),
+		Moniker:         "testnet",
+		AccountsToFund:  []string{funded.String()},
+		VotingPeriod:    time.Minute,
+	}
+	require.NoError(t, chainApp.ApplyInPlaceTestnet(ctx, testnet))
+
+	bonded := chainApp.StakingKeeper.GetLastValidators(ctx)
+	require.Len(t, bonded, 1)
+	require.Equal(t, sdk.ValAddress(operator).String(), bonded[0].OperatorAddress)
+	require.Equal(t, int64(10), chainApp.StakingKeeper.GetLastValidatorPower(ctx, sdk.ValAddress(operator)))
+	for _, val := range formerValidators {
+		val, found := chainApp.StakingKeeper.GetValidator(ctx, val.GetOperator())
+		require.True(t, found)
+		require.True(t, val.Jailed)
+		require.True(t, val.IsUnbonding())
+	}
+
+	consAddr := sdk.ConsAddress(consPubKey.Address())
+	signingInfo, found := chainApp.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, testnet.Height, signingInfo.StartHeight)
+	require.Equal(t, consAddr, chainApp.DistrKeeper.GetPreviousProposerConsAddr(ctx))
+	require.False(t, chainApp.BankKeeper.GetBalance(ctx, funded, sdk.DefaultBondDenom).IsZero())
+	require.Equal(t, time.Minute, *chainApp.GovKeeper.GetVotingParams(ctx).VotingPeriod)
+
+	for _, route := range chainApp.CrisisKeeper.Routes() {
+		msg, broken := route.Invar(ctx)
+		require.False(t, broken, msg)
+	}
+
+	// the consensus key cannot be used twice
+	require.Error(t, chainApp.ApplyInPlaceTestnet(ctx, testnet))
+}
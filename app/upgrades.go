@@ -0,0 +1,41 @@
+package app
+
+import (
+	"fmt"
+
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/cosmos-builders/chaos/app/upgrades"
+)
+
+// Upgrades are the software upgrades of the chain. Their handlers are
+// registered by New, which also applies the store upgrades of the upgrade
+// whose plan halted the chain.
+var Upgrades = []upgrades.Upgrade{}
+
+// setupUpgrades registers the handlers of the upgrades and sets the store
+// loader of the upgrade of the upgrade-info file written when the chain
+// halted, so that its store upgrades are applied when its height is loaded.
+// It must be called before the stores are loaded.
+func (app *App) setupUpgrades(upgradeList []upgrades.Upgrade) {
+	if err := upgrades.Validate(upgradeList); err != nil {
+		panic(err)
+	}
+
+	for _, u := range upgradeList {
+		app.UpgradeKeeper.SetUpgradeHandler(u.Name, u.Handler(app.mm, app.configurator, app))
+	}
+
+	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		panic(fmt.Sprintf("failed to read upgrade info from disk: %s", err))
+	}
+	if upgradeInfo.Name == "" || app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		return
+	}
+
+	if u, found := upgrades.Find(upgradeList, upgradeInfo.Name); found {
+		storeUpgrades := u.StoreUpgrades
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+	}
+}
@@ -0,0 +1,124 @@
+// Package upgrades describes the software upgrades of the chain: the handler
+// run at the height of an upgrade plan and the stores added, renamed or
+// deleted by the new version of the application.
+//
+// Each upgrade lives in a package of its own, e.g. app/upgrades/v2, exporting
+// an Upgrade registered in app.Upgrades.
+package upgrades
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// AppKeepers gives the upgrade handlers access to the stores and the
+// parameters of the app.
+type AppKeepers interface {
+	AppCodec() codec.Codec
+	GetKey(storeKey string) *storetypes.KVStoreKey
+	GetSubspace(moduleName string) paramstypes.Subspace
+}
+
+// Upgrade is a software upgrade of the chain.
+type Upgrade struct {
+	// Name is the name of the upgrade plan.
+	Name string
+	// CreateUpgradeHandler creates the handler of the upgrade from the module
+	// manager, the configurator and the keepers of the app. The handler runs
+	// the module migrations if it is nil.
+	CreateUpgradeHandler func(mm *module.Manager, configurator module.Configurator, keepers AppKeepers) upgradetypes.UpgradeHandler
+	// StoreUpgrades are the stores added, renamed and deleted by the upgrade,
+	// applied when the new version loads the height of the upgrade.
+	StoreUpgrades storetypes.StoreUpgrades
+}
+
+// Handler returns the handler of the upgrade.
+func (u Upgrade) Handler(mm *module.Manager, configurator module.Configurator, keepers AppKeepers) upgradetypes.UpgradeHandler {
+	if u.CreateUpgradeHandler == nil {
+		return CreateDefaultUpgradeHandler(mm, configurator, keepers)
+	}
+	return u.CreateUpgradeHandler(mm, configurator, keepers)
+}
+
+// Validate checks that the upgrade is named and that no store is upgraded
+// twice.
+func (u Upgrade) Validate() error {
+	if u.Name == "" {
+		return fmt.Errorf("upgrade name cannot be empty")
+	}
+
+	upgraded := make(map[string]bool)
+	upgrade := func(name string) error {
+		if name == "" {
+			return fmt.Errorf("upgrade %s: store name cannot be empty", u.Name)
+		}
+		if upgraded[name] {
+			return fmt.Errorf("upgrade %s: store %s is upgraded twice", u.Name, name)
+		}
+		upgraded[name] = true
+		return nil
+	}
+
+	for _, name := range u.StoreUpgrades.Added {
+		if err := upgrade(name); err != nil {
+			return err
+		}
+	}
+	for _, name := range u.StoreUpgrades.Deleted {
+		if err := upgrade(name); err != nil {
+			return err
+		}
+	}
+	for _, rename := range u.StoreUpgrades.Renamed {
+		if err := upgrade(rename.OldKey); err != nil {
+			return err
+		}
+		if err := upgrade(rename.NewKey); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CreateDefaultUpgradeHandler creates a handler running the migrations of the
+// modules whose consensus version changed.
+func CreateDefaultUpgradeHandler(mm *module.Manager, configurator module.Configurator, _ AppKeepers) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		return mm.RunMigrations(ctx, configurator, fromVM)
+	}
+}
+
+// Validate checks the upgrades of a registry and that their names are
+// unique.
+func Validate(upgrades []Upgrade) error {
+	names := make(map[string]bool)
+	for _, u := range upgrades {
+		if err := u.Validate(); err != nil {
+			return err
+		}
+		if names[u.Name] {
+			return fmt.Errorf("duplicate upgrade %s", u.Name)
+		}
+		names[u.Name] = true
+	}
+
+	return nil
+}
+
+// Find returns the upgrade of a name.
+func Find(upgrades []Upgrade, name string) (Upgrade, bool) {
+	for _, u := range upgrades {
+		if u.Name == name {
+			return u, true
+		}
+	}
+
+	return Upgrade{}, false
+}
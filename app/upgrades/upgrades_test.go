@@ -0,0 +1,51 @@
+package upgrades_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+
+	"github.com/cosmos-builders/chaos/app/upgrades"
+)
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		upgrades []upgrades.Upgrade
+		expPass  bool
+	}{
+		{"empty registry", nil, true},
+		{"valid upgrades", []upgrades.Upgrade{
+			{Name: "v2"},
+			{Name: "v3", StoreUpgrades: storetypes.StoreUpgrades{
+				Added:   []string{"new"},
+				Renamed: []storetypes.StoreRename{{OldKey: "old", NewKey: "renamed"}},
+				Deleted: []string{"deleted"},
+			}},
+		}, true},
+		{"unnamed upgrade", []upgrades.Upgrade{{}}, false},
+		{"duplicate upgrade", []upgrades.Upgrade{{Name: "v2"}, {Name: "v2"}}, false},
+		{"unnamed store", []upgrades.Upgrade{{Name: "v2", StoreUpgrades: storetypes.StoreUpgrades{Added: []string{""}}}}, false},
+		{"store added and deleted", []upgrades.Upgrade{{Name: "v2", StoreUpgrades: storetypes.StoreUpgrades{
+			Added:   []string{"store"},
+			Deleted: []string{"store"},
+		}}}, false},
+		{"store renamed to an added store", []upgrades.Upgrade{{Name: "v2", StoreUpgrades: storetypes.StoreUpgrades{
+			Added:   []string{"store"},
+			Renamed: []storetypes.StoreRename{{OldKey: "old", NewKey: "store"}},
+		}}}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := upgrades.Validate(tc.upgrades)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
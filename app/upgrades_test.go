@@ -0,0 +1,160 @@
+package app_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/cosmos-builders/chaos/app"
+	"github.com/cosmos-builders/chaos/app/upgrades"
+)
+
+func TestUpgradeRegistry(t *testing.T) {
+	registered := app.Upgrades
+	t.Cleanup(func() { app.Upgrades = registered })
+
+	home := t.TempDir()
+	db := dbm.NewMemDB()
+	newApp := func(skipUpgradeHeights map[int64]bool) *app.App {
+		return app.New(log.NewNopLogger(), db, nil, true, skipUpgradeHeights, home, 5, app.MakeEncodingConfig(), app.EmptyAppOptions{})
+	}
+
+	// commit the genesis of a chain halted by the plan of the upgrade at the
+	// next height
+	app.Upgrades = nil
+	chainApp := newApp(nil)
+	initChain(t, chainApp)
+	plan := upgradetypes.Plan{Name: "test", Height: chainApp.LastBlockHeight() + 1}
+	require.NoError(t, chainApp.UpgradeKeeper.DumpUpgradeInfoToDisk(plan.Height, plan))
+
+	mintStoreEmpty := func(chainApp *app.App) bool {
+		it := chainApp.CommitMultiStore().GetKVStore(chainApp.GetKey(minttypes.StoreKey)).Iterator(nil, nil)
+		defer it.Close()
+		return !it.Valid()
+	}
+	require.False(t, mintStoreEmpty(chainApp))
+
+	upgrade := upgrades.Upgrade{
+		Name:          plan.Name,
+		StoreUpgrades: storetypes.StoreUpgrades{Deleted: []string{minttypes.StoreKey}},
+	}
+
+	// the store upgrades of the other upgrades are not applied
+	app.Upgrades = []upgrades.Upgrade{{Name: "other", StoreUpgrades: upgrade.StoreUpgrades}}
+	chainApp = newApp(nil)
+	require.True(t, chainApp.UpgradeKeeper.HasHandler("other"))
+	require.False(t, chainApp.UpgradeKeeper.HasHandler(plan.Name))
+	require.False(t, mintStoreEmpty(chainApp))
+
+	// nor those of a skipped upgrade
+	app.Upgrades = []upgrades.Upgrade{upgrade}
+	chainApp = newApp(map[int64]bool{plan.Height: true})
+	require.True(t, chainApp.UpgradeKeeper.HasHandler(plan.Name))
+	require.False(t, mintStoreEmpty(chainApp))
+
+	chainApp = newApp(nil)
+	require.True(t, chainApp.UpgradeKeeper.HasHandler(plan.Name))
+	require.True(t, mintStoreEmpty(chainApp))
+
+	// an invalid registry is refused
+	app.Upgrades = []upgrades.Upgrade{upgrade, upgrade}
+	require.Panics(t, func() { newApp(nil) })
+}
+
+func TestSoftwareUpgrade(t *testing.T) {
+	chain := app.NewUpgradeChain(t, "test")
+
+	// the old binary ran the previous version of the auth module, without the
+	// index of the accounts by number
+	chain.Update(func(ctx sdk.Context) {
+		versions := chain.App.UpgradeKeeper.GetModuleVersionMap(ctx)
+		versions[authtypes.ModuleName] = 2
+		chain.App.UpgradeKeeper.SetModuleVersionMap(ctx, versions)
+
+		store := prefix.NewStore(ctx.KVStore(chain.App.GetKey(authtypes.StoreKey)), authtypes.AccountNumberStoreKeyPrefix)
+		it := store.Iterator(nil, nil)
+		var keys [][]byte
+		for ; it.Valid(); it.Next() {
+			keys = append(keys, it.Key())
+		}
+		require.NoError(t, it.Close())
+		require.NotEmpty(t, keys)
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	})
+
+	chain.ProposeUpgrade(15)
+	chain.RequireHalt()
+
+	var migratedVersions module.VersionMap
+	chain.Upgrade(upgrades.Upgrade{
+		Name: "test",
+		CreateUpgradeHandler: func(mm *module.Manager, configurator module.Configurator, keepers upgrades.AppKeepers) upgradetypes.UpgradeHandler {
+			return func(ctx sdk.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+				keepers.GetSubspace(stakingtypes.ModuleName).Set(ctx, stakingtypes.KeyMaxValidators, uint32(42))
+				migratedVersions = fromVM
+				return mm.RunMigrations(ctx, configurator, fromVM)
+			}
+		},
+	})
+
+	ctx := chain.Context()
+	require.Equal(t, uint64(2), migratedVersions[authtypes.ModuleName])
+	store := ctx.KVStore(chain.App.GetKey(authtypes.StoreKey))
+	chain.App.AccountKeeper.IterateAccounts(ctx, func(acc authtypes.AccountI) bool {
+		require.Equal(t, acc.GetAddress().Bytes(), store.Get(authtypes.AccountNumberStoreKey(acc.GetAccountNumber())))
+		return false
+	})
+	require.Equal(t, uint32(42), chain.App.StakingKeeper.MaxValidators(ctx))
+	chain.RequireInvariants()
+
+	// the upgraded chain goes on
+	chain.NextBlock()
+	require.Equal(t, int64(16), chain.App.LastBlockHeight())
+}
+
+// initChain initializes the chain of an app with a single validator and
+// commits its genesis.
+func initChain(t *testing.T, chainApp *app.App) {
+	t.Helper()
+
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{tmtypes.NewValidator(pubKey, 1)})
+
+	senderPrivKey := secp256k1.GenPrivKey()
+	acc := authtypes.NewBaseAccount(senderPrivKey.PubKey().Address().Bytes(), senderPrivKey.PubKey(), 0, 0)
+	balance := banktypes.Balance{
+		Address: acc.GetAddress().String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100000000000000))),
+	}
+
+	genesisState := app.NewGenesisStateWithValSet(t, valSet, []authtypes.GenesisAccount{acc}, balance)
+	stateBytes, err := json.MarshalIndent(genesisState, "", " ")
+	require.NoError(t, err)
+
+	chainApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: app.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	chainApp.Commit()
+}
@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/log"
+	"gopkg.in/yaml.v2"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/cosmos-builders/chaos/app/scenario"
+)
+
+const (
+	flagScenarioDir    = "dir"
+	flagScenarioOutput = "output"
+)
+
+// chaosCommand returns the chaos engineering commands.
+func chaosCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "chaos",
+		Short: "Chaos engineering subcommands",
+	}
+
+	cmd.AddCommand(chaosRunCmd())
+
+	return cmd
+}
+
+// chaosRunCmd returns the command running a scenario on an in-process
+// validator network.
+func chaosRunCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "run [scenario-file]",
+		Short: "Run a chaos scenario on an in-process validator network",
+		Long: `Start an in-process network of validators running the application, send
+the transaction load of the scenario and apply its timeline of actions:
+stopping and restarting validators, partitioning and healing the network,
+submitting parameter change and upgrade proposals and sending IBC transfers.
+
+At the end of the run, the block times, the blocks missed by each validator,
+the jailed validators, the invariants and whether the chain halted are
+reported in YAML and checked against the expectations of the scenario. The
+command exits with code 2 if an expectation fails, so that it can gate CI.
+`,
+		Example: fmt.Sprintf("%s chaos run scenarios/partition.yaml --output report.yaml", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			s, err := scenario.LoadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			dir, err := cmd.Flags().GetString(flagScenarioDir)
+			if err != nil {
+				return err
+			}
+			if dir == "" {
+				if dir, err = os.MkdirTemp("", "chaos-scenario-"); err != nil {
+					return err
+				}
+				defer os.RemoveAll(dir)
+			} else if err := os.MkdirAll(dir, 0o755); err != nil {
+				return err
+			}
+
+			// the nodes log to a file, so that the progress of the runner
+			// stays readable
+			nodeLog, err := os.Create(filepath.Join(dir, "network.log"))
+			if err != nil {
+				return err
+			}
+			defer nodeLog.Close()
+			nodeLogger := log.NewFilter(log.NewTMLogger(log.NewSyncWriter(nodeLog)), log.AllowInfo())
+			logger := log.NewFilter(log.NewTMLogger(log.NewSyncWriter(cmd.ErrOrStderr())), log.AllowInfo()).With("module", "chaos")
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			report, err := scenario.Run(ctx, s, dir, logger, nodeLogger)
+			if err != nil {
+				return err
+			}
+
+			bz, err := yaml.Marshal(report)
+			if err != nil {
+				return err
+			}
+			output, err := cmd.Flags().GetString(flagScenarioOutput)
+			if err != nil {
+				return err
+			}
+			if output == "" {
+				cmd.Print(string(bz))
+			} else if err := os.WriteFile(output, bz, 0o644); err != nil {
+				return err
+			}
+
+			if !report.Passed {
+				logger.Error("scenario failed", "failures", report.Failures)
+				cmd.SilenceUsage = true
+				cmd.SilenceErrors = true
+				return server.ErrorCode{Code: 2}
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagScenarioDir, "", "Directory of the validators and of the network log (default: a temporary directory removed at the end of the run)")
+	cmd.Flags().String(flagScenarioOutput, "", "File the report is written to (default: stdout)")
+
+	return cmd
+}
@@ -0,0 +1,115 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/debug"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/cosmos-builders/chaos/app/equivocation"
+)
+
+const (
+	flagEquivocationHeight = "height"
+	flagEquivocationRound  = "round"
+	flagBroadcast          = "broadcast"
+)
+
+// debugCommand returns the debug commands of the SDK extended with the
+// commands of the chain.
+func debugCommand() *cobra.Command {
+	cmd := debug.Cmd()
+	cmd.AddCommand(MakeEquivocationCmd())
+	return cmd
+}
+
+// MakeEquivocationCmd returns the command forging the evidence of a
+// validator double signing.
+func MakeEquivocationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "make-equivocation [priv_validator_key_file]",
+		Short: "Forge the evidence of a validator double signing at a height",
+		Long: `Sign two conflicting prevotes at a height and round with the key of a
+priv_validator_key.json file and print the DuplicateVoteEvidence of the
+equivocation. The time of the block of the height and its validator set are
+queried from the node, as the evidence is only accepted if it matches them.
+
+With --broadcast, the evidence is submitted to the node, which commits it in a
+block: the validator is then slashed, jailed and tombstoned.
+
+The sign state of the validator is not read nor updated: do not use the key of
+a validator of a live network.
+`,
+		Example: fmt.Sprintf("%s debug make-equivocation ~/.chaos/config/priv_validator_key.json --height 10 --broadcast", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			node, err := clientCtx.GetNode()
+			if err != nil {
+				return err
+			}
+
+			height, err := cmd.Flags().GetInt64(flagEquivocationHeight)
+			if err != nil {
+				return err
+			}
+			if height <= 0 {
+				return fmt.Errorf("--%s must be positive", flagEquivocationHeight)
+			}
+			round, err := cmd.Flags().GetInt32(flagEquivocationRound)
+			if err != nil {
+				return err
+			}
+			broadcast, err := cmd.Flags().GetBool(flagBroadcast)
+			if err != nil {
+				return err
+			}
+
+			privKey, err := equivocation.LoadPrivValidatorKey(args[0])
+			if err != nil {
+				return err
+			}
+			block, err := equivocation.QueryBlock(cmd.Context(), node, height)
+			if err != nil {
+				return err
+			}
+			evidence, err := equivocation.NewEvidence(privKey, block, round)
+			if err != nil {
+				return err
+			}
+
+			bz, err := tmjson.MarshalIndent(evidence, "", "  ")
+			if err != nil {
+				return err
+			}
+			if err := clientCtx.PrintString(string(bz) + "\n"); err != nil {
+				return err
+			}
+
+			if !broadcast {
+				return nil
+			}
+			res, err := node.BroadcastEvidence(cmd.Context(), evidence)
+			if err != nil {
+				return err
+			}
+			cmd.PrintErrf("evidence %X submitted\n", res.Hash)
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagEquivocationHeight, 0, "Height of the equivocation, whose block must be known to the node")
+	cmd.Flags().Int32(flagEquivocationRound, 0, "Round of the equivocation")
+	cmd.Flags().Bool(flagBroadcast, false, "Submit the evidence to the node")
+	cmd.Flags().String(flags.FlagNode, "tcp://localhost:26657", "<host>:<port> to Tendermint RPC interface for this chain")
+
+	return cmd
+}
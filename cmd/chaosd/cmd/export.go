@@ -0,0 +1,327 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+	"gopkg.in/yaml.v2"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/cosmos-builders/chaos/app"
+)
+
+const (
+	flagStreamOutput  = "stream-output"
+	flagSplitByModule = "split-by-module"
+	flagModules       = "modules"
+	flagAccounts      = "accounts"
+	flagAccountPrefix = "account-prefix"
+	flagRedact        = "redact"
+	flagDryRun        = "dry-run"
+
+	// memorySampleInterval is the interval between two samples of the memory
+	// used by a streaming export.
+	memorySampleInterval = 100 * time.Millisecond
+)
+
+// extendExportCmd extends the export command of the SDK with the streaming
+// export of the genesis to a file or to a directory split by module, and with
+// the export of the parts of the state selected by a filter.
+func extendExportCmd(cmd *cobra.Command, a appCreator) {
+	runE := cmd.RunE
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		output, err := cmd.Flags().GetString(flagStreamOutput)
+		if err != nil {
+			return err
+		}
+		filter, err := exportFilterFromFlags(cmd)
+		if err != nil {
+			return err
+		}
+		if dryRun, _ := cmd.Flags().GetBool(flagDryRun); dryRun {
+			if forZeroHeight, _ := cmd.Flags().GetBool(server.FlagForZeroHeight); !forZeroHeight {
+				return fmt.Errorf("--%s requires --%s", flagDryRun, server.FlagForZeroHeight)
+			}
+			if output != "" || !filter.IsEmpty() {
+				return fmt.Errorf("--%s cannot be used with --%s or a filtered export", flagDryRun, flagStreamOutput)
+			}
+			return zeroHeightDryRun(cmd, a)
+		}
+		if !filter.IsEmpty() {
+			if output != "" {
+				return fmt.Errorf("--%s cannot be used with a filtered export", flagStreamOutput)
+			}
+			return filteredExport(cmd, a, filter)
+		}
+		if output == "" {
+			if splitByModule, _ := cmd.Flags().GetBool(flagSplitByModule); splitByModule {
+				return fmt.Errorf("--%s requires --%s", flagSplitByModule, flagStreamOutput)
+			}
+			return runE(cmd, args)
+		}
+		return streamExport(cmd, a, output)
+	}
+
+	cmd.Long = `Export the state of the application to a genesis printed to stdout.
+
+With --stream-output, the genesis of each module is exported and written to a
+file one at a time, instead of the whole app state being held in memory, and
+the progress of the export and the peak memory usage are logged. With
+--split-by-module, the output is a directory holding the genesis without its
+app state and a file per module, joined back into a genesis file by
+"genesis join". The written genesis is identical to the one printed by the
+export without --stream-output.
+
+With --modules, --accounts, --account-prefix or --redact, only the selected
+parts of the app state are printed, for analysis or audits: the modules, the
+accounts of the auth, bank, staking and distribution modules, and the state
+with the redacted fields replaced by "REDACTED". The totals, e.g. the supply,
+are not filtered. The output is not a genesis and states which parts of the
+app state are partial.
+
+With --for-zero-height --dry-run, nothing is exported: the changes that the
+preparation of the zero height genesis would make to the state are printed in
+YAML instead, i.e. the commissions and rewards withdrawn, the outstanding
+rewards sent to the community pool, the validators jailed as missing from
+--jail-allowed-addrs and the redelegations and unbonding delegations whose
+creation height is reset.
+`
+	cmd.Example = fmt.Sprintf(`%[1]s export --stream-output genesis.json
+%[1]s export --stream-output genesis --split-by-module
+%[1]s export --modules bank,staking --account-prefix cosmos1qq --redact auth.accounts.pub_key
+%[1]s export --for-zero-height --jail-allowed-addrs cosmosvaloper1... --dry-run`, version.AppName)
+	cmd.Flags().String(flagStreamOutput, "", "Stream the genesis to a file instead of printing it")
+	cmd.Flags().Bool(flagSplitByModule, false, "Write the streamed genesis to a directory split by module (requires --"+flagStreamOutput+")")
+	cmd.Flags().StringSlice(flagModules, nil, "Comma-separated list of the exported modules (partial export)")
+	cmd.Flags().StringSlice(flagAccounts, nil, "Comma-separated list of the addresses of the exported accounts (partial export)")
+	cmd.Flags().String(flagAccountPrefix, "", "Prefix of the addresses of the exported accounts (partial export)")
+	cmd.Flags().StringSlice(flagRedact, nil, "Comma-separated list of the redacted fields, as <module>.<field>... (partial export)")
+	cmd.Flags().Bool(flagDryRun, false, "Print the changes made to the state by --"+server.FlagForZeroHeight+" instead of exporting it")
+}
+
+func exportFilterFromFlags(cmd *cobra.Command) (app.ExportFilter, error) {
+	var filter app.ExportFilter
+	var err error
+	if filter.Modules, err = cmd.Flags().GetStringSlice(flagModules); err != nil {
+		return app.ExportFilter{}, err
+	}
+	if filter.Accounts, err = cmd.Flags().GetStringSlice(flagAccounts); err != nil {
+		return app.ExportFilter{}, err
+	}
+	if filter.AccountPrefix, err = cmd.Flags().GetString(flagAccountPrefix); err != nil {
+		return app.ExportFilter{}, err
+	}
+	if filter.Redact, err = cmd.Flags().GetStringSlice(flagRedact); err != nil {
+		return app.ExportFilter{}, err
+	}
+	return filter, nil
+}
+
+// openExportedApp opens the database of the app of the home of the export
+// command and creates the app whose state is exported.
+func openExportedApp(cmd *cobra.Command, a appCreator) (*app.App, dbm.DB, error) {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	config := serverCtx.Config
+
+	homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+	config.SetRoot(homeDir)
+
+	if _, err := os.Stat(config.GenesisFile()); os.IsNotExist(err) {
+		return nil, nil, err
+	}
+
+	height, _ := cmd.Flags().GetInt64(server.FlagHeight)
+
+	db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), filepath.Join(config.RootDir, "data"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	exportedApp, err := a.exportedApp(serverCtx.Logger, db, nil, height, serverCtx.Viper)
+	if err != nil {
+		db.Close()
+		return nil, nil, fmt.Errorf("error exporting state: %v", err)
+	}
+	return exportedApp, db, nil
+}
+
+// filteredExport prints the parts of the exported state selected by a
+// filter.
+func filteredExport(cmd *cobra.Command, a appCreator, filter app.ExportFilter) error {
+	exportedApp, db, err := openExportedApp(cmd, a)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	forZeroHeight, _ := cmd.Flags().GetBool(server.FlagForZeroHeight)
+	jailAllowedAddrs, _ := cmd.Flags().GetStringSlice(server.FlagJailAllowedAddrs)
+
+	exported, err := exportedApp.ExportFilteredAppState(filter, forZeroHeight, jailAllowedAddrs)
+	if err != nil {
+		return fmt.Errorf("error exporting state: %v", err)
+	}
+	bz, err := json.Marshal(exported)
+	if err != nil {
+		return err
+	}
+	if bz, err = sdk.SortJSON(bz); err != nil {
+		return err
+	}
+
+	cmd.Println(string(bz))
+	return nil
+}
+
+// zeroHeightDryRun prints the changes that the preparation of a zero height
+// genesis would make to the exported state.
+func zeroHeightDryRun(cmd *cobra.Command, a appCreator) error {
+	exportedApp, db, err := openExportedApp(cmd, a)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	jailAllowedAddrs, _ := cmd.Flags().GetStringSlice(server.FlagJailAllowedAddrs)
+
+	report, err := exportedApp.ZeroHeightGenesisDryRun(jailAllowedAddrs)
+	if err != nil {
+		return fmt.Errorf("error exporting state: %v", err)
+	}
+	bz, err := yaml.Marshal(report)
+	if err != nil {
+		return err
+	}
+
+	cmd.Print(string(bz))
+	return nil
+}
+
+// streamExport streams the genesis of the exported state to an output, as the
+// export command of the SDK does.
+func streamExport(cmd *cobra.Command, a appCreator, output string) error {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	logger := serverCtx.Logger
+
+	exportedApp, db, err := openExportedApp(cmd, a)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	forZeroHeight, _ := cmd.Flags().GetBool(server.FlagForZeroHeight)
+	jailAllowedAddrs, _ := cmd.Flags().GetStringSlice(server.FlagJailAllowedAddrs)
+	splitByModule, _ := cmd.Flags().GetBool(flagSplitByModule)
+
+	doc, err := tmtypes.GenesisDocFromFile(serverCtx.Config.GenesisFile())
+	if err != nil {
+		return err
+	}
+
+	sampler := startMemorySampler(memorySampleInterval)
+	start := time.Now()
+	progress := func(p app.ExportProgress) {
+		sampler.sample(p.HeapAlloc)
+		logger.Info(
+			"exported module genesis",
+			"module", p.Module,
+			"progress", fmt.Sprintf("%d/%d", p.Index, p.Total),
+			"size", p.Size,
+			"duration", p.Duration,
+			"heap_alloc", p.HeapAlloc,
+		)
+	}
+
+	if splitByModule {
+		err = exportedApp.StreamExportGenesisDir(output, doc, forZeroHeight, jailAllowedAddrs, progress)
+	} else {
+		err = streamExportFile(exportedApp, output, doc, forZeroHeight, jailAllowedAddrs, progress)
+	}
+	peak := sampler.stop()
+	if err != nil {
+		return fmt.Errorf("error exporting state: %v", err)
+	}
+
+	logger.Info("exported genesis", "output", output, "duration", time.Since(start), "peak_heap_alloc", peak)
+	return nil
+}
+
+// streamExportFile streams the genesis of the exported state to a file,
+// ended by a newline as the genesis printed by the export command.
+func streamExportFile(
+	exportedApp *app.App, file string, doc *tmtypes.GenesisDoc,
+	forZeroHeight bool, jailAllowedAddrs []string, progress func(app.ExportProgress),
+) error {
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	if err := exportedApp.StreamExportGenesis(f, doc, forZeroHeight, jailAllowedAddrs, progress); err != nil {
+		f.Close()
+		return err
+	}
+	if _, err := f.WriteString("\n"); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// memorySampler samples the allocated heap until it is stopped and records
+// its peak.
+type memorySampler struct {
+	mu   sync.Mutex
+	peak uint64
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+func startMemorySampler(interval time.Duration) *memorySampler {
+	s := &memorySampler{done: make(chan struct{})}
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-s.done:
+				return
+			case <-ticker.C:
+				var memStats runtime.MemStats
+				runtime.ReadMemStats(&memStats)
+				s.sample(memStats.HeapAlloc)
+			}
+		}
+	}()
+	return s
+}
+
+func (s *memorySampler) sample(heapAlloc uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if heapAlloc > s.peak {
+		s.peak = heapAlloc
+	}
+}
+
+// stop stops the sampling and returns the peak of the allocated heap.
+func (s *memorySampler) stop() uint64 {
+	close(s.done)
+	s.wg.Wait()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.peak
+}
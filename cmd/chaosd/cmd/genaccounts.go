@@ -2,17 +2,24 @@ package cmd
 
 import (
 	"bufio"
+	"encoding/csv"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/server"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	authvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -24,6 +31,7 @@ const (
 	flagVestingStart = "vesting-start-time"
 	flagVestingEnd   = "vesting-end-time"
 	flagVestingAmt   = "vesting-amount"
+	flagMerge        = "merge"
 )
 
 // AddGenesisAccountCmd returns add-genesis-account cobra Command.
@@ -94,36 +102,9 @@ contain valid denominations. Accounts may optionally be supplied with vesting pa
 				return fmt.Errorf("failed to parse vesting amount: %w", err)
 			}
 
-			// create concrete account type based on input parameters
-			var genAccount authtypes.GenesisAccount
-
-			balances := banktypes.Balance{Address: addr.String(), Coins: coins.Sort()}
-			baseAccount := authtypes.NewBaseAccount(addr, nil, 0, 0)
-
-			if !vestingAmt.IsZero() {
-				baseVestingAccount := authvesting.NewBaseVestingAccount(baseAccount, vestingAmt.Sort(), vestingEnd)
-
-				if (balances.Coins.IsZero() && !baseVestingAccount.OriginalVesting.IsZero()) ||
-					baseVestingAccount.OriginalVesting.IsAnyGT(balances.Coins) {
-					return errors.New("vesting amount cannot be greater than total amount")
-				}
-
-				switch {
-				case vestingStart != 0 && vestingEnd != 0:
-					genAccount = authvesting.NewContinuousVestingAccountRaw(baseVestingAccount, vestingStart)
-
-				case vestingEnd != 0:
-					genAccount = authvesting.NewDelayedVestingAccountRaw(baseVestingAccount)
-
-				default:
-					return errors.New("invalid vesting parameters; must supply start and end time or end time")
-				}
-			} else {
-				genAccount = baseAccount
-			}
-
-			if err := genAccount.Validate(); err != nil {
-				return fmt.Errorf("failed to validate new genesis account: %w", err)
+			genAccount, balances, err := newGenesisAccount(addr, coins, vestingAmt, vestingStart, vestingEnd)
+			if err != nil {
+				return err
 			}
 
 			genFile := config.GenesisFile()
@@ -132,45 +113,143 @@ contain valid denominations. Accounts may optionally be supplied with vesting pa
 				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
 			}
 
-			authGenState := authtypes.GetGenesisStateFromAppState(cdc, appState)
+			if err := addGenesisAccounts(cdc, appState, []authtypes.GenesisAccount{genAccount}, []banktypes.Balance{balances}, false); err != nil {
+				return err
+			}
 
-			accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+			appStateJSON, err := json.Marshal(appState)
 			if err != nil {
-				return fmt.Errorf("failed to get accounts from any: %w", err)
+				return fmt.Errorf("failed to marshal application genesis state: %w", err)
 			}
 
-			if accs.Contains(addr) {
-				return fmt.Errorf("cannot add account at existing address %s", addr)
-			}
+			genDoc.AppState = appStateJSON
+			return genutil.ExportGenesisFile(genDoc, genFile)
+		},
+	}
 
-			// Add the new account to the set of genesis accounts and sanitize the
-			// accounts afterwards.
-			accs = append(accs, genAccount)
-			accs = authtypes.SanitizeGenesisAccounts(accs)
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flagVestingAmt, "", "amount of coins for vesting accounts")
+	cmd.Flags().Int64(flagVestingStart, 0, "schedule start time (unix epoch) for vesting accounts")
+	cmd.Flags().Int64(flagVestingEnd, 0, "schedule end time (unix epoch) for vesting accounts")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// bulkGenesisAccount is an account of the file of bulk-add-genesis-account,
+// whose fields are the arguments and the vesting flags of
+// add-genesis-account.
+type bulkGenesisAccount struct {
+	// Address is the address or the key name of the account.
+	Address      string `json:"address"`
+	Coins        string `json:"coins"`
+	VestingAmt   string `json:"vesting_amount"`
+	VestingStart int64  `json:"vesting_start_time"`
+	VestingEnd   int64  `json:"vesting_end_time"`
+}
+
+// BulkAddGenesisAccountCmd returns bulk-add-genesis-account cobra Command.
+func BulkAddGenesisAccountCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bulk-add-genesis-account [file]",
+		Short: "Add the genesis accounts of a CSV or JSON file to genesis.json",
+		Long: `Add the genesis accounts of a CSV or JSON file to genesis.json, as
+add-genesis-account does for each of them. The genesis is read and written once,
+and the bank genesis is validated once all the accounts are added, so that
+thousands of accounts are added at once. The supply of the genesis, if set, is
+increased by their coins.
 
-			genAccs, err := authtypes.PackAccounts(accs)
+An account has an address or key name, coins and, optionally, a vesting amount
+with a vesting start time and end time, as the vesting flags. A CSV file (.csv)
+has a header row naming its columns:
+
+address,coins,vesting_amount,vesting_start_time,vesting_end_time
+cosmos1...,"1000stake,500token",,,
+alice,1000stake,500stake,1700000000,1800000000
+
+A JSON file (.json) holds an array of accounts with the same fields:
+
+[{"address": "cosmos1...", "coins": "1000stake,500token"}]
+
+An account that already exists, in the genesis or earlier in the file, is an
+error, unless --merge is set: its coins are then added to its balance. The
+accounts merged must not be vesting.
+`,
+		Example: fmt.Sprintf("%s bulk-add-genesis-account accounts.csv --merge", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			cdc := clientCtx.Codec
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			config.SetRoot(clientCtx.HomeDir)
+
+			merge, err := cmd.Flags().GetBool(flagMerge)
 			if err != nil {
-				return fmt.Errorf("failed to convert accounts into any's: %w", err)
+				return err
 			}
-			authGenState.Accounts = genAccs
-
-			authGenStateBz, err := cdc.MarshalJSON(&authGenState)
+			accounts, err := readBulkGenesisAccounts(args[0])
 			if err != nil {
-				return fmt.Errorf("failed to marshal auth genesis state: %w", err)
+				return err
 			}
 
-			appState[authtypes.ModuleName] = authGenStateBz
+			// the keyring is opened for the first key name
+			var kb keyring.Keyring
+			genAccounts := make([]authtypes.GenesisAccount, len(accounts))
+			balances := make([]banktypes.Balance, len(accounts))
+			for i, account := range accounts {
+				addr, err := sdk.AccAddressFromBech32(account.Address)
+				if err != nil {
+					if kb == nil {
+						keyringBackend, err := cmd.Flags().GetString(flags.FlagKeyringBackend)
+						if err != nil {
+							return err
+						}
+						if kb, err = keyring.New(sdk.KeyringServiceName(), keyringBackend, clientCtx.HomeDir, bufio.NewReader(cmd.InOrStdin()), cdc); err != nil {
+							return err
+						}
+					}
+					info, err := kb.Key(account.Address)
+					if err != nil {
+						return fmt.Errorf("account %d: failed to get address from Keybase: %w", i+1, err)
+					}
+					if addr, err = info.GetAddress(); err != nil {
+						return fmt.Errorf("account %d: failed to get address from Keybase: %w", i+1, err)
+					}
+				}
 
-			bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
-			bankGenState.Balances = append(bankGenState.Balances, balances)
-			bankGenState.Balances = banktypes.SanitizeGenesisBalances(bankGenState.Balances)
+				coins, err := sdk.ParseCoinsNormalized(account.Coins)
+				if err != nil {
+					return fmt.Errorf("account %d: failed to parse coins: %w", i+1, err)
+				}
+				vestingAmt, err := sdk.ParseCoinsNormalized(account.VestingAmt)
+				if err != nil {
+					return fmt.Errorf("account %d: failed to parse vesting amount: %w", i+1, err)
+				}
+				if genAccounts[i], balances[i], err = newGenesisAccount(addr, coins, vestingAmt, account.VestingStart, account.VestingEnd); err != nil {
+					return fmt.Errorf("account %d: %w", i+1, err)
+				}
+			}
 
-			bankGenStateBz, err := cdc.MarshalJSON(bankGenState)
+			genFile := config.GenesisFile()
+			appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
 			if err != nil {
-				return fmt.Errorf("failed to marshal bank genesis state: %w", err)
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
 			}
 
-			appState[banktypes.ModuleName] = bankGenStateBz
+			if err := addGenesisAccounts(cdc, appState, genAccounts, balances, merge); err != nil {
+				return err
+			}
+			var added sdk.Coins
+			for _, balance := range balances {
+				added = added.Add(balance.Coins...)
+			}
+			if err := addGenesisSupply(cdc, appState, added); err != nil {
+				return err
+			}
 
 			appStateJSON, err := json.Marshal(appState)
 			if err != nil {
@@ -184,10 +263,222 @@ contain valid denominations. Accounts may optionally be supplied with vesting pa
 
 	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
 	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
-	cmd.Flags().String(flagVestingAmt, "", "amount of coins for vesting accounts")
-	cmd.Flags().Int64(flagVestingStart, 0, "schedule start time (unix epoch) for vesting accounts")
-	cmd.Flags().Int64(flagVestingEnd, 0, "schedule end time (unix epoch) for vesting accounts")
+	cmd.Flags().Bool(flagMerge, false, "Add the coins of the accounts that already exist to their balances")
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// readBulkGenesisAccounts reads the accounts of a CSV or JSON file, by its
+// extension.
+func readBulkGenesisAccounts(path string) ([]bulkGenesisAccount, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	switch ext := filepath.Ext(path); ext {
+	case ".json":
+		var accounts []bulkGenesisAccount
+		decoder := json.NewDecoder(f)
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&accounts); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+		return accounts, nil
+
+	case ".csv":
+		records, err := csv.NewReader(f).ReadAll()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+		if len(records) == 0 {
+			return nil, fmt.Errorf("%s has no header row", path)
+		}
+		header := records[0]
+		columns := make(map[string]bool, len(header))
+		for _, column := range header {
+			columns[column] = true
+		}
+		for _, column := range []string{"address", "coins"} {
+			if !columns[column] {
+				return nil, fmt.Errorf("%s has no %s column", path, column)
+			}
+		}
+
+		accounts := make([]bulkGenesisAccount, len(records)-1)
+		for i, record := range records[1:] {
+			account := &accounts[i]
+			for j, value := range record {
+				value = strings.TrimSpace(value)
+				switch header[j] {
+				case "address":
+					account.Address = value
+				case "coins":
+					account.Coins = value
+				case "vesting_amount":
+					account.VestingAmt = value
+				case "vesting_start_time", "vesting_end_time":
+					if value == "" {
+						continue
+					}
+					t, err := strconv.ParseInt(value, 10, 64)
+					if err != nil {
+						return nil, fmt.Errorf("%s line %d: invalid %s: %w", path, i+2, header[j], err)
+					}
+					if header[j] == "vesting_start_time" {
+						account.VestingStart = t
+					} else {
+						account.VestingEnd = t
+					}
+				default:
+					return nil, fmt.Errorf("%s: unknown column %s", path, header[j])
+				}
+			}
+		}
+		return accounts, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported accounts file extension %q, expected .csv or .json", ext)
+	}
+}
+
+// newGenesisAccount returns the genesis account of an address and its balance
+// of coins. The account is a continuous vesting account if a vesting amount,
+// start and end are given, a delayed vesting account if a vesting amount and
+// end are given.
+func newGenesisAccount(
+	addr sdk.AccAddress, coins, vestingAmt sdk.Coins, vestingStart, vestingEnd int64,
+) (authtypes.GenesisAccount, banktypes.Balance, error) {
+	// create concrete account type based on input parameters
+	var genAccount authtypes.GenesisAccount
+
+	balances := banktypes.Balance{Address: addr.String(), Coins: coins.Sort()}
+	baseAccount := authtypes.NewBaseAccount(addr, nil, 0, 0)
+
+	if !vestingAmt.IsZero() {
+		baseVestingAccount := authvesting.NewBaseVestingAccount(baseAccount, vestingAmt.Sort(), vestingEnd)
+
+		if (balances.Coins.IsZero() && !baseVestingAccount.OriginalVesting.IsZero()) ||
+			baseVestingAccount.OriginalVesting.IsAnyGT(balances.Coins) {
+			return nil, balances, errors.New("vesting amount cannot be greater than total amount")
+		}
+
+		switch {
+		case vestingStart != 0 && vestingEnd != 0:
+			genAccount = authvesting.NewContinuousVestingAccountRaw(baseVestingAccount, vestingStart)
+
+		case vestingEnd != 0:
+			genAccount = authvesting.NewDelayedVestingAccountRaw(baseVestingAccount)
+
+		default:
+			return nil, balances, errors.New("invalid vesting parameters; must supply start and end time or end time")
+		}
+	} else {
+		genAccount = baseAccount
+	}
+
+	if err := genAccount.Validate(); err != nil {
+		return nil, balances, fmt.Errorf("failed to validate new genesis account: %w", err)
+	}
+	return genAccount, balances, nil
+}
+
+// addGenesisAccounts adds genesis accounts and their balances to the auth and
+// bank genesis of an app state. An account must not exist, unless merge is
+// set: the balance of an account that exists, in the genesis or earlier in
+// the accounts, is then added to its balance, and its account is kept.
+func addGenesisAccounts(
+	cdc codec.Codec, appState map[string]json.RawMessage, genAccounts []authtypes.GenesisAccount, balances []banktypes.Balance, merge bool,
+) error {
+	authGenState := authtypes.GetGenesisStateFromAppState(cdc, appState)
+
+	accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	if err != nil {
+		return fmt.Errorf("failed to get accounts from any: %w", err)
+	}
+
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+	// the balances are indexed by address, so that adding many accounts to a
+	// large genesis does not scan it for each of them
+	balanceIndexes := make(map[string]int, len(bankGenState.Balances)+len(balances))
+	for i, balance := range bankGenState.Balances {
+		balanceIndexes[balance.Address] = i
+	}
+	existing := make(map[string]bool, len(accs)+len(genAccounts))
+	for _, acc := range accs {
+		existing[acc.GetAddress().String()] = true
+	}
+
+	for i, genAccount := range genAccounts {
+		addr := genAccount.GetAddress().String()
+		if existing[addr] {
+			if !merge {
+				return fmt.Errorf("cannot add account at existing address %s", addr)
+			}
+			if _, ok := genAccount.(*authtypes.BaseAccount); !ok {
+				return fmt.Errorf("cannot merge vesting account at existing address %s", addr)
+			}
+		} else {
+			existing[addr] = true
+			accs = append(accs, genAccount)
+		}
+
+		if j, found := balanceIndexes[addr]; found {
+			bankGenState.Balances[j].Coins = bankGenState.Balances[j].Coins.Add(balances[i].Coins...)
+		} else {
+			balanceIndexes[addr] = len(bankGenState.Balances)
+			bankGenState.Balances = append(bankGenState.Balances, balances[i])
+		}
+	}
+
+	// Add the new accounts to the set of genesis accounts and sanitize the
+	// accounts afterwards.
+	accs = authtypes.SanitizeGenesisAccounts(accs)
+
+	genAccs, err := authtypes.PackAccounts(accs)
+	if err != nil {
+		return fmt.Errorf("failed to convert accounts into any's: %w", err)
+	}
+	authGenState.Accounts = genAccs
+
+	authGenStateBz, err := cdc.MarshalJSON(&authGenState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal auth genesis state: %w", err)
+	}
+
+	appState[authtypes.ModuleName] = authGenStateBz
+
+	bankGenState.Balances = banktypes.SanitizeGenesisBalances(bankGenState.Balances)
+
+	bankGenStateBz, err := cdc.MarshalJSON(bankGenState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal bank genesis state: %w", err)
+	}
+
+	appState[banktypes.ModuleName] = bankGenStateBz
+	return nil
+}
+
+// addGenesisSupply increases the supply of the bank genesis of an app state
+// by the coins added to its balances, if the supply is set, and validates the
+// bank genesis, so that the supply matches the balances.
+func addGenesisSupply(cdc codec.Codec, appState map[string]json.RawMessage, coins sdk.Coins) error {
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+	// an empty supply is computed from the balances by InitGenesis
+	if !bankGenState.Supply.Empty() {
+		bankGenState.Supply = bankGenState.Supply.Add(coins...)
+	}
+	if err := bankGenState.Validate(); err != nil {
+		return fmt.Errorf("invalid bank genesis state: %w", err)
+	}
+
+	bankGenStateBz, err := cdc.MarshalJSON(bankGenState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal bank genesis state: %w", err)
+	}
+
+	appState[banktypes.ModuleName] = bankGenStateBz
+	return nil
+}
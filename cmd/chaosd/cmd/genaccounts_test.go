@@ -0,0 +1,377 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	authvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	"github.com/cosmos-builders/chaos/app"
+)
+
+// executeCmd executes a command with the client and server contexts set by
+// the root command for a home directory.
+func executeCmd(cmd *cobra.Command, homeDir string, args ...string) error {
+	encodingConfig := app.MakeEncodingConfig()
+	clientCtx := client.Context{}.
+		WithCodec(encodingConfig.Marshaler).
+		WithInterfaceRegistry(encodingConfig.InterfaceRegistry).
+		WithTxConfig(encodingConfig.TxConfig).
+		WithLegacyAmino(encodingConfig.Amino).
+		WithHomeDir(homeDir)
+
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+	ctx = context.WithValue(ctx, server.ServerContextKey, server.NewDefaultContext())
+	cmd.SetArgs(args)
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+	return cmd.ExecuteContext(ctx)
+}
+
+func newAddress() sdk.AccAddress {
+	return sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+}
+
+func coins(s string) sdk.Coins {
+	coins, err := sdk.ParseCoinsNormalized(s)
+	if err != nil {
+		panic(err)
+	}
+	return coins
+}
+
+// readGenesisFile returns the app state of the genesis file of a home
+// directory.
+func readGenesisFile(t *testing.T, homeDir string) map[string]json.RawMessage {
+	appState, _, err := genutiltypes.GenesisStateFromGenFile(filepath.Join(homeDir, "config", "genesis.json"))
+	require.NoError(t, err)
+	return appState
+}
+
+// genesisBalances returns the balances of the bank genesis of an app state
+// by address.
+func genesisBalances(appState map[string]json.RawMessage) (map[string]sdk.Coins, sdk.Coins) {
+	cdc := app.MakeEncodingConfig().Marshaler
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+	balances := make(map[string]sdk.Coins, len(bankGenState.Balances))
+	for _, balance := range bankGenState.Balances {
+		balances[balance.Address] = balance.Coins
+	}
+	return balances, bankGenState.Supply
+}
+
+// genesisAccounts returns the accounts of the auth genesis of an app state by
+// address.
+func genesisAccounts(t *testing.T, appState map[string]json.RawMessage) map[string]authtypes.GenesisAccount {
+	cdc := app.MakeEncodingConfig().Marshaler
+	authGenState := authtypes.GetGenesisStateFromAppState(cdc, appState)
+	accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	require.NoError(t, err)
+	accounts := make(map[string]authtypes.GenesisAccount, len(accs))
+	for _, acc := range accs {
+		accounts[acc.GetAddress().String()] = acc
+	}
+	return accounts
+}
+
+func TestReadBulkGenesisAccounts(t *testing.T) {
+	addr := newAddress().String()
+
+	testCases := []struct {
+		name    string
+		file    string
+		content string
+		expect  []bulkGenesisAccount
+		expErr  bool
+	}{
+		{
+			"csv",
+			"accounts.csv",
+			"address,coins,vesting_amount,vesting_start_time,vesting_end_time\n" +
+				addr + `,"100stake,5token",50stake,1700000000,1800000000` + "\n" +
+				"alice, 7stake ,,,\n",
+			[]bulkGenesisAccount{
+				{Address: addr, Coins: "100stake,5token", VestingAmt: "50stake", VestingStart: 1700000000, VestingEnd: 1800000000},
+				{Address: "alice", Coins: "7stake"},
+			},
+			false,
+		},
+		{
+			"csv columns in any order",
+			"accounts.csv",
+			"coins,address\n1stake,alice\n",
+			[]bulkGenesisAccount{{Address: "alice", Coins: "1stake"}},
+			false,
+		},
+		{"csv unknown column", "accounts.csv", "address,coins,memo\nalice,1stake,hi\n", nil, true},
+		{"csv without coins column", "accounts.csv", "address\nalice\n", nil, true},
+		{"csv invalid vesting time", "accounts.csv", "address,coins,vesting_end_time\nalice,1stake,tomorrow\n", nil, true},
+		{"csv missing field", "accounts.csv", "address,coins\nalice\n", nil, true},
+		{"csv without header", "accounts.csv", "", nil, true},
+		{
+			"json",
+			"accounts.json",
+			`[{"address": "` + addr + `", "coins": "1stake", "vesting_amount": "1stake", "vesting_end_time": 1800000000}, {"address": "alice", "coins": "2stake"}]`,
+			[]bulkGenesisAccount{
+				{Address: addr, Coins: "1stake", VestingAmt: "1stake", VestingEnd: 1800000000},
+				{Address: "alice", Coins: "2stake"},
+			},
+			false,
+		},
+		{"json unknown field", "accounts.json", `[{"address": "alice", "coin": "1stake"}]`, nil, true},
+		{"unsupported extension", "accounts.txt", "address,coins\nalice,1stake\n", nil, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tc.file)
+			require.NoError(t, os.WriteFile(path, []byte(tc.content), 0o600))
+
+			accounts, err := readBulkGenesisAccounts(path)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expect, accounts)
+		})
+	}
+}
+
+func TestAddGenesisAccounts(t *testing.T) {
+	cdc := app.MakeEncodingConfig().Marshaler
+	existing, added := newAddress(), newAddress()
+	vestingEnd := time.Now().Add(time.Hour).Unix()
+
+	type entry struct {
+		addr       sdk.AccAddress
+		coins      string
+		vestingAmt string
+	}
+	testCases := []struct {
+		name        string
+		entries     []entry
+		merge       bool
+		expBalances map[string]string
+		expVesting  bool
+		expErr      bool
+	}{
+		{
+			"new accounts",
+			[]entry{{added, "5stake", "2stake"}},
+			false,
+			map[string]string{existing.String(): "10stake", added.String(): "5stake"},
+			true,
+			false,
+		},
+		{"existing account", []entry{{existing, "5stake", ""}}, false, nil, false, true},
+		{"duplicate accounts", []entry{{added, "5stake", ""}, {added, "3stake", ""}}, false, nil, false, true},
+		{
+			"merge into existing balance",
+			[]entry{{existing, "5stake,1token", ""}},
+			true,
+			map[string]string{existing.String(): "15stake,1token"},
+			false,
+			false,
+		},
+		{
+			"merge duplicate accounts",
+			[]entry{{added, "5stake", "2stake"}, {added, "3stake", ""}},
+			true,
+			map[string]string{existing.String(): "10stake", added.String(): "8stake"},
+			true,
+			false,
+		},
+		{"merge vesting account", []entry{{existing, "5stake", "2stake"}}, true, nil, false, true},
+		{"merge duplicate vesting account", []entry{{added, "5stake", ""}, {added, "3stake", "2stake"}}, true, nil, false, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			appState := app.ModuleBasics.DefaultGenesis(cdc)
+			genAccount, balance, err := newGenesisAccount(existing, coins("10stake"), nil, 0, 0)
+			require.NoError(t, err)
+			require.NoError(t, addGenesisAccounts(cdc, appState, []authtypes.GenesisAccount{genAccount}, []banktypes.Balance{balance}, false))
+
+			genAccounts := make([]authtypes.GenesisAccount, len(tc.entries))
+			balances := make([]banktypes.Balance, len(tc.entries))
+			for i, e := range tc.entries {
+				var vestingAmt sdk.Coins
+				if e.vestingAmt != "" {
+					vestingAmt = coins(e.vestingAmt)
+				}
+				genAccounts[i], balances[i], err = newGenesisAccount(e.addr, coins(e.coins), vestingAmt, 0, vestingEnd)
+				require.NoError(t, err)
+			}
+
+			err = addGenesisAccounts(cdc, appState, genAccounts, balances, tc.merge)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			genBalances, supply := genesisBalances(appState)
+			require.Len(t, genBalances, len(tc.expBalances))
+			for addr, expected := range tc.expBalances {
+				require.Equal(t, coins(expected), genBalances[addr], addr)
+			}
+			// the supply is left to addGenesisSupply
+			require.Empty(t, supply)
+
+			accounts := genesisAccounts(t, appState)
+			require.Len(t, accounts, len(tc.expBalances))
+			require.IsType(t, &authtypes.BaseAccount{}, accounts[existing.String()])
+			if tc.expVesting {
+				require.IsType(t, &authvesting.DelayedVestingAccount{}, accounts[added.String()])
+			}
+		})
+	}
+}
+
+func TestAddGenesisSupply(t *testing.T) {
+	cdc := app.MakeEncodingConfig().Marshaler
+	addr := newAddress()
+
+	testCases := []struct {
+		name      string
+		supply    string
+		added     string
+		expSupply string
+		expErr    bool
+	}{
+		{"supply computed by InitGenesis", "", "5stake", "", false},
+		{"supply increased", "10stake", "5stake", "15stake", false},
+		{"supply not matching the balances", "10stake", "1stake", "", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			appState := app.ModuleBasics.DefaultGenesis(cdc)
+			bankGenState := banktypes.DefaultGenesisState()
+			bankGenState.Balances = []banktypes.Balance{{Address: addr.String(), Coins: coins("10stake")}}
+			if tc.supply != "" {
+				bankGenState.Supply = coins(tc.supply)
+			}
+			appState[banktypes.ModuleName] = cdc.MustMarshalJSON(bankGenState)
+
+			genAccount, balance, err := newGenesisAccount(addr, coins("5stake"), nil, 0, 0)
+			require.NoError(t, err)
+			require.NoError(t, addGenesisAccounts(cdc, appState, []authtypes.GenesisAccount{genAccount}, []banktypes.Balance{balance}, true))
+
+			err = addGenesisSupply(cdc, appState, coins(tc.added))
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			_, supply := genesisBalances(appState)
+			if tc.expSupply == "" {
+				require.Empty(t, supply)
+			} else {
+				require.Equal(t, coins(tc.expSupply), supply)
+			}
+		})
+	}
+}
+
+func TestBulkAddGenesisAccountCmd(t *testing.T) {
+	cdc := app.MakeEncodingConfig().Marshaler
+	homeDir := t.TempDir()
+	existing, added := newAddress(), newAddress()
+
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, homeDir, nil, cdc)
+	require.NoError(t, err)
+	record, _, err := kb.NewMnemonic("alice", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	alice, err := record.GetAddress()
+	require.NoError(t, err)
+
+	// the genesis holds an account and sets the supply
+	appState := app.ModuleBasics.DefaultGenesis(cdc)
+	bankGenState := banktypes.DefaultGenesisState()
+	bankGenState.Supply = coins("10stake")
+	appState[banktypes.ModuleName] = cdc.MustMarshalJSON(bankGenState)
+	genAccount, balance, err := newGenesisAccount(existing, coins("10stake"), nil, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, addGenesisAccounts(cdc, appState, []authtypes.GenesisAccount{genAccount}, []banktypes.Balance{balance}, false))
+	appStateJSON, err := json.Marshal(appState)
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Join(homeDir, "config"), 0o755))
+	require.NoError(t, genutil.ExportGenesisFile(
+		&tmtypes.GenesisDoc{ChainID: "bulk-1", AppState: appStateJSON},
+		filepath.Join(homeDir, "config", "genesis.json"),
+	))
+
+	writeFile := func(name, content string) string {
+		path := filepath.Join(t.TempDir(), name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		return path
+	}
+	bulkAdd := func(args ...string) error {
+		return executeCmd(BulkAddGenesisAccountCmd(homeDir), homeDir, append(args, "--keyring-backend", keyring.BackendTest)...)
+	}
+
+	// accounts are added by address or key name
+	vestingEnd := time.Now().Add(time.Hour).Unix()
+	require.NoError(t, bulkAdd(writeFile("accounts.csv", "address,coins,vesting_amount,vesting_end_time\n"+
+		"alice,7stake,,\n"+
+		added.String()+`,"5stake,1token",2stake,`+sdk.NewInt(vestingEnd).String()+"\n",
+	)))
+	appState = readGenesisFile(t, homeDir)
+	balances, supply := genesisBalances(appState)
+	require.Equal(t, coins("7stake"), balances[alice.String()])
+	require.Equal(t, coins("5stake,1token"), balances[added.String()])
+	require.Equal(t, coins("22stake,1token"), supply)
+	vestingAccount, vestingBalance, err := newGenesisAccount(added, coins("5stake,1token"), coins("2stake"), 0, vestingEnd)
+	require.NoError(t, err)
+	require.JSONEq(t, string(cdc.MustMarshalJSON(vestingAccount)), string(cdc.MustMarshalJSON(genesisAccounts(t, appState)[added.String()])))
+	require.Equal(t, vestingBalance.Coins, balances[added.String()])
+
+	// existing accounts, in the genesis or earlier in the file, are merged
+	// when asked
+	merged := writeFile("merged.json", `[
+		{"address": "alice", "coins": "3stake"},
+		{"address": "`+existing.String()+`", "coins": "1token"},
+		{"address": "alice", "coins": "1stake"}
+	]`)
+	require.Error(t, bulkAdd(merged))
+	require.Equal(t, appState, readGenesisFile(t, homeDir))
+	require.NoError(t, bulkAdd(merged, "--merge"))
+	appState = readGenesisFile(t, homeDir)
+	balances, supply = genesisBalances(appState)
+	require.Equal(t, coins("11stake"), balances[alice.String()])
+	require.Equal(t, coins("10stake,1token"), balances[existing.String()])
+	require.Equal(t, coins("26stake,2token"), supply)
+	require.Len(t, genesisAccounts(t, appState), 3)
+
+	// vesting is not merged into an existing account
+	require.Error(t, bulkAdd(writeFile("vesting.csv", "address,coins,vesting_amount,vesting_end_time\n"+
+		existing.String()+",2stake,1stake,"+sdk.NewInt(vestingEnd).String()+"\n",
+	), "--merge"))
+	require.Equal(t, appState, readGenesisFile(t, homeDir))
+
+	// unknown keys and invalid coins are rejected before writing the genesis
+	require.Error(t, bulkAdd(writeFile("unknown.csv", "address,coins\nbob,1stake\n")))
+	require.Error(t, bulkAdd(writeFile("invalid.csv", "address,coins\nalice,-1stake\n")))
+	require.Equal(t, appState, readGenesisFile(t, homeDir))
+}
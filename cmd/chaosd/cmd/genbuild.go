@@ -0,0 +1,540 @@
+package cmd
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/go-bip39"
+	"github.com/spf13/cobra"
+	tmcfg "github.com/tendermint/tendermint/config"
+	tmed25519 "github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/p2p"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"gopkg.in/yaml.v2"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/cosmos-builders/chaos/app"
+)
+
+const (
+	flagConfig      = "config"
+	flagOutputDir   = "output-dir"
+	flagSeed        = "seed"
+	flagGenesisTime = "genesis-time"
+
+	// buildPortOffset is the offset between the ports of two consecutive
+	// nodes of a build, so that they run on the same host.
+	buildPortOffset = 10
+)
+
+// buildConfig is the config.yml of the chain, read by the Ignite CLI, listing
+// the accounts and the validators of its genesis.
+type buildConfig struct {
+	Accounts   []buildAccount   `yaml:"accounts"`
+	Validators []buildValidator `yaml:"validators"`
+	Faucet     buildFaucet      `yaml:"faucet"`
+}
+
+// buildAccount is a genesis account of the config. Its key is the key of its
+// name in the keyring, created if it does not exist, unless it is given by
+// its address.
+type buildAccount struct {
+	Name     string        `yaml:"name"`
+	Address  string        `yaml:"address"`
+	Mnemonic string        `yaml:"mnemonic"`
+	Coins    []string      `yaml:"coins"`
+	Vesting  *buildVesting `yaml:"vesting"`
+}
+
+// buildVesting is the vesting of a genesis account, as the vesting flags of
+// add-genesis-account.
+type buildVesting struct {
+	Coins     []string `yaml:"coins"`
+	StartTime int64    `yaml:"start_time"`
+	EndTime   int64    `yaml:"end_time"`
+}
+
+// buildValidator is a validator of the config, created by the genesis
+// transaction of the account of its name.
+type buildValidator struct {
+	Name    string `yaml:"name"`
+	Bonded  string `yaml:"bonded"`
+	Moniker string `yaml:"moniker"`
+}
+
+// buildFaucet is the faucet of the config, which must be a genesis account.
+// The coins of the faucet, sent on each request by the faucet of the Ignite
+// CLI, do not belong to the genesis and are ignored.
+type buildFaucet struct {
+	Name string `yaml:"name"`
+}
+
+// validate checks the names and the references of the accounts and
+// validators of the config, and sets the default monikers.
+func (c *buildConfig) validate() error {
+	accounts := make(map[string]buildAccount, len(c.Accounts))
+	for _, account := range c.Accounts {
+		if account.Name == "" {
+			return errors.New("account without name")
+		}
+		if _, found := accounts[account.Name]; found {
+			return fmt.Errorf("duplicate account %s", account.Name)
+		}
+		if account.Address != "" && account.Mnemonic != "" {
+			return fmt.Errorf("account %s: address and mnemonic are exclusive", account.Name)
+		}
+		accounts[account.Name] = account
+	}
+
+	if len(c.Validators) == 0 {
+		return errors.New("no validator")
+	}
+	monikers := make(map[string]bool, len(c.Validators))
+	for i, val := range c.Validators {
+		account, found := accounts[val.Name]
+		if !found {
+			return fmt.Errorf("validator %s is not an account", val.Name)
+		}
+		if account.Address != "" {
+			return fmt.Errorf("validator %s must be an account with a key, not an address", val.Name)
+		}
+		if val.Moniker == "" {
+			c.Validators[i].Moniker = val.Name
+		}
+		// collect-gentxs tells the nodes apart by moniker
+		if monikers[c.Validators[i].Moniker] {
+			return fmt.Errorf("duplicate validator moniker %s", c.Validators[i].Moniker)
+		}
+		monikers[c.Validators[i].Moniker] = true
+	}
+
+	if c.Faucet.Name != "" {
+		if _, found := accounts[c.Faucet.Name]; !found {
+			return fmt.Errorf("faucet %s is not an account", c.Faucet.Name)
+		}
+	}
+	return nil
+}
+
+// genesisBuildCmd returns the command building the genesis and the node home
+// directories of the validators listed in config.yml.
+func genesisBuildCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "build",
+		Short: "Build a multi-validator genesis and its node home directories from config.yml",
+		Long: `Build the genesis of the accounts and validators of config.yml, the
+configuration of the chain read by the Ignite CLI, and the home directory of
+the node of each validator:
+  - the keys of the accounts are the keys of their names in the keyring, created
+    from the mnemonic of the account, if any, when they do not exist,
+  - the accounts are added as by add-genesis-account, with the vesting coins,
+    start_time and end_time of their vesting,
+  - the genesis transaction of each validator is signed and collected as by
+    gentx and collect-gentxs.
+
+The faucet, if any, must be one of the accounts: its coins, sent on each
+request by the faucet, are ignored.
+
+The output directory holds genesis.json, the genesis transactions in gentx/
+and the home directory of each validator, named after it. The ports of the
+nodes are offset by 10 and the nodes are persistent peers of each other on
+127.0.0.1, so that the network runs on the host.
+
+Everything runs offline. Given a seed, the build is deterministic: the keys
+created in the keyring and the node keys are derived from the seed, and the
+genesis time defaults to the Unix epoch.
+
+Example config.yml:
+
+accounts:
+  - name: alice
+    coins: ["20000000000token", "20000000000stake"]
+  - name: carol
+    coins: ["1000stake"]
+    vesting:
+      coins: ["500stake"]
+      end_time: 1893456000
+validators:
+  - name: alice
+    bonded: "10000000000stake"
+faucet:
+  name: carol
+`,
+		Example: fmt.Sprintf("%s genesis build --config config.yml --output-dir build --seed test", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			cdc := clientCtx.Codec
+
+			configFile, err := cmd.Flags().GetString(flagConfig)
+			if err != nil {
+				return err
+			}
+			outputDir, err := cmd.Flags().GetString(flagOutputDir)
+			if err != nil {
+				return err
+			}
+			seed, err := cmd.Flags().GetString(flagSeed)
+			if err != nil {
+				return err
+			}
+			chainID, err := cmd.Flags().GetString(flags.FlagChainID)
+			if err != nil {
+				return err
+			}
+			genesisTime, err := genesisTimeFromFlags(cmd, seed)
+			if err != nil {
+				return err
+			}
+			keyringBackend, err := cmd.Flags().GetString(flags.FlagKeyringBackend)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(configFile)
+			if err != nil {
+				return err
+			}
+			var config buildConfig
+			if err := yaml.Unmarshal(bz, &config); err != nil {
+				return fmt.Errorf("failed to parse %s: %w", configFile, err)
+			}
+			if err := config.validate(); err != nil {
+				return fmt.Errorf("invalid %s: %w", configFile, err)
+			}
+
+			if entries, err := os.ReadDir(outputDir); err == nil && len(entries) > 0 {
+				return fmt.Errorf("the output directory %s is not empty", outputDir)
+			}
+
+			kb, err := keyring.New(sdk.KeyringServiceName(), keyringBackend, clientCtx.HomeDir, bufio.NewReader(cmd.InOrStdin()), cdc)
+			if err != nil {
+				return err
+			}
+
+			/* Add the genesis accounts. */
+
+			addresses := make(map[string]sdk.AccAddress, len(config.Accounts))
+			genAccounts := make([]authtypes.GenesisAccount, len(config.Accounts))
+			balances := make([]banktypes.Balance, len(config.Accounts))
+			for i, account := range config.Accounts {
+				addr, err := buildAccountAddress(cmd, kb, account, seed)
+				if err != nil {
+					return err
+				}
+				addresses[account.Name] = addr
+
+				coins, err := sdk.ParseCoinsNormalized(strings.Join(account.Coins, ","))
+				if err != nil {
+					return fmt.Errorf("failed to parse coins of %s: %w", account.Name, err)
+				}
+				var (
+					vestingAmt               sdk.Coins
+					vestingStart, vestingEnd int64
+				)
+				if account.Vesting != nil {
+					if vestingAmt, err = sdk.ParseCoinsNormalized(strings.Join(account.Vesting.Coins, ",")); err != nil {
+						return fmt.Errorf("failed to parse vesting amount of %s: %w", account.Name, err)
+					}
+					vestingStart, vestingEnd = account.Vesting.StartTime, account.Vesting.EndTime
+				}
+				if genAccounts[i], balances[i], err = newGenesisAccount(addr, coins, vestingAmt, vestingStart, vestingEnd); err != nil {
+					return fmt.Errorf("account %s: %w", account.Name, err)
+				}
+			}
+
+			genesisState := app.ModuleBasics.DefaultGenesis(cdc)
+			if err := addGenesisAccounts(cdc, genesisState, genAccounts, balances, false); err != nil {
+				return err
+			}
+			appState, err := json.MarshalIndent(genesisState, "", " ")
+			if err != nil {
+				return fmt.Errorf("failed to marshal application genesis state: %w", err)
+			}
+			genDoc := tmtypes.GenesisDoc{
+				GenesisTime: genesisTime,
+				ChainID:     chainID,
+				AppState:    appState,
+			}
+
+			/* Initialize the nodes and sign the genesis transactions. */
+
+			genTxsDir := filepath.Join(outputDir, "gentx")
+			if err := os.MkdirAll(genTxsDir, 0o755); err != nil {
+				return err
+			}
+			nodeConfigs := make([]*tmcfg.Config, len(config.Validators))
+			for i, val := range config.Validators {
+				nodeConfig, nodeID, valPubKey, err := initBuildNode(filepath.Join(outputDir, val.Name), val, i, seed)
+				if err != nil {
+					return fmt.Errorf("failed to initialize the node of %s: %w", val.Name, err)
+				}
+				nodeConfigs[i] = nodeConfig
+
+				p2pURL, err := url.Parse(nodeConfig.P2P.ListenAddress)
+				if err != nil {
+					return err
+				}
+				memo := fmt.Sprintf("%s@127.0.0.1:%s", nodeID, p2pURL.Port())
+				genTx, err := buildGenTx(clientCtx, kb, chainID, val, addresses[val.Name], valPubKey, memo)
+				if err != nil {
+					return fmt.Errorf("failed to build the genesis transaction of %s: %w", val.Name, err)
+				}
+				if err := os.WriteFile(filepath.Join(genTxsDir, fmt.Sprintf("gentx-%s.json", nodeID)), genTx, 0o644); err != nil {
+					return err
+				}
+			}
+
+			/* Collect the genesis transactions into the genesis of each node. */
+
+			for _, nodeConfig := range nodeConfigs {
+				initCfg := genutiltypes.InitConfig{ChainID: chainID, GenTxsDir: genTxsDir}
+				if appState, err = genutil.GenAppStateFromConfig(
+					cdc, clientCtx.TxConfig, nodeConfig, initCfg, genDoc, banktypes.GenesisBalancesIterator{},
+				); err != nil {
+					return fmt.Errorf("failed to collect the genesis transactions of %s: %w", nodeConfig.Moniker, err)
+				}
+			}
+
+			if err := json.Unmarshal(appState, &genesisState); err != nil {
+				return err
+			}
+			if err := app.ModuleBasics.ValidateGenesis(cdc, clientCtx.TxConfig, genesisState); err != nil {
+				return fmt.Errorf("invalid genesis: %w", err)
+			}
+			genDoc.AppState = appState
+			if err := genutil.ExportGenesisFile(&genDoc, filepath.Join(outputDir, "genesis.json")); err != nil {
+				return err
+			}
+
+			cmd.Printf("Built the genesis of %s with %d accounts and %d validators in %s\n",
+				chainID, len(config.Accounts), len(config.Validators), outputDir)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory, holding the keyring")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
+	cmd.Flags().String(flags.FlagChainID, "", "Chain ID of the genesis")
+	cmd.Flags().String(flagConfig, "config.yml", "Configuration of the accounts and validators of the genesis")
+	cmd.Flags().String(flagOutputDir, "build", "Directory of the genesis and of the node home directories, which must be empty")
+	cmd.Flags().String(flagSeed, "", "Seed of the keys created by the build, for a deterministic build")
+	cmd.Flags().String(flagGenesisTime, "", "Genesis time in RFC3339 format (default now, or the Unix epoch with a seed)")
+
+	return cmd
+}
+
+// genesisTimeFromFlags returns the genesis time of the flags. It defaults to
+// the Unix epoch given a seed, so that the genesis is deterministic.
+func genesisTimeFromFlags(cmd *cobra.Command, seed string) (time.Time, error) {
+	genesisTime, err := cmd.Flags().GetString(flagGenesisTime)
+	if err != nil {
+		return time.Time{}, err
+	}
+	switch {
+	case genesisTime != "":
+		t, err := time.Parse(time.RFC3339, genesisTime)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("failed to parse genesis time: %w", err)
+		}
+		return t.UTC(), nil
+	case seed != "":
+		return time.Unix(0, 0).UTC(), nil
+	default:
+		return time.Now().UTC(), nil
+	}
+}
+
+// buildAccountAddress returns the address of an account of the config. The
+// key of the account is created in the keyring if it does not exist, from
+// the mnemonic of the account, from the seed or else from a random mnemonic.
+func buildAccountAddress(cmd *cobra.Command, kb keyring.Keyring, account buildAccount, seed string) (sdk.AccAddress, error) {
+	if account.Address != "" {
+		return sdk.AccAddressFromBech32(account.Address)
+	}
+
+	record, err := kb.Key(account.Name)
+	switch {
+	case err == nil:
+		return record.GetAddress()
+	case !errors.Is(err, sdkerrors.ErrKeyNotFound):
+		return nil, err
+	}
+
+	mnemonic := account.Mnemonic
+	if mnemonic == "" {
+		entropy, err := bip39.NewEntropy(256)
+		if err != nil {
+			return nil, err
+		}
+		if seed != "" {
+			secret := sha256.Sum256([]byte(seed + "/account/" + account.Name))
+			entropy = secret[:]
+		}
+		if mnemonic, err = bip39.NewMnemonic(entropy); err != nil {
+			return nil, err
+		}
+	}
+	record, err = kb.NewAccount(account.Name, mnemonic, "", sdk.GetConfig().GetFullBIP44Path(), hd.Secp256k1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the key of %s: %w", account.Name, err)
+	}
+	addr, err := record.GetAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd.Printf("Created key %s (%s)\n", account.Name, addr)
+	if account.Mnemonic == "" && seed == "" {
+		cmd.Printf("Mnemonic of %s: %s\n", account.Name, mnemonic)
+	}
+	return addr, nil
+}
+
+// initBuildNode writes the configuration and the keys of the node of a
+// validator in its home directory. The ports of the node are offset by its
+// index, and its keys are derived from the seed, if any.
+func initBuildNode(homeDir string, val buildValidator, index int, seed string) (*tmcfg.Config, string, cryptotypes.PubKey, error) {
+	offset := index * buildPortOffset
+
+	config := initTendermintConfig()
+	config.SetRoot(homeDir)
+	config.Moniker = val.Moniker
+	config.P2P.AddrBookStrict = false
+	config.P2P.AllowDuplicateIP = true
+	for _, address := range []*string{
+		&config.P2P.ListenAddress,
+		&config.RPC.ListenAddress,
+		&config.RPC.PprofListenAddress,
+		&config.ProxyApp,
+		&config.Instrumentation.PrometheusListenAddr,
+	} {
+		var err error
+		if *address, err = offsetPort(*address, offset); err != nil {
+			return nil, "", nil, err
+		}
+	}
+
+	appTemplate, customAppConfig := initAppConfig()
+	appConfig := customAppConfig.(CustomAppConfig)
+	for _, address := range []*string{&appConfig.API.Address, &appConfig.GRPC.Address, &appConfig.GRPCWeb.Address} {
+		var err error
+		if *address, err = offsetPort(*address, offset); err != nil {
+			return nil, "", nil, err
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Join(homeDir, "config"), 0o755); err != nil {
+		return nil, "", nil, err
+	}
+	if err := os.MkdirAll(filepath.Join(homeDir, "data"), 0o755); err != nil {
+		return nil, "", nil, err
+	}
+	tmcfg.WriteConfigFile(filepath.Join(homeDir, "config", "config.toml"), config)
+	serverconfig.SetConfigTemplate(appTemplate)
+	serverconfig.WriteConfigFile(filepath.Join(homeDir, "config", "app.toml"), appConfig)
+
+	var mnemonic string
+	if seed != "" {
+		nodeKey := &p2p.NodeKey{PrivKey: tmed25519.GenPrivKeyFromSecret([]byte(seed + "/node/" + val.Name))}
+		if err := nodeKey.SaveAs(config.NodeKeyFile()); err != nil {
+			return nil, "", nil, err
+		}
+		secret := sha256.Sum256([]byte(seed + "/validator/" + val.Name))
+		var err error
+		if mnemonic, err = bip39.NewMnemonic(secret[:]); err != nil {
+			return nil, "", nil, err
+		}
+	}
+	nodeID, valPubKey, err := genutil.InitializeNodeValidatorFilesFromMnemonic(config, mnemonic)
+	if err != nil {
+		return nil, "", nil, err
+	}
+	return config, nodeID, valPubKey, nil
+}
+
+// offsetPort offsets the port of a listen address, with or without scheme.
+// An empty address, of a disabled server, is kept.
+func offsetPort(address string, offset int) (string, error) {
+	if address == "" {
+		return "", nil
+	}
+	var scheme string
+	if i := strings.Index(address, "://"); i >= 0 {
+		scheme, address = address[:i+3], address[i+3:]
+	}
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return "", err
+	}
+	p, err := strconv.Atoi(port)
+	if err != nil {
+		return "", err
+	}
+	return scheme + net.JoinHostPort(host, strconv.Itoa(p+offset)), nil
+}
+
+// buildGenTx returns the JSON genesis transaction of a validator, signed by
+// the key of its account, as gentx.
+func buildGenTx(
+	clientCtx client.Context, kb keyring.Keyring, chainID string, val buildValidator, addr sdk.AccAddress, valPubKey cryptotypes.PubKey, memo string,
+) ([]byte, error) {
+	bonded, err := sdk.ParseCoinNormalized(val.Bonded)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse bonded amount: %w", err)
+	}
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		sdk.ValAddress(addr),
+		valPubKey,
+		bonded,
+		stakingtypes.NewDescription(val.Moniker, "", "", "", ""),
+		stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2)),
+		sdk.OneInt(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	txf := clienttx.Factory{}.
+		WithChainID(chainID).
+		WithKeybase(kb).
+		WithTxConfig(clientCtx.TxConfig).
+		WithGas(flags.DefaultGasLimit).
+		WithMemo(memo)
+	txBuilder, err := txf.BuildUnsignedTx(msg)
+	if err != nil {
+		return nil, err
+	}
+	if err := clienttx.Sign(txf, val.Name, txBuilder, true); err != nil {
+		return nil, err
+	}
+	return clientCtx.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+}
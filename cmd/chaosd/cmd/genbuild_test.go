@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	"github.com/cosmos-builders/chaos/app"
+)
+
+const buildTestConfig = `
+accounts:
+  - name: alice
+    coins: ["20000000000token", "20000000000stake"]
+  - name: bob
+    coins: ["20000000000stake"]
+  - name: carol
+    coins: ["1000stake"]
+    vesting:
+      coins: ["500stake"]
+      start_time: 1700000000
+      end_time: 1893456000
+validators:
+  - name: alice
+    bonded: "10000000000stake"
+  - name: bob
+    bonded: "10000000000stake"
+    moniker: bob-node
+faucet:
+  name: carol
+  coins: ["5token"]
+`
+
+func TestGenesisBuildCmd(t *testing.T) {
+	cdc := app.MakeEncodingConfig().Marshaler
+	configFile := filepath.Join(t.TempDir(), "config.yml")
+	require.NoError(t, os.WriteFile(configFile, []byte(buildTestConfig), 0o600))
+
+	// build twice with the same seed, with distinct keyrings
+	outputDirs := make([]string, 2)
+	for i := range outputDirs {
+		homeDir := t.TempDir()
+		outputDirs[i] = filepath.Join(t.TempDir(), "build")
+		require.NoError(t, executeCmd(genesisBuildCmd(homeDir), homeDir,
+			"--config", configFile,
+			"--output-dir", outputDirs[i],
+			"--seed", "test",
+			"--chain-id", "build-1",
+			"--keyring-backend", keyring.BackendTest,
+		))
+
+		// the output directory must be empty
+		require.Error(t, executeCmd(genesisBuildCmd(homeDir), homeDir,
+			"--config", configFile,
+			"--output-dir", outputDirs[i],
+			"--seed", "test",
+			"--chain-id", "build-1",
+			"--keyring-backend", keyring.BackendTest,
+		))
+	}
+
+	genesisFile := filepath.Join(outputDirs[0], "genesis.json")
+	genesis, err := os.ReadFile(genesisFile)
+	require.NoError(t, err)
+	otherGenesis, err := os.ReadFile(filepath.Join(outputDirs[1], "genesis.json"))
+	require.NoError(t, err)
+	require.Equal(t, string(genesis), string(otherGenesis))
+
+	appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genesisFile)
+	require.NoError(t, err)
+	require.Equal(t, "build-1", genDoc.ChainID)
+	require.Equal(t, int64(0), genDoc.GenesisTime.Unix())
+
+	// the genesis transaction of every validator is collected
+	genutilGenState := genutiltypes.GetGenesisStateFromAppState(cdc, appState)
+	require.Len(t, genutilGenState.GenTxs, 2)
+	for _, val := range []string{"alice", "bob"} {
+		for _, file := range []string{"config.toml", "app.toml", "node_key.json", "priv_validator_key.json"} {
+			_, err := os.Stat(filepath.Join(outputDirs[0], val, "config", file))
+			require.NoError(t, err, val)
+		}
+	}
+	entries, err := os.ReadDir(filepath.Join(outputDirs[0], "gentx"))
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	// the accounts are added as by add-genesis-account
+	accounts := genesisAccounts(t, appState)
+	require.Len(t, accounts, 3)
+	balances, _ := genesisBalances(appState)
+	var carol sdk.AccAddress
+	for addr, account := range accounts {
+		if balances[addr].IsEqual(coins("1000stake")) {
+			carol = account.GetAddress()
+		}
+	}
+	require.NotNil(t, carol)
+	vestingAccount, _, err := newGenesisAccount(carol, coins("1000stake"), coins("500stake"), 1700000000, 1893456000)
+	require.NoError(t, err)
+	require.JSONEq(t, string(cdc.MustMarshalJSON(vestingAccount)), string(cdc.MustMarshalJSON(accounts[carol.String()])))
+
+	var genesisState map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(genDoc.AppState, &genesisState))
+	require.NoError(t, app.ModuleBasics.ValidateGenesis(cdc, app.MakeEncodingConfig().TxConfig, genesisState))
+}
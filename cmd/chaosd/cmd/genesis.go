@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/cosmos-builders/chaos/app"
+)
+
+// genesisCommand returns the commands handling genesis files.
+func genesisCommand(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "genesis",
+		Short: "Genesis file subcommands",
+	}
+
+	cmd.AddCommand(
+		genesisJoinCmd(),
+		genesisBuildCmd(defaultNodeHome),
+	)
+
+	return cmd
+}
+
+// genesisJoinCmd returns the command joining a genesis split by module into
+// a genesis file.
+func genesisJoinCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "join [dir] [genesis-file]",
+		Short: "Join a genesis split by module into a genesis file",
+		Long: fmt.Sprintf(`Join the directory of a genesis split by module, written by
+"export --stream-output --split-by-module", into a genesis file. The module
+genesis are copied one at a time, so that the app state is not held in memory.
+The genesis file is identical to the one written by "export --stream-output".
+
+The directory holds the genesis without its app state in %s and the genesis
+of each module in %s/<module>.json.
+`, app.SplitGenesisFile, app.SplitAppStateDir),
+		Example: fmt.Sprintf("%s genesis join genesis genesis.json", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			f, err := os.Create(args[1])
+			if err != nil {
+				return err
+			}
+			if err := app.JoinGenesisDir(args[0], f); err != nil {
+				f.Close()
+				return err
+			}
+			// as the genesis written by the export command
+			if _, err := f.WriteString("\n"); err != nil {
+				f.Close()
+				return err
+			}
+			return f.Close()
+		},
+	}
+
+	return cmd
+}
@@ -14,7 +14,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/config"
-	"github.com/cosmos/cosmos-sdk/client/debug"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/keys"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
@@ -37,7 +36,9 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos-builders/chaos/app"
+	"github.com/cosmos-builders/chaos/app/dbfault"
 	appparams "github.com/cosmos-builders/chaos/app/params"
+	"github.com/cosmos-builders/chaos/app/storefault"
 )
 
 // NewRootCmd creates a new root command for a Cosmos SDK application
@@ -116,9 +117,12 @@ func initRootCmd(
 		),
 		genutilcli.ValidateGenesisCmd(app.ModuleBasics),
 		AddGenesisAccountCmd(app.DefaultNodeHome),
+		BulkAddGenesisAccountCmd(app.DefaultNodeHome),
 		tmcli.NewCompletionCmd(rootCmd, true),
-		debug.Cmd(),
+		debugCommand(),
 		config.Cmd(),
+		chaosCommand(),
+		genesisCommand(app.DefaultNodeHome),
 	)
 
 	a := appCreator{
@@ -133,6 +137,11 @@ func initRootCmd(
 		a.appExport,
 		addModuleInitFlags,
 	)
+	for _, cmd := range rootCmd.Commands() {
+		if cmd.Name() == "export" {
+			extendExportCmd(cmd, a)
+		}
+	}
 
 	// add keybase, auxiliary RPC, query, and tx child commands
 	rootCmd.AddCommand(
@@ -141,6 +150,7 @@ func initRootCmd(
 		txCommand(),
 		keys.Commands(app.DefaultNodeHome),
 		startWithTunnelingCommand(a, app.DefaultNodeHome),
+		inPlaceTestnetCommand(a, app.DefaultNodeHome),
 	)
 }
 
@@ -221,6 +231,9 @@ func startWithTunnelingCommand(appCreator appCreator, defaultNodeHome string) *c
 
 func addModuleInitFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
+	startCmd.Flags().StringSlice(storefault.FlagUnsafeStoreFaults, nil, "Inject faults into the reads of stores, as <store-key>[/<hex-key-prefix>]:<latency|panic|corrupt>[:<latency>][@<start>[-<end>]] (UNSAFE: makes the node diverge from the network)")
+	startCmd.Flags().String(dbfault.FlagUnsafeDBFault, "", "Inject a fault into the writes of the application database, as <fail|crash|drop>@<batch>[:<writes>], batches being numbered from the start of the node (UNSAFE: a crash loses the unsynced writes)")
+	startCmd.Flags().Bool(dbfault.FlagUnsafeDBLieFsync, false, "Make the synced writes of the application database lost on the crash injected by --"+dbfault.FlagUnsafeDBFault+" (UNSAFE)")
 }
 
 func overwriteFlagDefaults(c *cobra.Command, defaults map[string]string) {
@@ -256,6 +269,28 @@ func (a appCreator) newApp(
 		cache = store.NewCommitKVStoreCacheManager()
 	}
 
+	storeFaults, err := storefault.ParseRules(cast.ToStringSlice(appOpts.Get(storefault.FlagUnsafeStoreFaults)))
+	if err != nil {
+		panic(err)
+	}
+	interBlockCache := baseapp.SetInterBlockCache(cache)
+	if len(storeFaults) > 0 {
+		logger.Error("UNSAFE: injecting store faults, the state of this node will diverge from the network", "rules", len(storeFaults))
+		interBlockCache = storefault.SetStoreFaults(cache, storeFaults)
+	}
+
+	if dbFault := cast.ToString(appOpts.Get(dbfault.FlagUnsafeDBFault)); dbFault != "" {
+		fault, err := dbfault.ParseFault(dbFault)
+		if err != nil {
+			panic(err)
+		}
+		logger.Error("UNSAFE: injecting a fault into the writes of the application database", "fault", dbFault)
+		db = dbfault.NewDB(db, dbfault.Config{
+			Fault:    fault,
+			LieFsync: cast.ToBool(appOpts.Get(dbfault.FlagUnsafeDBLieFsync)),
+		})
+	}
+
 	skipUpgradeHeights := make(map[int64]bool)
 	for _, h := range cast.ToIntSlice(appOpts.Get(server.FlagUnsafeSkipUpgrades)) {
 		skipUpgradeHeights[int64(h)] = true
@@ -296,7 +331,7 @@ func (a appCreator) newApp(
 		baseapp.SetMinRetainBlocks(cast.ToUint64(appOpts.Get(server.FlagMinRetainBlocks))),
 		baseapp.SetHaltHeight(cast.ToUint64(appOpts.Get(server.FlagHaltHeight))),
 		baseapp.SetHaltTime(cast.ToUint64(appOpts.Get(server.FlagHaltTime))),
-		baseapp.SetInterBlockCache(cache),
+		interBlockCache,
 		baseapp.SetTrace(cast.ToBool(appOpts.Get(server.FlagTrace))),
 		baseapp.SetIndexEvents(cast.ToStringSlice(appOpts.Get(server.FlagIndexEvents))),
 		baseapp.SetSnapshot(snapshotStore, snapshotOptions),
@@ -315,9 +350,26 @@ func (a appCreator) appExport(
 	jailAllowedAddrs []string,
 	appOpts servertypes.AppOptions,
 ) (servertypes.ExportedApp, error) {
+	app, err := a.exportedApp(logger, db, traceStore, height, appOpts)
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+
+	return app.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs)
+}
+
+// exportedApp creates the app whose state is exported, loaded at a given
+// height (-1 for the latest height).
+func (a appCreator) exportedApp(
+	logger log.Logger,
+	db dbm.DB,
+	traceStore io.Writer,
+	height int64,
+	appOpts servertypes.AppOptions,
+) (*app.App, error) {
 	homePath, ok := appOpts.Get(flags.FlagHome).(string)
 	if !ok || homePath == "" {
-		return servertypes.ExportedApp{}, errors.New("application home not set")
+		return nil, errors.New("application home not set")
 	}
 
 	app := app.New(
@@ -334,33 +386,39 @@ func (a appCreator) appExport(
 
 	if height != -1 {
 		if err := app.LoadHeight(height); err != nil {
-			return servertypes.ExportedApp{}, err
+			return nil, err
 		}
 	}
 
-	return app.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs)
+	return app, nil
 }
 
-// initAppConfig helps to override default appConfig template and configs.
-// return "", nil if no custom configuration is required for the application.
-func initAppConfig() (string, interface{}) {
-	// The following code snippet is just for reference.
+// WASMConfig defines configuration for the wasm module.
+type WASMConfig struct {
+	// This is the maximum sdk gas (wasm and storage) that we allow for any x/wasm "smart" queries
+	QueryGasLimit uint64 `mapstructure:"query_gas_limit"`
 
-	// WASMConfig defines configuration for the wasm module.
-	type WASMConfig struct {
-		// This is the maximum sdk gas (wasm and storage) that we allow for any x/wasm "smart" queries
-		QueryGasLimit uint64 `mapstructure:"query_gas_limit"`
+	// Address defines the gRPC-web server to listen on
+	LruSize uint64 `mapstructure:"lru_size"`
+}
 
-		// Address defines the gRPC-web server to listen on
-		LruSize uint64 `mapstructure:"lru_size"`
-	}
+// IBCFaultConfig defines the node-local fault rules of the ibcfault module.
+type IBCFaultConfig struct {
+	// Rules are given as <port>/<channel>/<sequence>:<fault>[:<delay-blocks>]
+	Rules []string `mapstructure:"rules"`
+}
 
-	type CustomAppConfig struct {
-		serverconfig.Config
+// CustomAppConfig is the app.toml configuration of the app.
+type CustomAppConfig struct {
+	serverconfig.Config `mapstructure:",squash"`
 
-		WASM WASMConfig `mapstructure:"wasm"`
-	}
+	WASM     WASMConfig     `mapstructure:"wasm"`
+	IBCFault IBCFaultConfig `mapstructure:"ibc-fault"`
+}
 
+// initAppConfig helps to override default appConfig template and configs.
+// return "", nil if no custom configuration is required for the application.
+func initAppConfig() (string, interface{}) {
 	// Optionally allow the chain developer to overwrite the SDK's default
 	// server config.
 	srvCfg := serverconfig.DefaultConfig()
@@ -384,6 +442,9 @@ func initAppConfig() (string, interface{}) {
 			LruSize:       1,
 			QueryGasLimit: 300000,
 		},
+		IBCFault: IBCFaultConfig{
+			Rules: []string{},
+		},
 	}
 
 	customAppTemplate := serverconfig.DefaultConfigTemplate + `
@@ -392,7 +453,19 @@ func initAppConfig() (string, interface{}) {
 query_gas_limit = 300000
 # This is the number of wasm vm instances we keep cached in memory for speed-up
 # Warning: this is currently unstable and may lead to crashes, best to keep for 0 unless testing locally
-lru_size = 0`
+lru_size = 0
+
+[ibc-fault]
+# Faults injected into the IBC packets received by this node, in addition to
+# the rules set through governance. Rules are given as
+# <port>/<channel>/<sequence>:<fault>[:<delay-blocks>], where port, channel
+# and sequence may be the * wildcard and fault is one of error-ack, hold-ack,
+# mutate-ack and reject-recv, e.g. "transfer/channel-0/*:error-ack" or
+# "icahost/*/*:hold-ack:10".
+# Warning: local rules make the state of this node diverge from the nodes
+# configured differently, which halts it on an app hash mismatch. Set the
+# same rules on every node of a test network.
+rules = [{{ range .IBCFault.Rules }}"{{ . }}", {{ end }}]`
 
 	return customAppTemplate, customAppConfig
 }
@@ -0,0 +1,194 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/node"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/cosmos-builders/chaos/app"
+	"github.com/cosmos-builders/chaos/app/equivocation"
+	"github.com/cosmos-builders/chaos/app/inplace"
+)
+
+const (
+	flagValidatorPower = "validator-power"
+	flagAccountsToFund = "accounts-to-fund"
+	flagFundAmount     = "fund-amount"
+	flagVotingPeriod   = "voting-period"
+)
+
+// inPlaceTestnetCommand returns the command forking the state of the node of
+// a home directory into a single validator testnet.
+func inPlaceTestnetCommand(a appCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "in-place-testnet [operator_address_or_key_name]",
+		Short: "Fork the state of a node into a local single validator testnet",
+		Long: `Fork the state of a stopped node into a testnet whose single validator is
+the consensus key of the node, in priv_validator_key.json, operated by an
+account, to rehearse upgrades and incidents on real state.
+
+The Tendermint state is rewritten so that the node restarts as the single
+validator: the validator sets are replaced and the commit of the last block is
+signed by the consensus key. The app state is changed when the node starts:
+the validators are jailed and unbonded, the validator is created with a
+minted self delegation, its slashing signing info and the distribution
+records are reset, the test accounts are funded with minted coins and the
+voting period of the governance proposals is shortened.
+
+The testnet has exactly one validator. A validator set of several local keys
+is not supported: the commit of the last block is signed by the key of this
+node only, and the node must be able to produce blocks alone.
+
+The data of the node is rewritten in place: run the command on a copy of the
+data directory, and do not connect the node to the peers of the chain.
+`,
+		Example: fmt.Sprintf("%s in-place-testnet validator --accounts-to-fund alice,bob --voting-period 1m", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+			config.SetRoot(clientCtx.HomeDir)
+
+			power, err := cmd.Flags().GetInt64(flagValidatorPower)
+			if err != nil {
+				return err
+			}
+			votingPeriod, err := cmd.Flags().GetDuration(flagVotingPeriod)
+			if err != nil {
+				return err
+			}
+			fundAmount, err := cmd.Flags().GetString(flagFundAmount)
+			if err != nil {
+				return err
+			}
+			fundCoins, err := sdk.ParseCoinsNormalized(fundAmount)
+			if err != nil {
+				return fmt.Errorf("failed to parse fund amount: %w", err)
+			}
+
+			operator, err := addressFromKeyOrBech32(cmd, clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+			fundedAccounts, err := cmd.Flags().GetStringSlice(flagAccountsToFund)
+			if err != nil {
+				return err
+			}
+			accountsToFund := make([]string, len(fundedAccounts))
+			for i, account := range fundedAccounts {
+				addr, err := addressFromKeyOrBech32(cmd, clientCtx, account)
+				if err != nil {
+					return err
+				}
+				accountsToFund[i] = addr.String()
+			}
+
+			privKey, err := equivocation.LoadPrivValidatorKey(config.PrivValidatorKeyFile())
+			if err != nil {
+				return err
+			}
+			if _, ok := privKey.(ed25519.PrivKey); !ok {
+				return fmt.Errorf("the consensus key must be an ed25519 key, got %s", privKey.Type())
+			}
+
+			testnet := app.InPlaceTestnet{
+				ValidatorPubKey: privKey.PubKey().Bytes(),
+				ValidatorPower:  power,
+				Operator:        operator.String(),
+				Moniker:         config.Moniker,
+				AccountsToFund:  accountsToFund,
+				FundCoins:       fundCoins,
+				VotingPeriod:    votingPeriod,
+			}
+			if err := checkInPlaceTestnet(cmd, a, testnet); err != nil {
+				return err
+			}
+
+			block, err := inplace.Fork(config, node.DefaultDBProvider, privKey, power)
+			if err != nil {
+				return err
+			}
+			testnet.Height, testnet.Time, testnet.ChainID = block.Height, block.Time, block.ChainID
+			if err := app.WriteInPlaceTestnet(config.RootDir, testnet); err != nil {
+				return err
+			}
+
+			cmd.Printf(
+				"Forked %s at height %d: %s (%s) is the single validator, start the node to apply the changes to the app state\n",
+				block.ChainID, block.Height, config.Moniker, sdk.ValAddress(operator),
+			)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
+	cmd.Flags().Int64(flagValidatorPower, 1000, "Voting power of the validator of the testnet")
+	cmd.Flags().StringSlice(flagAccountsToFund, nil, "Comma-separated list of the addresses or key names of the test accounts to fund")
+	cmd.Flags().String(flagFundAmount, "", "Coins sent to each test account (default 1000000000000 of the bond denom)")
+	cmd.Flags().Duration(flagVotingPeriod, time.Minute, "Voting period of the governance proposals")
+
+	return cmd
+}
+
+// checkInPlaceTestnet applies a testnet to the last committed state of the
+// app of the node, without writing it, so that the Tendermint state is not
+// rewritten if the app state cannot be changed, e.g. if the consensus key is
+// used by a validator.
+func checkInPlaceTestnet(cmd *cobra.Command, a appCreator, testnet app.InPlaceTestnet) error {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), serverCtx.Config.DBDir())
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	chainApp, err := a.exportedApp(serverCtx.Logger, db, nil, -1, serverCtx.Viper)
+	if err != nil {
+		return err
+	}
+	ctx := chainApp.NewContext(true, tmproto.Header{Height: chainApp.LastBlockHeight()})
+	cacheCtx, _ := ctx.CacheContext()
+	testnet.Height = chainApp.LastBlockHeight()
+	return chainApp.ApplyInPlaceTestnet(cacheCtx, testnet)
+}
+
+// addressFromKeyOrBech32 returns the address of an account given by its
+// address or by the name of its key in the keyring.
+func addressFromKeyOrBech32(cmd *cobra.Command, clientCtx client.Context, addressOrKey string) (sdk.AccAddress, error) {
+	if addr, err := sdk.AccAddressFromBech32(addressOrKey); err == nil {
+		return addr, nil
+	}
+
+	keyringBackend, err := cmd.Flags().GetString(flags.FlagKeyringBackend)
+	if err != nil {
+		return nil, err
+	}
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyringBackend, clientCtx.HomeDir, bufio.NewReader(cmd.InOrStdin()), clientCtx.Codec)
+	if err != nil {
+		return nil, err
+	}
+	info, err := kb.Key(addressOrKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get address from Keybase: %w", err)
+	}
+	addr, err := info.GetAddress()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get address from Keybase: %w", err)
+	}
+	return addr, nil
+}
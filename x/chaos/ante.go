@@ -0,0 +1,121 @@
+package chaos
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos-builders/chaos/x/chaos/keeper"
+	"github.com/cosmos-builders/chaos/x/chaos/types"
+)
+
+var (
+	_ sdk.AnteDecorator = FailMsgDecorator{}
+	_ sdk.AnteDecorator = TxFaultDecorator{}
+)
+
+// FailMsgDecorator fails the transactions delivering a message failed by an
+// active fault. Transactions are only failed in DeliverTx, so that they are
+// still accepted in the mempool and fail in a block. The messages nested in
+// other messages, e.g. by authz, are not checked.
+type FailMsgDecorator struct {
+	keeper keeper.Keeper
+}
+
+// NewFailMsgDecorator creates a new FailMsgDecorator.
+func NewFailMsgDecorator(k keeper.Keeper) FailMsgDecorator {
+	return FailMsgDecorator{keeper: k}
+}
+
+// AnteHandle implements the AnteDecorator interface
+func (d FailMsgDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if ctx.IsCheckTx() || simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	// the faults are read without gas so that the gas of the transactions
+	// does not depend on the schedule
+	readCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	for _, msg := range tx.GetMsgs() {
+		if fault, found := d.keeper.FailingFault(readCtx, sdk.MsgTypeURL(msg)); found {
+			return ctx, sdkerrors.Wrapf(types.ErrInjectedFault, "fault %d fails %s", fault.Id, fault.MsgTypeUrl)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// TxFaultDecorator applies the tx fault rules of the chaos parameters to the
+// delivered transactions: the extra gas of the rule of each message is
+// charged and the transaction is rejected if the draw of a message is lower
+// than the reject rate of its rule. The draws are seeded by the block header
+// and the transaction bytes so that every validator agrees on them.
+//
+// A rejected transaction passes the AnteHandler, so that its fees are
+// deducted and the events explaining the rejection are kept, and fails
+// before its first message is executed by the message router wrapped by
+// NewTxFaultMsgServer. Nothing is read, charged or emitted in CheckTx, in
+// simulations, while the tx faults are disabled or while the kill switch is
+// engaged. The genesis transactions are delivered before the chaos genesis is
+// initialized, at the initial height of the chain, and are never faulted.
+type TxFaultDecorator struct {
+	keeper keeper.Keeper
+}
+
+// NewTxFaultDecorator creates a new TxFaultDecorator.
+func NewTxFaultDecorator(k keeper.Keeper) TxFaultDecorator {
+	return TxFaultDecorator{keeper: k}
+}
+
+// AnteHandle implements the AnteDecorator interface
+func (d TxFaultDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if ctx.IsCheckTx() || simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	// the parameters are read without gas so that the gas of the transactions
+	// does not change while the tx faults are disabled
+	readCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	if !d.keeper.HasParams(readCtx) {
+		return next(ctx, tx, simulate)
+	}
+	params := d.keeper.GetParams(readCtx)
+	if !params.TxFaultsEnabled || len(params.TxFaultRules) == 0 || d.keeper.IsKillSwitchEngaged(readCtx) {
+		return next(ctx, tx, simulate)
+	}
+
+	seed := types.TxFaultSeed(ctx.BlockHeader(), ctx.TxBytes())
+	for i, msg := range tx.GetMsgs() {
+		msgTypeURL := sdk.MsgTypeURL(msg)
+		rule, found := params.TxFaultRule(msgTypeURL)
+		if !found {
+			continue
+		}
+
+		if rule.ExtraGas != 0 {
+			ctx.GasMeter().ConsumeGas(rule.ExtraGas, "chaos extra gas")
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeExtraGas,
+				sdk.NewAttribute(types.AttributeKeyMsgTypeURL, msgTypeURL),
+				sdk.NewAttribute(types.AttributeKeyMsgIndex, strconv.Itoa(i)),
+				sdk.NewAttribute(types.AttributeKeyGas, strconv.FormatUint(rule.ExtraGas, 10)),
+			))
+		}
+
+		if draw := types.TxFaultDraw(seed, i); draw.LT(rule.RejectRate) {
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeTxRejected,
+				sdk.NewAttribute(types.AttributeKeyMsgTypeURL, msgTypeURL),
+				sdk.NewAttribute(types.AttributeKeyMsgIndex, strconv.Itoa(i)),
+				sdk.NewAttribute(types.AttributeKeyRejectRate, rule.RejectRate.String()),
+				sdk.NewAttribute(types.AttributeKeyDraw, draw.String()),
+			))
+			ctx = types.WithTxRejection(ctx, sdkerrors.Wrapf(types.ErrTxRejected, "message %d (%s) drew %s, below the reject rate %s", i, msgTypeURL, draw, rule.RejectRate))
+
+			break
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
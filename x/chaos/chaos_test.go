@@ -0,0 +1,726 @@
+package chaos_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+	"github.com/cosmos/ibc-go/v5/testing/simapp"
+	"github.com/cosmos/ibc-go/v5/testing/simapp/helpers"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos-builders/chaos/app"
+	"github.com/cosmos-builders/chaos/x/chaos"
+	"github.com/cosmos-builders/chaos/x/chaos/types"
+)
+
+var msgSendTypeURL = sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+func chaosApp(chain *ibctesting.TestChain) *app.App {
+	return chain.App.(*app.App)
+}
+
+// ChaosTestSuite runs the faults scheduled through governance on a single
+// chain.
+type ChaosTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+	chain       *ibctesting.TestChain
+}
+
+func TestChaosTestSuite(t *testing.T) {
+	suite.Run(t, new(ChaosTestSuite))
+}
+
+func (suite *ChaosTestSuite) SetupTest() {
+	ibctesting.DefaultTestingAppInit = app.SetupTestingApp
+
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 1)
+	suite.chain = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+}
+
+// executeProposal runs a proposal through the chaos proposal handler and
+// moves to the next block.
+func (suite *ChaosTestSuite) executeProposal(content govv1beta1.Content) error {
+	suite.Require().NoError(content.ValidateBasic())
+	suite.Require().Equal(types.RouterKey, content.ProposalRoute())
+
+	handler := chaos.NewChaosProposalHandler(chaosApp(suite.chain).ChaosKeeper)
+	if err := handler(suite.chain.GetContext(), content); err != nil {
+		return err
+	}
+	suite.chain.NextBlock()
+
+	return nil
+}
+
+// scheduleFault schedules a fault whose window starts at the next block.
+func (suite *ChaosTestSuite) scheduleFault(fault types.Fault, blocks int64) {
+	fault.StartHeight = suite.chain.CurrentHeader.Height + 1
+	fault.EndHeight = fault.StartHeight + blocks - 1
+	suite.Require().NoError(suite.executeProposal(types.NewScheduleFaultProposal("title", "description", fault)))
+}
+
+func (suite *ChaosTestSuite) msgSend() sdk.Msg {
+	return banktypes.NewMsgSend(
+		suite.chain.SenderAccount.GetAddress(),
+		suite.chain.SenderAccounts[1].SenderAccount.GetAddress(),
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+	)
+}
+
+// deliverFailingTx delivers a transaction expected to fail in the current
+// block and returns its error.
+func (suite *ChaosTestSuite) deliverFailingTx(msgs ...sdk.Msg) error {
+	_, _, err := simapp.SignAndDeliver(
+		suite.T(),
+		suite.chain.TxConfig,
+		suite.chain.App.GetBaseApp(),
+		suite.chain.GetContext().BlockHeader(),
+		msgs,
+		suite.chain.ChainID,
+		[]uint64{suite.chain.SenderAccount.GetAccountNumber()},
+		[]uint64{suite.chain.SenderAccount.GetSequence()},
+		true, false, suite.chain.SenderPrivKey,
+	)
+
+	return err
+}
+
+// setParams sets the chaos parameters changed by malleate.
+func (suite *ChaosTestSuite) setParams(malleate func(*types.Params)) {
+	keeper := chaosApp(suite.chain).ChaosKeeper
+	params := keeper.GetParams(suite.chain.GetContext())
+	malleate(&params)
+	keeper.SetParams(suite.chain.GetContext(), params)
+}
+
+func (suite *ChaosTestSuite) TestConsumeGas() {
+	// the whole block gas but the reserve is consumed, leaving none to
+	// transactions with a tiny reserve
+	suite.setParams(func(params *types.Params) { params.BlockGasReserve = 1 })
+	suite.scheduleFault(types.Fault{Kind: types.KindConsumeGas, Gas: uint64(simapp.DefaultConsensusParams.Block.MaxGas) * 2}, 1)
+	res := suite.deliverTx(suite.msgSend())
+	suite.Require().Equal(sdkerrors.ErrOutOfGas.ABCICode(), res.Code, res.Log)
+
+	// the fault is deleted once its window is over
+	suite.chain.NextBlock()
+	suite.Require().Empty(chaosApp(suite.chain).ChaosKeeper.GetAllFaults(suite.chain.GetContext()))
+	_, err := suite.chain.SendMsgs(suite.msgSend())
+	suite.Require().NoError(err)
+
+	// the block gas reserve is left to the transactions
+	keeper := chaosApp(suite.chain).ChaosKeeper
+	suite.setParams(func(params *types.Params) { params.BlockGasReserve = 1000 })
+	height := suite.chain.CurrentHeader.Height + 1
+	_, err = keeper.ScheduleFault(suite.chain.GetContext(), types.Fault{Kind: types.KindConsumeGas, StartHeight: height, EndHeight: height, Gas: 1500})
+	suite.Require().NoError(err)
+
+	ctx := suite.chain.GetContext().WithBlockHeight(height).WithBlockGasMeter(sdk.NewGasMeter(2500))
+	keeper.RunFaults(ctx, types.PhaseBeginBlock)
+	suite.Require().Equal(uint64(1500), ctx.BlockGasMeter().GasConsumed())
+	keeper.RunFaults(ctx, types.PhaseBeginBlock)
+	suite.Require().Equal(uint64(1500), ctx.BlockGasMeter().GasConsumed())
+
+	ctx = ctx.WithBlockGasMeter(sdk.NewGasMeter(900))
+	keeper.RunFaults(ctx, types.PhaseBeginBlock)
+	suite.Require().Zero(ctx.BlockGasMeter().GasConsumed())
+}
+
+func (suite *ChaosTestSuite) TestFailMsg() {
+	suite.scheduleFault(types.Fault{Kind: types.KindFailMsg, MsgTypeUrl: msgSendTypeURL}, 2)
+
+	suite.Require().ErrorIs(suite.deliverFailingTx(suite.msgSend()), types.ErrInjectedFault)
+
+	// the transactions are still accepted by CheckTx
+	tx, err := helpers.GenTx(
+		suite.chain.TxConfig,
+		[]sdk.Msg{suite.msgSend()},
+		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)},
+		helpers.DefaultGenTxGas,
+		suite.chain.ChainID,
+		[]uint64{suite.chain.SenderAccount.GetAccountNumber()},
+		[]uint64{suite.chain.SenderAccount.GetSequence()},
+		suite.chain.SenderPrivKey,
+	)
+	suite.Require().NoError(err)
+	_, _, err = suite.chain.App.GetBaseApp().SimCheck(suite.chain.TxConfig.TxEncoder(), tx)
+	suite.Require().NoError(err)
+
+	// other messages are delivered
+	_, err = suite.chain.SendMsgs(banktypes.NewMsgMultiSend(
+		[]banktypes.Input{banktypes.NewInput(suite.chain.SenderAccount.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))},
+		[]banktypes.Output{banktypes.NewOutput(suite.chain.SenderAccounts[1].SenderAccount.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))},
+	))
+	suite.Require().NoError(err)
+	suite.Require().ErrorIs(suite.deliverFailingTx(suite.msgSend()), types.ErrInjectedFault)
+
+	suite.chain.NextBlock()
+	_, err = suite.chain.SendMsgs(suite.msgSend())
+	suite.Require().NoError(err)
+}
+
+func (suite *ChaosTestSuite) TestKillSwitch() {
+	keeper := chaosApp(suite.chain).ChaosKeeper
+	suite.scheduleFault(types.Fault{Kind: types.KindFailMsg, MsgTypeUrl: msgSendTypeURL}, 10)
+	suite.Require().ErrorIs(suite.deliverFailingTx(suite.msgSend()), types.ErrInjectedFault)
+
+	// no fault runs and no fault can be scheduled while the kill switch is
+	// engaged
+	suite.Require().NoError(suite.executeProposal(types.NewKillSwitchProposal("title", "description", true)))
+	res, err := keeper.KillSwitch(sdk.WrapSDKContext(suite.chain.GetContext()), &types.QueryKillSwitchRequest{})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Engaged)
+
+	_, err = suite.chain.SendMsgs(suite.msgSend())
+	suite.Require().NoError(err)
+	err = suite.executeProposal(types.NewScheduleFaultProposal("title", "description", types.Fault{
+		Kind:        types.KindSleep,
+		StartHeight: suite.chain.CurrentHeader.Height + 1,
+		EndHeight:   suite.chain.CurrentHeader.Height + 1,
+		Sleep:       time.Millisecond,
+	}))
+	suite.Require().ErrorIs(err, types.ErrKillSwitchEngaged)
+
+	ctx := suite.chain.GetContext().WithEventManager(sdk.NewEventManager())
+	keeper.RunFaults(ctx, types.PhaseBeginBlock)
+	suite.Require().Empty(ctx.EventManager().Events())
+
+	// the scheduled faults run again once it is released
+	suite.Require().NoError(suite.executeProposal(types.NewKillSwitchProposal("title", "description", false)))
+	suite.Require().ErrorIs(suite.deliverFailingTx(suite.msgSend()), types.ErrInjectedFault)
+}
+
+// TestEngageKillSwitch checks that the guardian engages the kill switch
+// while a fault of each kind is active.
+func (suite *ChaosTestSuite) TestEngageKillSwitch() {
+	testCases := []struct {
+		name  string
+		fault types.Fault
+	}{
+		{"consume-gas", types.Fault{Kind: types.KindConsumeGas, Gas: uint64(simapp.DefaultConsensusParams.Block.MaxGas) * 2}},
+		{"oversized-events", types.Fault{Kind: types.KindOversizedEvents, EventBytes: 1 << 20}},
+		{"fail-msg", types.Fault{Kind: types.KindFailMsg, MsgTypeUrl: msgSendTypeURL}},
+		{"sleep", types.Fault{Kind: types.KindSleep, Sleep: time.Millisecond}},
+		{"end block sleep", types.Fault{Kind: types.KindSleep, Phase: types.PhaseEndBlock, Sleep: time.Millisecond}},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			keeper := chaosApp(suite.chain).ChaosKeeper
+			guardian := suite.chain.SenderAccount.GetAddress().String()
+			suite.setParams(func(params *types.Params) { params.Guardian = guardian })
+			suite.scheduleFault(tc.fault, 10)
+			suite.Require().Len(keeper.GetActiveFaults(suite.chain.GetContext()), 1)
+
+			res := suite.deliverTx(types.NewMsgEngageKillSwitch(guardian))
+			suite.Require().True(res.IsOK(), res.Log)
+			suite.Require().True(keeper.IsKillSwitchEngaged(suite.chain.GetContext()))
+			attributes, found := findEvent(res.Events, types.EventTypeKillSwitch)
+			suite.Require().True(found)
+			suite.Require().Equal("true", attributes[types.AttributeKeyEngaged])
+
+			// no fault runs once it is engaged
+			ctx := suite.chain.GetContext().WithEventManager(sdk.NewEventManager())
+			keeper.RunFaults(ctx, tc.fault.Phase)
+			suite.Require().Empty(ctx.EventManager().Events())
+		})
+	}
+}
+
+func (suite *ChaosTestSuite) TestEngageKillSwitchUnauthorized() {
+	keeper := chaosApp(suite.chain).ChaosKeeper
+	sender := suite.chain.SenderAccount.GetAddress().String()
+
+	// no guardian by default
+	res := suite.deliverTx(types.NewMsgEngageKillSwitch(sender))
+	suite.Require().Equal(sdkerrors.ErrUnauthorized.ABCICode(), res.Code, res.Log)
+	suite.Require().False(keeper.IsKillSwitchEngaged(suite.chain.GetContext()))
+
+	suite.setParams(func(params *types.Params) {
+		params.Guardian = suite.chain.SenderAccounts[1].SenderAccount.GetAddress().String()
+	})
+	res = suite.deliverTx(types.NewMsgEngageKillSwitch(sender))
+	suite.Require().Equal(sdkerrors.ErrUnauthorized.ABCICode(), res.Code, res.Log)
+	suite.Require().False(keeper.IsKillSwitchEngaged(suite.chain.GetContext()))
+}
+
+func (suite *ChaosTestSuite) TestBlockPhaseFaults() {
+	keeper := chaosApp(suite.chain).ChaosKeeper
+	height := suite.chain.CurrentHeader.Height + 1
+	_, err := keeper.ScheduleFault(suite.chain.GetContext(), types.Fault{
+		Kind: types.KindOversizedEvents, StartHeight: height, EndHeight: height, Phase: types.PhaseEndBlock, EventBytes: 1 << 20,
+	})
+	suite.Require().NoError(err)
+	_, err = keeper.ScheduleFault(suite.chain.GetContext(), types.Fault{
+		Kind: types.KindSleep, StartHeight: height, EndHeight: height, Sleep: 50 * time.Millisecond,
+	})
+	suite.Require().NoError(err)
+
+	// the faults only run in their block phase
+	ctx := suite.chain.GetContext().WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+	start := time.Now()
+	keeper.RunFaults(ctx, types.PhaseBeginBlock)
+	suite.Require().GreaterOrEqual(time.Since(start), 50*time.Millisecond)
+	for _, event := range ctx.EventManager().Events() {
+		suite.Require().NotEqual(types.EventTypeOversized, event.Type)
+	}
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	keeper.RunFaults(ctx, types.PhaseEndBlock)
+	var payload []byte
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeOversized {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeKeyPayload {
+				payload = attr.Value
+			}
+		}
+	}
+	suite.Require().Len(payload, 1<<20)
+}
+
+func (suite *ChaosTestSuite) TestQueries() {
+	keeper := chaosApp(suite.chain).ChaosKeeper
+	height := suite.chain.CurrentHeader.Height
+	for _, window := range [][2]int64{{height + 1, height + 1}, {height + 1, height + 5}, {height + 3, height + 4}} {
+		_, err := keeper.ScheduleFault(suite.chain.GetContext(), types.Fault{
+			Kind: types.KindConsumeGas, StartHeight: window[0], EndHeight: window[1], Gas: 1000,
+		})
+		suite.Require().NoError(err)
+	}
+
+	ids := func(faults []types.Fault) []uint64 {
+		ids := []uint64{}
+		for _, fault := range faults {
+			ids = append(ids, fault.Id)
+		}
+		return ids
+	}
+
+	ctx := suite.chain.GetContext().WithBlockHeight(height + 1)
+	active, err := keeper.ActiveFaults(sdk.WrapSDKContext(ctx), &types.QueryActiveFaultsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{1, 2}, ids(active.Faults))
+	upcoming, err := keeper.UpcomingFaults(sdk.WrapSDKContext(ctx), &types.QueryUpcomingFaultsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{3}, ids(upcoming.Faults))
+
+	keeper.PruneFaults(ctx)
+	suite.Require().Equal([]uint64{2, 3}, ids(keeper.GetAllFaults(ctx)))
+
+	_, err = keeper.ActiveFaults(sdk.WrapSDKContext(ctx), nil)
+	suite.Require().Error(err)
+}
+
+func (suite *ChaosTestSuite) TestProposals() {
+	height := suite.chain.CurrentHeader.Height
+
+	testCases := []struct {
+		name    string
+		content govv1beta1.Content
+		expErr  error
+	}{
+		{
+			"fault window already started",
+			types.NewScheduleFaultProposal("title", "description", types.Fault{Kind: types.KindSleep, StartHeight: height, EndHeight: height + 1, Sleep: time.Millisecond}),
+			types.ErrInvalidFault,
+		},
+		{
+			"unknown message type",
+			types.NewScheduleFaultProposal("title", "description", types.Fault{Kind: types.KindFailMsg, StartHeight: height + 1, EndHeight: height + 1, MsgTypeUrl: "/chaos.unknown.MsgUnknown"}),
+			types.ErrUnknownMessageType,
+		},
+		{
+			"fault window too long",
+			types.NewScheduleFaultProposal("title", "description", types.Fault{
+				Kind: types.KindSleep, StartHeight: height + 1, EndHeight: height + 1 + int64(types.DefaultMaxFaultWindow), Sleep: time.Millisecond,
+			}),
+			types.ErrInvalidFault,
+		},
+		{
+			"schedule fault",
+			types.NewScheduleFaultProposal("title", "description", types.Fault{Kind: types.KindFailMsg, StartHeight: height + 10, EndHeight: height + 20, MsgTypeUrl: msgSendTypeURL}),
+			nil,
+		},
+		{
+			"cancel unknown fault",
+			types.NewCancelFaultProposal("title", "description", 2),
+			types.ErrFaultNotFound,
+		},
+		{
+			"cancel fault",
+			types.NewCancelFaultProposal("title", "description", 1),
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := suite.executeProposal(tc.content)
+		if tc.expErr != nil {
+			suite.Require().ErrorIs(err, tc.expErr, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+		}
+	}
+
+	suite.Require().Empty(chaosApp(suite.chain).ChaosKeeper.GetAllFaults(suite.chain.GetContext()))
+}
+
+func (suite *ChaosTestSuite) TestGenesis() {
+	keeper := chaosApp(suite.chain).ChaosKeeper
+	suite.scheduleFault(types.Fault{Kind: types.KindFailMsg, MsgTypeUrl: msgSendTypeURL}, 10)
+	keeper.SetKillSwitch(suite.chain.GetContext(), true)
+	params := types.NewParams(true, []types.TxFaultRule{{MsgTypeUrl: msgSendTypeURL, RejectRate: sdk.NewDecWithPrec(5, 1), ExtraGas: 1000}})
+	keeper.SetParams(suite.chain.GetContext(), params)
+
+	gs := keeper.ExportGenesis(suite.chain.GetContext())
+	suite.Require().NoError(gs.Validate())
+	suite.Require().Len(gs.Faults, 1)
+	suite.Require().Equal(uint64(2), gs.NextFaultId)
+	suite.Require().True(gs.KillSwitch)
+	suite.Require().Equal(params, gs.Params)
+
+	ctx := suite.chain.GetContext()
+	suite.Require().NoError(keeper.CancelFault(ctx, 1))
+	keeper.SetKillSwitch(ctx, false)
+	keeper.SetParams(ctx, types.DefaultParams())
+	keeper.InitGenesis(ctx, *gs)
+	suite.Require().Equal(gs, keeper.ExportGenesis(ctx))
+}
+
+// genTx returns a transaction of the sender account of the chain.
+func (suite *ChaosTestSuite) genTx(msgs ...sdk.Msg) sdk.Tx {
+	tx, err := helpers.GenTx(
+		suite.chain.TxConfig,
+		msgs,
+		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)},
+		helpers.DefaultGenTxGas,
+		suite.chain.ChainID,
+		[]uint64{suite.chain.SenderAccount.GetAccountNumber()},
+		[]uint64{suite.chain.SenderAccount.GetSequence()},
+		suite.chain.SenderPrivKey,
+	)
+	suite.Require().NoError(err)
+
+	return tx
+}
+
+// deliverTx delivers a transaction in the current block and returns the
+// DeliverTx response, which holds the events of failed transactions.
+func (suite *ChaosTestSuite) deliverTx(msgs ...sdk.Msg) abci.ResponseDeliverTx {
+	bz, err := suite.chain.TxConfig.TxEncoder()(suite.genTx(msgs...))
+	suite.Require().NoError(err)
+
+	res := suite.chain.App.DeliverTx(abci.RequestDeliverTx{Tx: bz})
+
+	// the sequence is incremented whenever the AnteHandler passes
+	acc := chaosApp(suite.chain).AccountKeeper.GetAccount(suite.chain.GetContext(), suite.chain.SenderAccount.GetAddress())
+	suite.Require().NoError(suite.chain.SenderAccount.SetSequence(acc.GetSequence()))
+
+	return res
+}
+
+func findEvent(events []abci.Event, eventType string) (map[string]string, bool) {
+	for _, event := range events {
+		if event.Type != eventType {
+			continue
+		}
+
+		attributes := make(map[string]string)
+		for _, attr := range event.Attributes {
+			attributes[string(attr.Key)] = string(attr.Value)
+		}
+		return attributes, true
+	}
+
+	return nil, false
+}
+
+func (suite *ChaosTestSuite) TestTxFaultRules() {
+	chaosApp := chaosApp(suite.chain)
+	setRule := func(enabled bool, rejectRate sdk.Dec, extraGas uint64) {
+		chaosApp.ChaosKeeper.SetParams(suite.chain.GetContext(), types.NewParams(enabled, []types.TxFaultRule{
+			{MsgTypeUrl: msgSendTypeURL, RejectRate: rejectRate, ExtraGas: extraGas},
+		}))
+	}
+	balance := func() sdk.Coins {
+		return chaosApp.BankKeeper.GetAllBalances(suite.chain.GetContext(), suite.chain.SenderAccount.GetAddress())
+	}
+
+	// no rule applies while the tx faults are disabled
+	setRule(false, sdk.OneDec(), 100000)
+	res := suite.deliverTx(suite.msgSend())
+	suite.Require().True(res.IsOK(), res.Log)
+	_, found := findEvent(res.Events, types.EventTypeTxRejected)
+	suite.Require().False(found)
+	_, found = findEvent(res.Events, types.EventTypeExtraGas)
+	suite.Require().False(found)
+	gasUsed := res.GasUsed
+
+	// rejected transactions pass the AnteHandler and fail before their
+	// messages are executed, keeping the events explaining the rejection
+	setRule(true, sdk.OneDec(), 0)
+	before := balance()
+	res = suite.deliverTx(suite.msgSend())
+	suite.Require().Equal(types.ModuleName, res.Codespace)
+	suite.Require().Equal(types.ErrTxRejected.ABCICode(), res.Code)
+	suite.Require().Equal(before, balance())
+	attributes, found := findEvent(res.Events, types.EventTypeTxRejected)
+	suite.Require().True(found)
+	suite.Require().Equal(msgSendTypeURL, attributes[types.AttributeKeyMsgTypeURL])
+	suite.Require().Equal("0", attributes[types.AttributeKeyMsgIndex])
+	suite.Require().Equal(sdk.OneDec().String(), attributes[types.AttributeKeyRejectRate])
+	suite.Require().NotEmpty(attributes[types.AttributeKeyDraw])
+
+	// other messages are not affected
+	suite.Require().True(suite.deliverTx(banktypes.NewMsgMultiSend(
+		[]banktypes.Input{banktypes.NewInput(suite.chain.SenderAccount.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))},
+		[]banktypes.Output{banktypes.NewOutput(suite.chain.SenderAccounts[1].SenderAccount.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))},
+	)).IsOK())
+
+	// extra gas is charged for each message
+	setRule(true, sdk.ZeroDec(), 100000)
+	res = suite.deliverTx(suite.msgSend(), suite.msgSend())
+	suite.Require().True(res.IsOK(), res.Log)
+	suite.Require().Greater(res.GasUsed, gasUsed+200000)
+	attributes, found = findEvent(res.Events, types.EventTypeExtraGas)
+	suite.Require().True(found)
+	suite.Require().Equal("100000", attributes[types.AttributeKeyGas])
+
+	setRule(true, sdk.ZeroDec(), helpers.DefaultGenTxGas)
+	res = suite.deliverTx(suite.msgSend())
+	suite.Require().Equal(sdkerrors.ErrOutOfGas.ABCICode(), res.Code)
+
+	// no rule applies while the kill switch is engaged
+	setRule(true, sdk.OneDec(), 0)
+	chaosApp.ChaosKeeper.SetKillSwitch(suite.chain.GetContext(), true)
+	suite.Require().True(suite.deliverTx(suite.msgSend()).IsOK())
+}
+
+func (suite *ChaosTestSuite) TestTxFaultDecoratorInactive() {
+	keeper := chaosApp(suite.chain).ChaosKeeper
+	keeper.SetParams(suite.chain.GetContext(), types.NewParams(false, []types.TxFaultRule{
+		{MsgTypeUrl: msgSendTypeURL, RejectRate: sdk.OneDec(), ExtraGas: 100000},
+	}))
+
+	tx := suite.genTx(suite.msgSend())
+	bz, err := suite.chain.TxConfig.TxEncoder()(tx)
+	suite.Require().NoError(err)
+
+	// the context given to the next decorator is left untouched
+	ctx := suite.chain.GetContext().
+		WithTxBytes(bz).
+		WithGasMeter(sdk.NewGasMeter(helpers.DefaultGenTxGas)).
+		WithEventManager(sdk.NewEventManager())
+	var nextCtx sdk.Context
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		nextCtx = ctx
+		return ctx, nil
+	}
+
+	_, err = chaos.NewTxFaultDecorator(keeper).AnteHandle(ctx, tx, false, next)
+	suite.Require().NoError(err)
+	suite.Require().Zero(nextCtx.GasMeter().GasConsumed())
+	suite.Require().Empty(nextCtx.EventManager().Events())
+	suite.Require().NoError(types.TxRejection(nextCtx))
+
+	// the same rule applies once enabled
+	keeper.SetParams(suite.chain.GetContext(), types.NewParams(true, keeper.GetParams(suite.chain.GetContext()).TxFaultRules))
+	_, err = chaos.NewTxFaultDecorator(keeper).AnteHandle(ctx, tx, false, next)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(100000), nextCtx.GasMeter().GasConsumed())
+	suite.Require().ErrorIs(types.TxRejection(nextCtx), types.ErrTxRejected)
+}
+
+// TestGenesisTransactions delivers a genesis transaction in InitChain, before
+// the chaos genesis is initialized, at the first and at a later initial
+// height.
+func TestGenesisTransactions(t *testing.T) {
+	for _, initialHeight := range []int64{1, 5} {
+		encodingConfig := app.MakeEncodingConfig()
+		cdc := encodingConfig.Marshaler
+		chainApp := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, app.DefaultNodeHome, 5, encodingConfig, app.EmptyAppOptions{})
+
+		privKey := secp256k1.GenPrivKey()
+		addr := sdk.AccAddress(privKey.PubKey().Address())
+		genesisState := app.NewDefaultGenesisState(cdc)
+		authGenState := authtypes.NewGenesisState(authtypes.DefaultParams(), []authtypes.GenesisAccount{authtypes.NewBaseAccount(addr, nil, 0, 0)})
+		genesisState[authtypes.ModuleName] = cdc.MustMarshalJSON(authGenState)
+		balance := banktypes.Balance{Address: addr.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000000))}
+		bankGenState := banktypes.NewGenesisState(banktypes.DefaultParams(), []banktypes.Balance{balance}, balance.Coins, nil)
+		genesisState[banktypes.ModuleName] = cdc.MustMarshalJSON(bankGenState)
+
+		msg, err := stakingtypes.NewMsgCreateValidator(
+			sdk.ValAddress(addr),
+			ed25519.GenPrivKey().PubKey(),
+			sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000000),
+			stakingtypes.NewDescription("validator", "", "", "", ""),
+			stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+			sdk.OneInt(),
+		)
+		require.NoError(t, err)
+		genTx, err := helpers.GenTx(encodingConfig.TxConfig, []sdk.Msg{msg}, sdk.Coins{}, helpers.DefaultGenTxGas, "chaos-1", []uint64{0}, []uint64{0}, privKey)
+		require.NoError(t, err)
+		bz, err := encodingConfig.TxConfig.TxJSONEncoder()(genTx)
+		require.NoError(t, err)
+		genesisState[genutiltypes.ModuleName] = cdc.MustMarshalJSON(genutiltypes.NewGenesisState([]json.RawMessage{bz}))
+
+		stateBytes, err := json.Marshal(genesisState)
+		require.NoError(t, err)
+		res := chainApp.InitChain(abci.RequestInitChain{
+			ChainId:         "chaos-1",
+			ConsensusParams: app.DefaultConsensusParams,
+			AppStateBytes:   stateBytes,
+			InitialHeight:   initialHeight,
+		})
+		require.Len(t, res.Validators, 1, initialHeight)
+	}
+}
+
+func TestTxFaultDraw(t *testing.T) {
+	seed := types.TxFaultSeed(tmproto.Header{Height: 10, AppHash: []byte("app hash")}, []byte("tx"))
+	require.Equal(t, seed, types.TxFaultSeed(tmproto.Header{Height: 10, AppHash: []byte("app hash")}, []byte("tx")))
+	require.NotEqual(t, seed, types.TxFaultSeed(tmproto.Header{Height: 11, AppHash: []byte("app hash")}, []byte("tx")))
+	require.NotEqual(t, seed, types.TxFaultSeed(tmproto.Header{Height: 10, AppHash: []byte("app hash")}, []byte("other tx")))
+	require.True(t, types.TxFaultDraw(seed, 0).Equal(types.TxFaultDraw(seed, 0)))
+
+	// draws are uniformly distributed in [0, 1)
+	rejectRate := sdk.NewDecWithPrec(3, 1)
+	rejected := 0
+	for i := 0; i < 10000; i++ {
+		draw := types.TxFaultDraw(seed, i)
+		require.False(t, draw.IsNegative())
+		require.True(t, draw.LT(sdk.OneDec()))
+		if draw.LT(rejectRate) {
+			rejected++
+		}
+	}
+	require.InDelta(t, 3000, rejected, 200)
+}
+
+// withParams returns the default parameters changed by malleate.
+func withParams(malleate func(*types.Params)) types.Params {
+	params := types.DefaultParams()
+	malleate(&params)
+	return params
+}
+
+func TestParamsValidate(t *testing.T) {
+	rule := types.TxFaultRule{MsgTypeUrl: msgSendTypeURL, RejectRate: sdk.NewDecWithPrec(5, 1)}
+
+	testCases := []struct {
+		name    string
+		params  types.Params
+		expPass bool
+	}{
+		{"default", types.DefaultParams(), true},
+		{"valid rule", types.NewParams(true, []types.TxFaultRule{rule}), true},
+		{"extra gas only", types.NewParams(true, []types.TxFaultRule{{MsgTypeUrl: msgSendTypeURL, RejectRate: sdk.ZeroDec(), ExtraGas: 1}}), true},
+		{"no-op rule", types.NewParams(true, []types.TxFaultRule{{MsgTypeUrl: msgSendTypeURL, RejectRate: sdk.ZeroDec()}}), false},
+		{"reject rate above 1", types.NewParams(true, []types.TxFaultRule{{MsgTypeUrl: msgSendTypeURL, RejectRate: sdk.NewDec(2)}}), false},
+		{"negative reject rate", types.NewParams(true, []types.TxFaultRule{{MsgTypeUrl: msgSendTypeURL, RejectRate: sdk.NewDec(-1)}}), false},
+		{"invalid message type URL", types.NewParams(true, []types.TxFaultRule{{MsgTypeUrl: "MsgSend", RejectRate: sdk.OneDec()}}), false},
+		{"duplicate rule", types.NewParams(true, []types.TxFaultRule{rule, rule}), false},
+		{"governance message rule", types.NewParams(true, []types.TxFaultRule{{MsgTypeUrl: "/cosmos.gov.v1beta1.MsgVote", RejectRate: sdk.OneDec()}}), false},
+		{"guardian", withParams(func(p *types.Params) { p.Guardian = sdk.AccAddress("guardian").String() }), true},
+		{"invalid guardian", withParams(func(p *types.Params) { p.Guardian = "guardian" }), false},
+		{"zero max fault window", withParams(func(p *types.Params) { p.MaxFaultWindow = 0 }), false},
+		{"zero block gas reserve", withParams(func(p *types.Params) { p.BlockGasReserve = 0 }), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.params.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestFaultValidate(t *testing.T) {
+	valid := types.Fault{Kind: types.KindConsumeGas, StartHeight: 10, EndHeight: 20, Gas: 1000}
+
+	testCases := []struct {
+		name     string
+		malleate func(*types.Fault)
+		expPass  bool
+	}{
+		{"valid", func(f *types.Fault) {}, true},
+		{"unspecified kind", func(f *types.Fault) { f.Kind = types.KindUnspecified }, false},
+		{"zero start height", func(f *types.Fault) { f.StartHeight = 0 }, false},
+		{"end before start", func(f *types.Fault) { f.EndHeight = 9 }, false},
+		{"missing gas", func(f *types.Fault) { f.Gas = 0 }, false},
+		{"parameter of another kind", func(f *types.Fault) { f.Sleep = time.Second }, false},
+		{"gas consumed in EndBlock", func(f *types.Fault) { f.Phase = types.PhaseEndBlock }, false},
+		{"sleep in EndBlock", func(f *types.Fault) {
+			*f = types.Fault{Kind: types.KindSleep, StartHeight: 10, EndHeight: 10, Phase: types.PhaseEndBlock, Sleep: time.Second}
+		}, true},
+		{"sleep too long", func(f *types.Fault) {
+			*f = types.Fault{Kind: types.KindSleep, StartHeight: 10, EndHeight: 10, Sleep: time.Hour}
+		}, false},
+		{"oversized event too large", func(f *types.Fault) {
+			*f = types.Fault{Kind: types.KindOversizedEvents, StartHeight: 10, EndHeight: 10, EventBytes: types.MaxEventBytes + 1}
+		}, false},
+		{"invalid message type URL", func(f *types.Fault) {
+			*f = types.Fault{Kind: types.KindFailMsg, StartHeight: 10, EndHeight: 10, MsgTypeUrl: "cosmos.bank.v1beta1.MsgSend"}
+		}, false},
+	}
+	// the messages engaging the kill switch cannot be failed
+	for _, msgTypeURL := range []string{
+		"/cosmos.gov.v1beta1.MsgSubmitProposal",
+		"/cosmos.gov.v1beta1.MsgDeposit",
+		"/cosmos.gov.v1beta1.MsgVote",
+		"/cosmos.gov.v1.MsgVote",
+		"/cosmos.gov.v1.MsgVoteWeighted",
+		sdk.MsgTypeURL(&types.MsgEngageKillSwitch{}),
+	} {
+		msgTypeURL := msgTypeURL
+		testCases = append(testCases, struct {
+			name     string
+			malleate func(*types.Fault)
+			expPass  bool
+		}{"fail " + msgTypeURL, func(f *types.Fault) {
+			*f = types.Fault{Kind: types.KindFailMsg, StartHeight: 10, EndHeight: 10, MsgTypeUrl: msgTypeURL}
+		}, false})
+	}
+
+	for _, tc := range testCases {
+		fault := valid
+		tc.malleate(&fault)
+		err := fault.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+
+	gs := types.DefaultGenesis()
+	require.NoError(t, gs.Validate())
+	valid.Id = 1
+	require.Error(t, types.NewGenesisState([]types.Fault{valid}, 1, false, types.DefaultParams()).Validate())
+	require.Error(t, types.NewGenesisState([]types.Fault{valid, valid}, 2, false, types.DefaultParams()).Validate())
+	require.NoError(t, types.NewGenesisState([]types.Fault{valid}, 2, true, types.DefaultParams()).Validate())
+}
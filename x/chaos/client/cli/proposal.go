@@ -0,0 +1,203 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/cosmos-builders/chaos/x/chaos/types"
+)
+
+const (
+	flagEndHeight  = "end-height"
+	flagPhase      = "phase"
+	flagGas        = "gas-amount"
+	flagEventBytes = "event-bytes"
+	flagMsgTypeURL = "msg-type-url"
+	flagSleep      = "sleep"
+)
+
+// NewCmdSubmitScheduleFaultProposal implements a command handler for
+// submitting a schedule chaos fault proposal transaction.
+func NewCmdSubmitScheduleFaultProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule-chaos-fault [fault] [start-height]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to run a chaos fault on a window of heights",
+		Long: "Submit a proposal to run a chaos fault on a window of heights along with an initial deposit.\n" +
+			"Faults are consume-gas, oversized-events, fail-msg and sleep, each taking its own flag: --gas-amount,\n" +
+			"--event-bytes, --msg-type-url and --sleep respectively. The window ends at the start height unless\n" +
+			"--end-height is set, and is at most max_fault_window heights long. The governance and chaos messages\n" +
+			"cannot be failed, and consume-gas leaves the block_gas_reserve of the chaos parameters to transactions.",
+		Example: "schedule-chaos-fault fail-msg 1000 --end-height 1100 --msg-type-url /cosmos.bank.v1beta1.MsgSend",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kind, err := types.ParseFaultKind(args[0])
+			if err != nil {
+				return err
+			}
+			startHeight, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			fault := types.Fault{
+				Kind:        kind,
+				StartHeight: startHeight,
+				EndHeight:   startHeight,
+			}
+
+			endHeight, err := cmd.Flags().GetInt64(flagEndHeight)
+			if err != nil {
+				return err
+			}
+			if endHeight != 0 {
+				fault.EndHeight = endHeight
+			}
+
+			phase, err := cmd.Flags().GetString(flagPhase)
+			if err != nil {
+				return err
+			}
+			if fault.Phase, err = types.ParseBlockPhase(phase); err != nil {
+				return err
+			}
+
+			if fault.Gas, err = cmd.Flags().GetUint64(flagGas); err != nil {
+				return err
+			}
+			if fault.EventBytes, err = cmd.Flags().GetUint64(flagEventBytes); err != nil {
+				return err
+			}
+			if fault.MsgTypeUrl, err = cmd.Flags().GetString(flagMsgTypeURL); err != nil {
+				return err
+			}
+			if fault.Sleep, err = cmd.Flags().GetDuration(flagSleep); err != nil {
+				return err
+			}
+
+			if err := fault.Validate(); err != nil {
+				return err
+			}
+
+			return submitProposal(cmd, func(title, description string) govv1beta1.Content {
+				return types.NewScheduleFaultProposal(title, description, fault)
+			})
+		},
+	}
+
+	cmd.Flags().Int64(flagEndHeight, 0, "last height of the window, the start height if unset")
+	cmd.Flags().String(flagPhase, types.PhaseBeginBlock.Name(), "phase of the block the fault runs in, begin or end")
+	cmd.Flags().Uint64(flagGas, 0, "block gas consumed by a consume-gas fault")
+	cmd.Flags().Uint64(flagEventBytes, 0, fmt.Sprintf("size of the event attribute emitted by an oversized-events fault, at most %d", types.MaxEventBytes))
+	cmd.Flags().String(flagMsgTypeURL, "", "type URL of the messages failed by a fail-msg fault")
+	cmd.Flags().Duration(flagSleep, 0, fmt.Sprintf("time slept by a sleep fault, at most %s", types.MaxSleep))
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitCancelFaultProposal implements a command handler for
+// submitting a cancel chaos fault proposal transaction.
+func NewCmdSubmitCancelFaultProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-chaos-fault [fault-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to cancel a scheduled chaos fault",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			faultID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			return submitProposal(cmd, func(title, description string) govv1beta1.Content {
+				return types.NewCancelFaultProposal(title, description, faultID)
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitKillSwitchProposal implements a command handler for
+// submitting a chaos kill switch proposal transaction.
+func NewCmdSubmitKillSwitchProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "chaos-kill-switch [engage|release]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to engage or release the chaos kill switch",
+		Long: "Submit a proposal to engage or release the chaos kill switch along with an initial deposit.\n" +
+			"No chaos fault runs and no chaos fault can be scheduled while the kill switch is engaged. The guardian\n" +
+			"of the chaos parameters also engages it without governance with 'tx chaos engage-kill-switch'.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var engage bool
+			switch args[0] {
+			case "engage":
+				engage = true
+			case "release":
+				engage = false
+			default:
+				return fmt.Errorf("expected engage or release, got %q", args[0])
+			}
+
+			return submitProposal(cmd, func(title, description string) govv1beta1.Content {
+				return types.NewKillSwitchProposal(title, description, engage)
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+func submitProposal(cmd *cobra.Command, newContent func(title, description string) govv1beta1.Content) error {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	title, err := cmd.Flags().GetString(govcli.FlagTitle) //nolint:staticcheck // need this till full govv1 conversion.
+	if err != nil {
+		return err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription) //nolint:staticcheck // need this till full govv1 conversion.
+	if err != nil {
+		return err
+	}
+
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return err
+	}
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return err
+	}
+
+	msg, err := govv1beta1.NewMsgSubmitProposal(newContent(title, description), deposit, clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+
+	if err = msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
+
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")             //nolint:staticcheck // need this till full govv1 conversion.
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal") //nolint:staticcheck // need this till full govv1 conversion.
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+}
@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+
+	"github.com/cosmos-builders/chaos/x/chaos/types"
+)
+
+// GetTxCmd creates and returns the chaos tx command
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		getEngageKillSwitchCmd(),
+	)
+
+	return cmd
+}
+
+func getEngageKillSwitchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "engage-kill-switch",
+		Short: "Engage the chaos kill switch as the guardian of the chaos parameters",
+		Long: `Engage the chaos kill switch as the guardian of the chaos parameters, without
+governance. No fault runs and no fault can be scheduled until the kill switch
+is released by a governance proposal.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgEngageKillSwitch(clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
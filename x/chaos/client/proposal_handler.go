@@ -0,0 +1,13 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/cosmos-builders/chaos/x/chaos/client/cli"
+)
+
+var (
+	ScheduleFaultProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitScheduleFaultProposal)
+	CancelFaultProposalHandler   = govclient.NewProposalHandler(cli.NewCmdSubmitCancelFaultProposal)
+	KillSwitchProposalHandler    = govclient.NewProposalHandler(cli.NewCmdSubmitKillSwitchProposal)
+)
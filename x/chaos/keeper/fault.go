@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos-builders/chaos/x/chaos/types"
+)
+
+// ScheduleFault stores a new fault scheduled through governance and returns
+// its id. The window of the fault must start after the current height and
+// must not be longer than the max fault window of the parameters, and no
+// fault can be scheduled while the kill switch is engaged.
+func (k Keeper) ScheduleFault(ctx sdk.Context, fault types.Fault) (uint64, error) {
+	if k.IsKillSwitchEngaged(ctx) {
+		return 0, types.ErrKillSwitchEngaged
+	}
+
+	fault.Id = k.GetNextFaultID(ctx)
+	if err := fault.Validate(); err != nil {
+		return 0, err
+	}
+	if fault.StartHeight <= ctx.BlockHeight() {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidFault, "start height %d is not after current height %d", fault.StartHeight, ctx.BlockHeight())
+	}
+	if window, maxWindow := uint64(fault.EndHeight-fault.StartHeight)+1, k.GetParams(ctx).MaxFaultWindow; window > maxWindow {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidFault, "window of %d heights exceeds the max fault window %d", window, maxWindow)
+	}
+	if fault.Kind == types.KindFailMsg && k.msgRouter.HandlerByTypeURL(fault.MsgTypeUrl) == nil {
+		return 0, sdkerrors.Wrap(types.ErrUnknownMessageType, fault.MsgTypeUrl)
+	}
+
+	k.SetFault(ctx, fault)
+	k.SetNextFaultID(ctx, fault.Id+1)
+
+	k.Logger(ctx).Info("scheduled chaos fault", "id", fault.Id, "fault", fault.Kind.Name(), "start", fault.StartHeight, "end", fault.EndHeight)
+
+	return fault.Id, nil
+}
+
+// CancelFault deletes a scheduled fault.
+func (k Keeper) CancelFault(ctx sdk.Context, id uint64) error {
+	if _, found := k.GetFault(ctx, id); !found {
+		return sdkerrors.Wrapf(types.ErrFaultNotFound, "fault %d", id)
+	}
+
+	k.RemoveFault(ctx, id)
+
+	k.Logger(ctx).Info("cancelled chaos fault", "id", id)
+
+	return nil
+}
+
+// SwitchKillSwitch engages or releases the kill switch. The scheduled faults
+// are kept and run again once it is released if their window is not over.
+func (k Keeper) SwitchKillSwitch(ctx sdk.Context, engage bool) {
+	k.SetKillSwitch(ctx, engage)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeKillSwitch,
+		sdk.NewAttribute(types.AttributeKeyEngaged, strconv.FormatBool(engage)),
+	))
+
+	k.Logger(ctx).Info("switched chaos kill switch", "engaged", engage)
+}
+
+// RunFaults runs the active faults of a block phase, unless the kill switch
+// is engaged. Fail-msg faults are run by the FailMsgDecorator instead.
+func (k Keeper) RunFaults(ctx sdk.Context, phase types.BlockPhase) {
+	if k.IsKillSwitchEngaged(ctx) {
+		return
+	}
+
+	for _, fault := range k.GetActiveFaults(ctx) {
+		if fault.Phase != phase || fault.Kind == types.KindFailMsg {
+			continue
+		}
+
+		switch fault.Kind {
+		case types.KindConsumeGas:
+			k.consumeBlockGas(ctx, fault)
+		case types.KindOversizedEvents:
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeOversized,
+				sdk.NewAttribute(types.AttributeKeyFault, strconv.FormatUint(fault.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyPayload, strings.Repeat("x", int(fault.EventBytes))),
+			))
+		case types.KindSleep:
+			time.Sleep(fault.Sleep)
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeFault,
+			sdk.NewAttribute(types.AttributeKeyFault, strconv.FormatUint(fault.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyKind, fault.Kind.Name()),
+		))
+	}
+}
+
+// consumeBlockGas consumes the gas of a fault from the block gas meter. The
+// gas is capped to the gas left in the block minus the block gas reserve of
+// the parameters, since running out of block gas outside of a transaction
+// halts the chain and the transactions engaging the kill switch must still
+// fit in the block. Nothing is consumed when the block gas is unlimited.
+func (k Keeper) consumeBlockGas(ctx sdk.Context, fault types.Fault) {
+	meter := ctx.BlockGasMeter()
+	if meter == nil || meter.Limit() == 0 {
+		return
+	}
+
+	reserve := k.GetParams(ctx).BlockGasReserve
+	left := meter.Limit() - meter.GasConsumedToLimit()
+	if left <= reserve {
+		return
+	}
+
+	gas := fault.Gas
+	if gas > left-reserve {
+		gas = left - reserve
+	}
+	meter.ConsumeGas(gas, "chaos fault")
+}
+
+// FailingFault returns the active fault failing the messages of a type URL,
+// if any and unless the kill switch is engaged.
+func (k Keeper) FailingFault(ctx sdk.Context, msgTypeURL string) (types.Fault, bool) {
+	if k.IsKillSwitchEngaged(ctx) {
+		return types.Fault{}, false
+	}
+
+	for _, fault := range k.GetActiveFaults(ctx) {
+		if fault.Kind == types.KindFailMsg && fault.MsgTypeUrl == msgTypeURL {
+			return fault, true
+		}
+	}
+
+	return types.Fault{}, false
+}
+
+// PruneFaults deletes the faults whose window is over at the current height.
+func (k Keeper) PruneFaults(ctx sdk.Context) {
+	for _, fault := range k.GetAllFaults(ctx) {
+		if fault.EndHeight <= ctx.BlockHeight() {
+			k.RemoveFault(ctx, fault.Id)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos-builders/chaos/x/chaos/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// ActiveFaults implements the Query/ActiveFaults gRPC method
+func (k Keeper) ActiveFaults(goCtx context.Context, req *types.QueryActiveFaultsRequest) (*types.QueryActiveFaultsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryActiveFaultsResponse{Faults: k.GetActiveFaults(ctx)}, nil
+}
+
+// UpcomingFaults implements the Query/UpcomingFaults gRPC method
+func (k Keeper) UpcomingFaults(goCtx context.Context, req *types.QueryUpcomingFaultsRequest) (*types.QueryUpcomingFaultsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryUpcomingFaultsResponse{Faults: k.GetUpcomingFaults(ctx)}, nil
+}
+
+// KillSwitch implements the Query/KillSwitch gRPC method
+func (k Keeper) KillSwitch(goCtx context.Context, req *types.QueryKillSwitchRequest) (*types.QueryKillSwitchResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryKillSwitchResponse{Engaged: k.IsKillSwitchEngaged(ctx)}, nil
+}
+
+// Params implements the Query/Params gRPC method
+func (k Keeper) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
@@ -0,0 +1,184 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos-builders/chaos/x/chaos/types"
+)
+
+// Keeper is the chaos keeper. It stores the faults scheduled through
+// governance, the kill switch and the tx fault rules.
+type Keeper struct {
+	cdc        codec.BinaryCodec
+	storeKey   storetypes.StoreKey
+	paramSpace paramtypes.Subspace
+
+	msgRouter types.MessageRouter
+}
+
+// NewKeeper creates a new chaos Keeper instance. The message router checks
+// the type URL of the messages failed by a fault.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	key storetypes.StoreKey,
+	paramSpace paramtypes.Subspace,
+	msgRouter types.MessageRouter,
+) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:        cdc,
+		storeKey:   key,
+		paramSpace: paramSpace,
+		msgRouter:  msgRouter,
+	}
+}
+
+// Logger returns the application logger, scoped to the associated module
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// SetFault stores a scheduled fault.
+func (k Keeper) SetFault(ctx sdk.Context, fault types.Fault) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.FaultKey(fault.Id), k.cdc.MustMarshal(&fault))
+}
+
+// GetFault returns a scheduled fault.
+func (k Keeper) GetFault(ctx sdk.Context, id uint64) (types.Fault, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.FaultKey(id))
+	if bz == nil {
+		return types.Fault{}, false
+	}
+
+	var fault types.Fault
+	k.cdc.MustUnmarshal(bz, &fault)
+
+	return fault, true
+}
+
+// RemoveFault deletes a scheduled fault.
+func (k Keeper) RemoveFault(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.FaultKey(id))
+}
+
+// GetAllFaults returns every scheduled fault, by id.
+func (k Keeper) GetAllFaults(ctx sdk.Context) []types.Fault {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FaultKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var faults []types.Fault
+	for ; iterator.Valid(); iterator.Next() {
+		var fault types.Fault
+		k.cdc.MustUnmarshal(iterator.Value(), &fault)
+		faults = append(faults, fault)
+	}
+
+	return faults
+}
+
+// GetActiveFaults returns the scheduled faults whose window includes the
+// current height, by id, whether or not the kill switch is engaged.
+func (k Keeper) GetActiveFaults(ctx sdk.Context) []types.Fault {
+	var faults []types.Fault
+	for _, fault := range k.GetAllFaults(ctx) {
+		if fault.IsActive(ctx.BlockHeight()) {
+			faults = append(faults, fault)
+		}
+	}
+
+	return faults
+}
+
+// GetUpcomingFaults returns the scheduled faults whose window starts after
+// the current height, by id.
+func (k Keeper) GetUpcomingFaults(ctx sdk.Context) []types.Fault {
+	var faults []types.Fault
+	for _, fault := range k.GetAllFaults(ctx) {
+		if fault.StartHeight > ctx.BlockHeight() {
+			faults = append(faults, fault)
+		}
+	}
+
+	return faults
+}
+
+// GetNextFaultID returns the id of the next scheduled fault.
+func (k Keeper) GetNextFaultID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextFaultIDKey)
+	if bz == nil {
+		return 1
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextFaultID sets the id of the next scheduled fault.
+func (k Keeper) SetNextFaultID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextFaultIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// IsKillSwitchEngaged returns true if the kill switch is engaged.
+func (k Keeper) IsKillSwitchEngaged(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KillSwitchKey)
+}
+
+// SetKillSwitch engages or releases the kill switch.
+func (k Keeper) SetKillSwitch(ctx sdk.Context, engaged bool) {
+	store := ctx.KVStore(k.storeKey)
+	if engaged {
+		store.Set(types.KillSwitchKey, []byte{1})
+	} else {
+		store.Delete(types.KillSwitchKey)
+	}
+}
+
+// GetParams returns the chaos parameters
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	var params types.Params
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// HasParams returns true if the chaos parameters are set, i.e. once the chaos
+// genesis is initialized.
+func (k Keeper) HasParams(ctx sdk.Context) bool {
+	return k.paramSpace.Has(ctx, types.KeyTxFaultsEnabled)
+}
+
+// SetParams sets the chaos parameters
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// InitGenesis initializes the chaos state from a genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
+	for _, fault := range gs.Faults {
+		k.SetFault(ctx, fault)
+	}
+	k.SetNextFaultID(ctx, gs.NextFaultId)
+	k.SetKillSwitch(ctx, gs.KillSwitch)
+	k.SetParams(ctx, gs.Params)
+}
+
+// ExportGenesis exports the chaos state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetAllFaults(ctx), k.GetNextFaultID(ctx), k.IsKillSwitchEngaged(ctx), k.GetParams(ctx))
+}
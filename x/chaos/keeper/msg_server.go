@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos-builders/chaos/x/chaos/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl creates and returns a new types.MsgServer, fulfilling the chaos Msg service interface
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// EngageKillSwitch implements the Msg/EngageKillSwitch interface. Only the
+// guardian of the parameters engages the kill switch, which is released
+// through governance.
+func (k msgServer) EngageKillSwitch(goCtx context.Context, msg *types.MsgEngageKillSwitch) (*types.MsgEngageKillSwitchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	guardian := k.GetParams(ctx).Guardian
+	if guardian == "" || guardian != msg.Guardian {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the chaos guardian", msg.Guardian)
+	}
+
+	k.SwitchKillSwitch(ctx, true)
+
+	return &types.MsgEngageKillSwitchResponse{}, nil
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos-builders/chaos/x/chaos/types"
+)
+
+// HandleScheduleFaultProposal handles a ScheduleFaultProposal
+func (k Keeper) HandleScheduleFaultProposal(ctx sdk.Context, p *types.ScheduleFaultProposal) error {
+	_, err := k.ScheduleFault(ctx, p.Fault)
+	return err
+}
+
+// HandleCancelFaultProposal handles a CancelFaultProposal
+func (k Keeper) HandleCancelFaultProposal(ctx sdk.Context, p *types.CancelFaultProposal) error {
+	return k.CancelFault(ctx, p.FaultId)
+}
+
+// HandleKillSwitchProposal handles a KillSwitchProposal
+func (k Keeper) HandleKillSwitchProposal(ctx sdk.Context, p *types.KillSwitchProposal) error {
+	k.SwitchKillSwitch(ctx, p.Engage)
+	return nil
+}
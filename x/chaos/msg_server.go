@@ -0,0 +1,50 @@
+package chaos
+
+import (
+	"context"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos-builders/chaos/x/chaos/types"
+)
+
+var _ gogogrpc.Server = txFaultMsgServer{}
+
+// txFaultMsgServer registers the Msg services with handlers failing the
+// messages of the transactions rejected by the TxFaultDecorator.
+type txFaultMsgServer struct {
+	gogogrpc.Server
+}
+
+// NewTxFaultMsgServer wraps the Msg service router of the application so
+// that the messages of the transactions rejected by the TxFaultDecorator
+// fail. It is given to the module configurator in place of the router.
+func NewTxFaultMsgServer(msgServer gogogrpc.Server) gogogrpc.Server {
+	return txFaultMsgServer{Server: msgServer}
+}
+
+// RegisterService implements the gRPC Server.RegisterService method.
+func (s txFaultMsgServer) RegisterService(sd *grpc.ServiceDesc, ss interface{}) {
+	desc := *sd
+	desc.Methods = make([]grpc.MethodDesc, len(sd.Methods))
+	for i, method := range sd.Methods {
+		handler := method.Handler
+		method.Handler = func(srv interface{}, goCtx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+			// the router also calls the handler without an SDK context to
+			// resolve its request type
+			if ctx, ok := goCtx.Value(sdk.SdkContextKey).(sdk.Context); ok {
+				if err := types.TxRejection(ctx); err != nil {
+					return nil, err
+				}
+			}
+
+			return handler(srv, goCtx, dec, interceptor)
+		}
+		desc.Methods[i] = method
+	}
+
+	s.Server.RegisterService(&desc, ss)
+}
@@ -0,0 +1,27 @@
+package chaos
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/cosmos-builders/chaos/x/chaos/keeper"
+	"github.com/cosmos-builders/chaos/x/chaos/types"
+)
+
+// NewChaosProposalHandler defines the chaos proposal handler
+func NewChaosProposalHandler(k keeper.Keeper) govv1beta1.Handler {
+	return func(ctx sdk.Context, content govv1beta1.Content) error {
+		switch c := content.(type) {
+		case *types.ScheduleFaultProposal:
+			return k.HandleScheduleFaultProposal(ctx, c)
+		case *types.CancelFaultProposal:
+			return k.HandleCancelFaultProposal(ctx, c)
+		case *types.KillSwitchProposal:
+			return k.HandleKillSwitchProposal(ctx, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized chaos proposal content type: %T", c)
+		}
+	}
+}
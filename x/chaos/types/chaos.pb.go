@@ -0,0 +1,1360 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: chaos/chaos/chaos.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FaultKind enumerates the faults the chaos module runs on the blocks of a
+// schedule.
+type FaultKind int32
+
+const (
+	// FAULT_KIND_UNSPECIFIED is an invalid fault.
+	KindUnspecified FaultKind = 0
+	// FAULT_KIND_CONSUME_GAS consumes block gas in BeginBlock, leaving less gas
+	// to the transactions of the block.
+	KindConsumeGas FaultKind = 1
+	// FAULT_KIND_OVERSIZED_EVENTS emits an event with an oversized attribute.
+	KindOversizedEvents FaultKind = 2
+	// FAULT_KIND_FAIL_MSG fails the transactions delivering a message of a
+	// given type, other than the governance and chaos messages.
+	KindFailMsg FaultKind = 3
+	// FAULT_KIND_SLEEP sleeps to stretch the block time.
+	KindSleep FaultKind = 4
+)
+
+var FaultKind_name = map[int32]string{
+	0: "FAULT_KIND_UNSPECIFIED",
+	1: "FAULT_KIND_CONSUME_GAS",
+	2: "FAULT_KIND_OVERSIZED_EVENTS",
+	3: "FAULT_KIND_FAIL_MSG",
+	4: "FAULT_KIND_SLEEP",
+}
+
+var FaultKind_value = map[string]int32{
+	"FAULT_KIND_UNSPECIFIED":      0,
+	"FAULT_KIND_CONSUME_GAS":      1,
+	"FAULT_KIND_OVERSIZED_EVENTS": 2,
+	"FAULT_KIND_FAIL_MSG":         3,
+	"FAULT_KIND_SLEEP":            4,
+}
+
+func (x FaultKind) String() string {
+	return proto.EnumName(FaultKind_name, int32(x))
+}
+
+func (FaultKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9722941b67672d91, []int{0}
+}
+
+// BlockPhase is the phase of the block a fault runs in.
+type BlockPhase int32
+
+const (
+	// BLOCK_PHASE_BEGIN runs the fault in BeginBlock.
+	PhaseBeginBlock BlockPhase = 0
+	// BLOCK_PHASE_END runs the fault in EndBlock.
+	PhaseEndBlock BlockPhase = 1
+)
+
+var BlockPhase_name = map[int32]string{
+	0: "BLOCK_PHASE_BEGIN",
+	1: "BLOCK_PHASE_END",
+}
+
+var BlockPhase_value = map[string]int32{
+	"BLOCK_PHASE_BEGIN": 0,
+	"BLOCK_PHASE_END":   1,
+}
+
+func (x BlockPhase) String() string {
+	return proto.EnumName(BlockPhase_name, int32(x))
+}
+
+func (BlockPhase) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9722941b67672d91, []int{1}
+}
+
+// Fault is a fault run on every block of a window of heights.
+type Fault struct {
+	// id of the fault, set by the chain.
+	Id   uint64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind FaultKind `protobuf:"varint,2,opt,name=kind,proto3,enum=chaos.chaos.FaultKind" json:"kind,omitempty"`
+	// start_height is the first height of the window.
+	StartHeight int64 `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty" yaml:"start_height"`
+	// end_height is the last height of the window.
+	EndHeight int64 `protobuf:"varint,4,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty" yaml:"end_height"`
+	// phase is the phase of the block the fault runs in. Block gas is always
+	// consumed in BeginBlock and messages fail when delivered.
+	Phase BlockPhase `protobuf:"varint,5,opt,name=phase,proto3,enum=chaos.chaos.BlockPhase" json:"phase,omitempty"`
+	// gas is the block gas consumed. FAULT_KIND_CONSUME_GAS only.
+	Gas uint64 `protobuf:"varint,6,opt,name=gas,proto3" json:"gas,omitempty"`
+	// event_bytes is the size of the attribute of the emitted event.
+	// FAULT_KIND_OVERSIZED_EVENTS only.
+	EventBytes uint64 `protobuf:"varint,7,opt,name=event_bytes,json=eventBytes,proto3" json:"event_bytes,omitempty" yaml:"event_bytes"`
+	// msg_type_url is the type URL of the failing messages, e.g.
+	// /cosmos.bank.v1beta1.MsgSend. FAULT_KIND_FAIL_MSG only.
+	MsgTypeUrl string `protobuf:"bytes,8,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty" yaml:"msg_type_url"`
+	// sleep is the time slept. FAULT_KIND_SLEEP only.
+	Sleep time.Duration `protobuf:"bytes,9,opt,name=sleep,proto3,stdduration" json:"sleep"`
+}
+
+func (m *Fault) Reset()         { *m = Fault{} }
+func (m *Fault) String() string { return proto.CompactTextString(m) }
+func (*Fault) ProtoMessage()    {}
+func (*Fault) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9722941b67672d91, []int{0}
+}
+func (m *Fault) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Fault) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Fault.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Fault) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Fault.Merge(m, src)
+}
+func (m *Fault) XXX_Size() int {
+	return m.Size()
+}
+func (m *Fault) XXX_DiscardUnknown() {
+	xxx_messageInfo_Fault.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Fault proto.InternalMessageInfo
+
+func (m *Fault) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Fault) GetKind() FaultKind {
+	if m != nil {
+		return m.Kind
+	}
+	return KindUnspecified
+}
+
+func (m *Fault) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *Fault) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *Fault) GetPhase() BlockPhase {
+	if m != nil {
+		return m.Phase
+	}
+	return PhaseBeginBlock
+}
+
+func (m *Fault) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+func (m *Fault) GetEventBytes() uint64 {
+	if m != nil {
+		return m.EventBytes
+	}
+	return 0
+}
+
+func (m *Fault) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *Fault) GetSleep() time.Duration {
+	if m != nil {
+		return m.Sleep
+	}
+	return 0
+}
+
+// Params defines the set of chaos parameters.
+type Params struct {
+	// tx_faults_enabled enables the tx fault rules. No rule applies while it
+	// is disabled.
+	TxFaultsEnabled bool `protobuf:"varint,1,opt,name=tx_faults_enabled,json=txFaultsEnabled,proto3" json:"tx_faults_enabled,omitempty" yaml:"tx_faults_enabled"`
+	// tx_fault_rules are the faults of the transactions delivering a message,
+	// at most one per message type.
+	TxFaultRules []TxFaultRule `protobuf:"bytes,2,rep,name=tx_fault_rules,json=txFaultRules,proto3" json:"tx_fault_rules" yaml:"tx_fault_rules"`
+	// guardian is the address allowed to engage the kill switch with
+	// MsgEngageKillSwitch, without governance. Empty for no guardian.
+	Guardian string `protobuf:"bytes,3,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	// max_fault_window is the largest number of heights in the window of a
+	// scheduled fault.
+	MaxFaultWindow uint64 `protobuf:"varint,4,opt,name=max_fault_window,json=maxFaultWindow,proto3" json:"max_fault_window,omitempty" yaml:"max_fault_window"`
+	// block_gas_reserve is the block gas left to the transactions of a block by
+	// FAULT_KIND_CONSUME_GAS, so that the kill switch can still be engaged.
+	BlockGasReserve uint64 `protobuf:"varint,5,opt,name=block_gas_reserve,json=blockGasReserve,proto3" json:"block_gas_reserve,omitempty" yaml:"block_gas_reserve"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9722941b67672d91, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetTxFaultsEnabled() bool {
+	if m != nil {
+		return m.TxFaultsEnabled
+	}
+	return false
+}
+
+func (m *Params) GetTxFaultRules() []TxFaultRule {
+	if m != nil {
+		return m.TxFaultRules
+	}
+	return nil
+}
+
+func (m *Params) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
+func (m *Params) GetMaxFaultWindow() uint64 {
+	if m != nil {
+		return m.MaxFaultWindow
+	}
+	return 0
+}
+
+func (m *Params) GetBlockGasReserve() uint64 {
+	if m != nil {
+		return m.BlockGasReserve
+	}
+	return 0
+}
+
+// TxFaultRule is a fault of the transactions delivering a message of a given
+// type.
+type TxFaultRule struct {
+	// msg_type_url is the type URL of the message, e.g.
+	// /cosmos.bank.v1beta1.MsgSend.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty" yaml:"msg_type_url"`
+	// reject_rate is the fraction of the transactions rejected, in [0, 1].
+	RejectRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=reject_rate,json=rejectRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reject_rate" yaml:"reject_rate"`
+	// extra_gas is the gas charged for each message on top of its execution.
+	ExtraGas uint64 `protobuf:"varint,3,opt,name=extra_gas,json=extraGas,proto3" json:"extra_gas,omitempty" yaml:"extra_gas"`
+}
+
+func (m *TxFaultRule) Reset()         { *m = TxFaultRule{} }
+func (m *TxFaultRule) String() string { return proto.CompactTextString(m) }
+func (*TxFaultRule) ProtoMessage()    {}
+func (*TxFaultRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9722941b67672d91, []int{2}
+}
+func (m *TxFaultRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxFaultRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxFaultRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxFaultRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxFaultRule.Merge(m, src)
+}
+func (m *TxFaultRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxFaultRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxFaultRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxFaultRule proto.InternalMessageInfo
+
+func (m *TxFaultRule) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *TxFaultRule) GetExtraGas() uint64 {
+	if m != nil {
+		return m.ExtraGas
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("chaos.chaos.FaultKind", FaultKind_name, FaultKind_value)
+	proto.RegisterEnum("chaos.chaos.BlockPhase", BlockPhase_name, BlockPhase_value)
+	proto.RegisterType((*Fault)(nil), "chaos.chaos.Fault")
+	proto.RegisterType((*Params)(nil), "chaos.chaos.Params")
+	proto.RegisterType((*TxFaultRule)(nil), "chaos.chaos.TxFaultRule")
+}
+
+func init() { proto.RegisterFile("chaos/chaos/chaos.proto", fileDescriptor_9722941b67672d91) }
+
+var fileDescriptor_9722941b67672d91 = []byte{
+	// 910 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x41, 0x6f, 0xe2, 0x46,
+	0x14, 0xc6, 0x40, 0x52, 0x18, 0xb2, 0xe0, 0x4c, 0x76, 0x83, 0xeb, 0x6d, 0xb1, 0xe5, 0x4a, 0x2b,
+	0x14, 0x29, 0x46, 0x4d, 0x2b, 0xb5, 0xbb, 0xb7, 0x38, 0x18, 0x82, 0x92, 0x10, 0x64, 0xc2, 0x56,
+	0x5a, 0xa9, 0xb2, 0x06, 0x3c, 0x31, 0x6e, 0x8c, 0x8d, 0x3c, 0x76, 0x36, 0xe9, 0x2f, 0xa8, 0x38,
+	0xf5, 0xd8, 0x0b, 0xa7, 0xaa, 0xff, 0x65, 0x8f, 0x7b, 0xac, 0x7a, 0x70, 0xab, 0xe4, 0x1f, 0x70,
+	0xed, 0xa5, 0x9a, 0x31, 0x04, 0xa7, 0xb9, 0xec, 0xc5, 0x9e, 0xf7, 0xde, 0xf7, 0xcd, 0x9b, 0xf7,
+	0xbe, 0x79, 0x03, 0xaa, 0xa3, 0x31, 0xf2, 0x49, 0x23, 0xf5, 0x55, 0xa7, 0x81, 0x1f, 0xfa, 0xb0,
+	0x94, 0x18, 0xec, 0x2b, 0x3e, 0xb7, 0x7d, 0xdb, 0x67, 0xfe, 0x06, 0x5d, 0x25, 0x10, 0xb1, 0x66,
+	0xfb, 0xbe, 0xed, 0xe2, 0x06, 0xb3, 0x86, 0xd1, 0x65, 0xc3, 0x8a, 0x02, 0x14, 0x3a, 0xbe, 0x97,
+	0xc4, 0x95, 0x3f, 0x72, 0x60, 0xa3, 0x85, 0x22, 0x37, 0x84, 0x65, 0x90, 0x75, 0x2c, 0x81, 0x93,
+	0xb9, 0x7a, 0xde, 0xc8, 0x3a, 0x16, 0xdc, 0x03, 0xf9, 0x2b, 0xc7, 0xb3, 0x84, 0xac, 0xcc, 0xd5,
+	0xcb, 0x07, 0xbb, 0x6a, 0x2a, 0x97, 0xca, 0x18, 0x27, 0x8e, 0x67, 0x19, 0x0c, 0x03, 0xdf, 0x80,
+	0x2d, 0x12, 0xa2, 0x20, 0x34, 0xc7, 0xd8, 0xb1, 0xc7, 0xa1, 0x90, 0x93, 0xb9, 0x7a, 0x4e, 0xab,
+	0x2e, 0x62, 0x69, 0xe7, 0x16, 0x4d, 0xdc, 0x37, 0x4a, 0x3a, 0xaa, 0x18, 0x25, 0x66, 0x1e, 0x33,
+	0x0b, 0x7e, 0x0b, 0x00, 0xf6, 0xac, 0x15, 0x33, 0xcf, 0x98, 0x2f, 0x16, 0xb1, 0xb4, 0x9d, 0x30,
+	0xd7, 0x31, 0xc5, 0x28, 0x62, 0xcf, 0x5a, 0xb2, 0xf6, 0xc1, 0xc6, 0x74, 0x8c, 0x08, 0x16, 0x36,
+	0xd8, 0xf1, 0xaa, 0x8f, 0x8e, 0xa7, 0xb9, 0xfe, 0xe8, 0xaa, 0x47, 0xc3, 0x46, 0x82, 0x82, 0x3c,
+	0xc8, 0xd9, 0x88, 0x08, 0x9b, 0xac, 0x3a, 0xba, 0x84, 0xdf, 0x81, 0x12, 0xbe, 0xc6, 0x5e, 0x68,
+	0x0e, 0x6f, 0x43, 0x4c, 0x84, 0xcf, 0x68, 0x44, 0xdb, 0x5d, 0xc4, 0x12, 0x5c, 0xe6, 0x5d, 0x07,
+	0x15, 0x03, 0x30, 0x4b, 0xa3, 0x06, 0x7c, 0x0d, 0xb6, 0x26, 0xc4, 0x36, 0xc3, 0xdb, 0x29, 0x36,
+	0xa3, 0xc0, 0x15, 0x0a, 0x32, 0x57, 0x2f, 0xa6, 0x6b, 0x4d, 0x47, 0x15, 0x03, 0x4c, 0x88, 0x7d,
+	0x71, 0x3b, 0xc5, 0x83, 0xc0, 0x85, 0xaf, 0xc1, 0x06, 0x71, 0x31, 0x9e, 0x0a, 0x45, 0x99, 0xab,
+	0x97, 0x0e, 0x3e, 0x57, 0x13, 0x71, 0xd4, 0x95, 0x38, 0x6a, 0x73, 0x29, 0x8e, 0x56, 0xf8, 0x10,
+	0x4b, 0x99, 0xdf, 0xfe, 0x96, 0x38, 0x23, 0x61, 0x28, 0x71, 0x16, 0x6c, 0xf6, 0x50, 0x80, 0x26,
+	0x04, 0x1e, 0x83, 0xed, 0xf0, 0xc6, 0xbc, 0xa4, 0x12, 0x10, 0x13, 0x7b, 0x68, 0xe8, 0xe2, 0x44,
+	0xb7, 0x82, 0xf6, 0xc5, 0x22, 0x96, 0x84, 0xe4, 0x14, 0x4f, 0x20, 0x8a, 0x51, 0x09, 0x6f, 0x98,
+	0x70, 0x44, 0x4f, 0x3c, 0xf0, 0x47, 0x50, 0x5e, 0xc1, 0xcc, 0x20, 0x72, 0x31, 0x11, 0xb2, 0x72,
+	0xae, 0x5e, 0x3a, 0x10, 0x1e, 0x75, 0xf3, 0x22, 0x61, 0x19, 0x91, 0x8b, 0xb5, 0x2f, 0xe9, 0xb9,
+	0x16, 0xb1, 0xf4, 0xe2, 0x71, 0x92, 0x84, 0xad, 0x18, 0x5b, 0xe1, 0x1a, 0x4b, 0xa0, 0x08, 0x0a,
+	0x76, 0x84, 0x02, 0xcb, 0x41, 0x1e, 0xbb, 0x11, 0x45, 0xe3, 0xc1, 0x86, 0x3a, 0xe0, 0x27, 0x68,
+	0xc5, 0x7e, 0xef, 0x78, 0x96, 0xff, 0x9e, 0x69, 0x9f, 0xd7, 0x5e, 0x2e, 0x62, 0xa9, 0xba, 0xec,
+	0xe4, 0xff, 0x10, 0x8a, 0x51, 0x9e, 0xa0, 0x24, 0xc3, 0x0f, 0xcc, 0x41, 0x7b, 0x31, 0xa4, 0x62,
+	0x9b, 0x36, 0x22, 0x66, 0x80, 0x09, 0x0e, 0xae, 0x93, 0x2b, 0x91, 0x4f, 0xf7, 0xe2, 0x09, 0x44,
+	0x31, 0x2a, 0xcc, 0xd7, 0x46, 0xc4, 0x58, 0x7a, 0xee, 0x38, 0x50, 0x4a, 0x55, 0xfa, 0x44, 0x66,
+	0xee, 0xd3, 0x65, 0xc6, 0xa0, 0x14, 0xe0, 0x9f, 0xf0, 0x28, 0x34, 0x03, 0x14, 0x62, 0x36, 0x40,
+	0x45, 0xad, 0x49, 0x3b, 0xf7, 0x57, 0x2c, 0xbd, 0xb2, 0x9d, 0x70, 0x1c, 0x0d, 0xd5, 0x91, 0x3f,
+	0x69, 0x8c, 0x7c, 0x32, 0xf1, 0xc9, 0xf2, 0xb7, 0x4f, 0xac, 0xab, 0x06, 0xdd, 0x90, 0xa8, 0x4d,
+	0x3c, 0x5a, 0x5f, 0xc4, 0xd4, 0x56, 0x8a, 0x01, 0x12, 0xcb, 0x40, 0x21, 0x86, 0x5f, 0x83, 0x22,
+	0xbe, 0x09, 0x03, 0x44, 0x0b, 0x63, 0xfd, 0xcd, 0x6b, 0xcf, 0x17, 0xb1, 0xc4, 0x27, 0xb4, 0x87,
+	0x90, 0x62, 0x14, 0xd8, 0xba, 0x8d, 0xc8, 0xde, 0xbf, 0x1c, 0x28, 0x3e, 0xcc, 0x2e, 0x6c, 0x80,
+	0xdd, 0xd6, 0xe1, 0xe0, 0xf4, 0xc2, 0x3c, 0xe9, 0x74, 0x9b, 0xe6, 0xa0, 0xdb, 0xef, 0xe9, 0x47,
+	0x9d, 0x56, 0x47, 0x6f, 0xf2, 0x19, 0x71, 0x67, 0x36, 0x97, 0x2b, 0x14, 0x35, 0xf0, 0xc8, 0x14,
+	0x8f, 0x9c, 0x4b, 0x07, 0x5b, 0x50, 0x7d, 0x44, 0x38, 0x3a, 0xef, 0xf6, 0x07, 0x67, 0xba, 0xd9,
+	0x3e, 0xec, 0xf3, 0x9c, 0x08, 0x67, 0x73, 0xb9, 0x4c, 0x09, 0x47, 0xbe, 0x47, 0xa2, 0x09, 0x6e,
+	0x23, 0x02, 0xbf, 0x07, 0x2f, 0x53, 0xf8, 0xf3, 0xb7, 0xba, 0xd1, 0xef, 0xbc, 0xd3, 0x9b, 0xa6,
+	0xfe, 0x56, 0xef, 0x5e, 0xf4, 0xf9, 0xac, 0x58, 0x9d, 0xcd, 0xe5, 0x1d, 0x4a, 0x3a, 0xbf, 0xc6,
+	0x01, 0x71, 0x7e, 0xc6, 0x96, 0x4e, 0x07, 0x8d, 0xc0, 0x3a, 0xd8, 0x49, 0x31, 0x5b, 0x87, 0x9d,
+	0x53, 0xf3, 0xac, 0xdf, 0xe6, 0x73, 0x62, 0x65, 0x36, 0x97, 0x4b, 0x94, 0xd1, 0x42, 0x8e, 0x7b,
+	0x46, 0x6c, 0xf8, 0x15, 0xe0, 0x53, 0xc8, 0xfe, 0xa9, 0xae, 0xf7, 0xf8, 0xbc, 0xf8, 0x6c, 0x36,
+	0x97, 0x8b, 0x14, 0xd6, 0xa7, 0xd3, 0x23, 0xe6, 0x7f, 0xf9, 0xbd, 0x96, 0xd9, 0xbb, 0x04, 0x60,
+	0xfd, 0x32, 0xc0, 0x3d, 0xb0, 0xad, 0x9d, 0x9e, 0x1f, 0x9d, 0x98, 0xbd, 0xe3, 0xc3, 0xbe, 0x6e,
+	0x6a, 0x7a, 0xbb, 0xd3, 0x5d, 0x15, 0xce, 0x10, 0x1a, 0xb6, 0x1d, 0x8f, 0x11, 0xe0, 0x2b, 0x50,
+	0x49, 0x63, 0xf5, 0x6e, 0x93, 0xe7, 0xc4, 0xed, 0xd9, 0x5c, 0x7e, 0xc6, 0x90, 0xba, 0x67, 0x31,
+	0x5c, 0x92, 0x47, 0x3b, 0xfe, 0x70, 0x57, 0xe3, 0x3e, 0xde, 0xd5, 0xb8, 0x7f, 0xee, 0x6a, 0xdc,
+	0xaf, 0xf7, 0xb5, 0xcc, 0xc7, 0xfb, 0x5a, 0xe6, 0xcf, 0xfb, 0x5a, 0xe6, 0x9d, 0xfa, 0x44, 0xfc,
+	0xfd, 0x61, 0xe4, 0xb8, 0x16, 0x0e, 0x56, 0xcf, 0xfb, 0xcd, 0xf2, 0xcf, 0x2e, 0xc2, 0x70, 0x93,
+	0xbd, 0x0c, 0xdf, 0xfc, 0x37, 0x00, 0x50, 0xa3, 0x73, 0x2f, 0x02, 0x06, 0x00, 0x00,
+}
+
+func (m *Fault) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Fault) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Fault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Sleep, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Sleep):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintChaos(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x4a
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintChaos(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.EventBytes != 0 {
+		i = encodeVarintChaos(dAtA, i, uint64(m.EventBytes))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Gas != 0 {
+		i = encodeVarintChaos(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Phase != 0 {
+		i = encodeVarintChaos(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintChaos(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintChaos(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Kind != 0 {
+		i = encodeVarintChaos(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintChaos(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockGasReserve != 0 {
+		i = encodeVarintChaos(dAtA, i, uint64(m.BlockGasReserve))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxFaultWindow != 0 {
+		i = encodeVarintChaos(dAtA, i, uint64(m.MaxFaultWindow))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintChaos(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TxFaultRules) > 0 {
+		for iNdEx := len(m.TxFaultRules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TxFaultRules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintChaos(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.TxFaultsEnabled {
+		i--
+		if m.TxFaultsEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxFaultRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxFaultRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxFaultRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExtraGas != 0 {
+		i = encodeVarintChaos(dAtA, i, uint64(m.ExtraGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.RejectRate.Size()
+		i -= size
+		if _, err := m.RejectRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintChaos(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintChaos(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintChaos(dAtA []byte, offset int, v uint64) int {
+	offset -= sovChaos(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Fault) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovChaos(uint64(m.Id))
+	}
+	if m.Kind != 0 {
+		n += 1 + sovChaos(uint64(m.Kind))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovChaos(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovChaos(uint64(m.EndHeight))
+	}
+	if m.Phase != 0 {
+		n += 1 + sovChaos(uint64(m.Phase))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovChaos(uint64(m.Gas))
+	}
+	if m.EventBytes != 0 {
+		n += 1 + sovChaos(uint64(m.EventBytes))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovChaos(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Sleep)
+	n += 1 + l + sovChaos(uint64(l))
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxFaultsEnabled {
+		n += 2
+	}
+	if len(m.TxFaultRules) > 0 {
+		for _, e := range m.TxFaultRules {
+			l = e.Size()
+			n += 1 + l + sovChaos(uint64(l))
+		}
+	}
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovChaos(uint64(l))
+	}
+	if m.MaxFaultWindow != 0 {
+		n += 1 + sovChaos(uint64(m.MaxFaultWindow))
+	}
+	if m.BlockGasReserve != 0 {
+		n += 1 + sovChaos(uint64(m.BlockGasReserve))
+	}
+	return n
+}
+
+func (m *TxFaultRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovChaos(uint64(l))
+	}
+	l = m.RejectRate.Size()
+	n += 1 + l + sovChaos(uint64(l))
+	if m.ExtraGas != 0 {
+		n += 1 + sovChaos(uint64(m.ExtraGas))
+	}
+	return n
+}
+
+func sovChaos(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozChaos(x uint64) (n int) {
+	return sovChaos(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Fault) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChaos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Fault: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Fault: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= FaultKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= BlockPhase(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventBytes", wireType)
+			}
+			m.EventBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChaos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sleep", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChaos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Sleep, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChaos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChaos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChaos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxFaultsEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TxFaultsEnabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxFaultRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChaos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxFaultRules = append(m.TxFaultRules, TxFaultRule{})
+			if err := m.TxFaultRules[len(m.TxFaultRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChaos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFaultWindow", wireType)
+			}
+			m.MaxFaultWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFaultWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockGasReserve", wireType)
+			}
+			m.BlockGasReserve = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockGasReserve |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChaos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChaos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxFaultRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChaos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxFaultRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxFaultRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChaos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChaos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RejectRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraGas", wireType)
+			}
+			m.ExtraGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtraGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChaos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChaos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipChaos(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowChaos
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowChaos
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowChaos
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthChaos
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupChaos
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthChaos
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthChaos        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowChaos          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupChaos = fmt.Errorf("proto: unexpected end of group")
+)
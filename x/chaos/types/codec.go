@@ -0,0 +1,45 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+var (
+	amino = codec.NewLegacyAmino()
+
+	// ModuleCdc references the global chaos module codec. Note, the codec
+	// should ONLY be used in certain instances of tests and for JSON encoding.
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	amino.Seal()
+}
+
+// RegisterLegacyAminoCodec registers the chaos types on the provided LegacyAmino codec.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&ScheduleFaultProposal{}, "chaos/ScheduleFaultProposal", nil)
+	cdc.RegisterConcrete(&CancelFaultProposal{}, "chaos/CancelFaultProposal", nil)
+	cdc.RegisterConcrete(&KillSwitchProposal{}, "chaos/KillSwitchProposal", nil)
+	cdc.RegisterConcrete(&MsgEngageKillSwitch{}, "chaos/MsgEngageKillSwitch", nil)
+}
+
+// RegisterInterfaces registers the chaos proposals as governance content
+// and the chaos messages with the interface registry
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*govv1beta1.Content)(nil),
+		&ScheduleFaultProposal{},
+		&CancelFaultProposal{},
+		&KillSwitchProposal{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgEngageKillSwitch{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// chaos sentinel errors
+var (
+	ErrInvalidFault       = sdkerrors.Register(ModuleName, 2, "invalid fault")
+	ErrFaultNotFound      = sdkerrors.Register(ModuleName, 3, "fault not found")
+	ErrKillSwitchEngaged  = sdkerrors.Register(ModuleName, 4, "kill switch engaged")
+	ErrInjectedFault      = sdkerrors.Register(ModuleName, 5, "injected fault")
+	ErrUnknownMessageType = sdkerrors.Register(ModuleName, 6, "unknown message type")
+	ErrTxRejected         = sdkerrors.Register(ModuleName, 7, "transaction rejected by tx fault rule")
+)
@@ -0,0 +1,20 @@
+package types
+
+// chaos events
+const (
+	EventTypeFault      = "chaos_fault"
+	EventTypeOversized  = "chaos_oversized"
+	EventTypeKillSwitch = "chaos_kill_switch"
+	EventTypeTxRejected = "chaos_tx_rejected"
+	EventTypeExtraGas   = "chaos_extra_gas"
+
+	AttributeKeyFault      = "fault"
+	AttributeKeyKind       = "kind"
+	AttributeKeyPayload    = "payload"
+	AttributeKeyEngaged    = "engaged"
+	AttributeKeyMsgTypeURL = "msg_type_url"
+	AttributeKeyMsgIndex   = "msg_index"
+	AttributeKeyRejectRate = "reject_rate"
+	AttributeKeyDraw       = "draw"
+	AttributeKeyGas        = "gas"
+)
@@ -0,0 +1,11 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+)
+
+// MessageRouter defines the expected message router, used to check the type
+// URL of the messages failed by a fault.
+type MessageRouter interface {
+	HandlerByTypeURL(typeURL string) baseapp.MsgServiceHandler
+}
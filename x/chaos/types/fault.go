@@ -0,0 +1,147 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// MaxEventBytes is the largest attribute of an oversized event.
+	MaxEventBytes = 16 << 20
+
+	// MaxSleep is the longest time a sleep fault stretches a block by.
+	MaxSleep = time.Minute
+)
+
+// kindNames are the names of the faults in the CLI commands.
+var kindNames = map[FaultKind]string{
+	KindConsumeGas:      "consume-gas",
+	KindOversizedEvents: "oversized-events",
+	KindFailMsg:         "fail-msg",
+	KindSleep:           "sleep",
+}
+
+// protectedMsgTypePrefixes are the prefixes of the type URLs of the
+// governance and chaos messages, which engage the kill switch and must not be
+// failed by a fault.
+var protectedMsgTypePrefixes = []string{"/cosmos.gov.", "/chaos.chaos."}
+
+// phaseNames are the names of the block phases in the CLI commands.
+var phaseNames = map[BlockPhase]string{
+	PhaseBeginBlock: "begin",
+	PhaseEndBlock:   "end",
+}
+
+// ParseFaultKind returns the fault of the given name, e.g. consume-gas.
+func ParseFaultKind(name string) (FaultKind, error) {
+	for kind, kindName := range kindNames {
+		if name == kindName {
+			return kind, nil
+		}
+	}
+
+	return KindUnspecified, fmt.Errorf("unknown fault %q", name)
+}
+
+// Name returns the name of the fault in the CLI commands.
+func (k FaultKind) Name() string {
+	if name, ok := kindNames[k]; ok {
+		return name
+	}
+
+	return k.String()
+}
+
+// ParseBlockPhase returns the block phase of the given name, begin or end.
+func ParseBlockPhase(name string) (BlockPhase, error) {
+	for phase, phaseName := range phaseNames {
+		if name == phaseName {
+			return phase, nil
+		}
+	}
+
+	return PhaseBeginBlock, fmt.Errorf("unknown block phase %q", name)
+}
+
+// Name returns the name of the block phase in the CLI commands.
+func (p BlockPhase) Name() string {
+	if name, ok := phaseNames[p]; ok {
+		return name
+	}
+
+	return p.String()
+}
+
+// Validate performs a stateless validation of the fault. The id is set by
+// the chain and is not checked. Each kind of fault only accepts its own
+// parameters.
+func (f Fault) Validate() error {
+	if _, ok := kindNames[f.Kind]; !ok {
+		return sdkerrors.Wrapf(ErrInvalidFault, "invalid fault %s", f.Kind)
+	}
+	if _, ok := phaseNames[f.Phase]; !ok {
+		return sdkerrors.Wrapf(ErrInvalidFault, "invalid block phase %s", f.Phase)
+	}
+
+	if f.StartHeight <= 0 {
+		return sdkerrors.Wrap(ErrInvalidFault, "start height must be positive")
+	}
+	if f.EndHeight < f.StartHeight {
+		return sdkerrors.Wrapf(ErrInvalidFault, "end height %d is before start height %d", f.EndHeight, f.StartHeight)
+	}
+
+	if (f.Kind == KindConsumeGas) != (f.Gas != 0) {
+		return sdkerrors.Wrapf(ErrInvalidFault, "gas is required by and only supported by the %s fault", KindConsumeGas.Name())
+	}
+	if (f.Kind == KindOversizedEvents) != (f.EventBytes != 0) {
+		return sdkerrors.Wrapf(ErrInvalidFault, "event bytes are required by and only supported by the %s fault", KindOversizedEvents.Name())
+	}
+	if (f.Kind == KindFailMsg) != (f.MsgTypeUrl != "") {
+		return sdkerrors.Wrapf(ErrInvalidFault, "message type URL is required by and only supported by the %s fault", KindFailMsg.Name())
+	}
+	if (f.Kind == KindSleep) != (f.Sleep != 0) {
+		return sdkerrors.Wrapf(ErrInvalidFault, "sleep is required by and only supported by the %s fault", KindSleep.Name())
+	}
+
+	// block gas is only consumed before the transactions of the block and
+	// messages fail when delivered
+	if (f.Kind == KindConsumeGas || f.Kind == KindFailMsg) && f.Phase != PhaseBeginBlock {
+		return sdkerrors.Wrapf(ErrInvalidFault, "block phase is not supported by the %s fault", f.Kind.Name())
+	}
+
+	if f.EventBytes > MaxEventBytes {
+		return sdkerrors.Wrapf(ErrInvalidFault, "event bytes %d exceed %d", f.EventBytes, MaxEventBytes)
+	}
+	if f.MsgTypeUrl != "" && !strings.HasPrefix(f.MsgTypeUrl, "/") {
+		return sdkerrors.Wrapf(ErrInvalidFault, "message type URL %q must start with /", f.MsgTypeUrl)
+	}
+	if IsProtectedMsgType(f.MsgTypeUrl) {
+		return sdkerrors.Wrapf(ErrInvalidFault, "message type %s engages the kill switch and cannot be failed", f.MsgTypeUrl)
+	}
+	if f.Sleep < 0 || f.Sleep > MaxSleep {
+		return sdkerrors.Wrapf(ErrInvalidFault, "sleep %s is not in (0, %s]", f.Sleep, MaxSleep)
+	}
+
+	return nil
+}
+
+// IsActive returns true if the window of the fault includes the height.
+func (f Fault) IsActive(height int64) bool {
+	return f.StartHeight <= height && height <= f.EndHeight
+}
+
+// IsProtectedMsgType returns true if the messages of a type URL are
+// governance or chaos messages, which the faults cannot fail so that the kill
+// switch can always be engaged.
+func IsProtectedMsgType(msgTypeURL string) bool {
+	for _, prefix := range protectedMsgTypePrefixes {
+		if strings.HasPrefix(msgTypeURL, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"fmt"
+)
+
+// DefaultGenesis returns the default chaos genesis state, which schedules no
+// fault.
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState(nil, 1, false, DefaultParams())
+}
+
+// NewGenesisState creates a new chaos GenesisState
+func NewGenesisState(faults []Fault, nextFaultID uint64, killSwitch bool, params Params) *GenesisState {
+	return &GenesisState{
+		Faults:      faults,
+		NextFaultId: nextFaultID,
+		KillSwitch:  killSwitch,
+		Params:      params,
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if gs.NextFaultId == 0 {
+		return fmt.Errorf("next fault id must be positive")
+	}
+
+	ids := make(map[uint64]bool)
+	for _, fault := range gs.Faults {
+		if fault.Id == 0 || fault.Id >= gs.NextFaultId {
+			return fmt.Errorf("fault id %d is not in [1, %d)", fault.Id, gs.NextFaultId)
+		}
+		if ids[fault.Id] {
+			return fmt.Errorf("duplicate fault %d", fault.Id)
+		}
+		ids[fault.Id] = true
+
+		if err := fault.Validate(); err != nil {
+			return fmt.Errorf("fault %d: %w", fault.Id, err)
+		}
+	}
+
+	return gs.Params.Validate()
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the chaos module name
+	ModuleName = "chaos"
+
+	// StoreKey is the store key string for the chaos module
+	StoreKey = ModuleName
+
+	// RouterKey is the proposal route for the chaos module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the chaos module
+	QuerierRoute = ModuleName
+)
+
+var (
+	// FaultKeyPrefix is the prefix of the scheduled fault store
+	FaultKeyPrefix = []byte{0x01}
+
+	// NextFaultIDKey is the key of the id of the next scheduled fault
+	NextFaultIDKey = []byte{0x02}
+
+	// KillSwitchKey is the key of the kill switch, which is stored only while
+	// engaged
+	KillSwitchKey = []byte{0x03}
+)
+
+// FaultKey returns the store key of a scheduled fault.
+func FaultKey(id uint64) []byte {
+	return append(FaultKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+// TypeMsgEngageKillSwitch is the type of MsgEngageKillSwitch
+const TypeMsgEngageKillSwitch = "engage_kill_switch"
+
+var (
+	_ sdk.Msg            = &MsgEngageKillSwitch{}
+	_ legacytx.LegacyMsg = &MsgEngageKillSwitch{}
+)
+
+// NewMsgEngageKillSwitch creates a new MsgEngageKillSwitch instance
+func NewMsgEngageKillSwitch(guardian string) *MsgEngageKillSwitch {
+	return &MsgEngageKillSwitch{
+		Guardian: guardian,
+	}
+}
+
+// Route implements legacytx.LegacyMsg
+func (msg MsgEngageKillSwitch) Route() string { return RouterKey }
+
+// Type implements legacytx.LegacyMsg
+func (msg MsgEngageKillSwitch) Type() string { return TypeMsgEngageKillSwitch }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgEngageKillSwitch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Guardian); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "failed to parse guardian address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements legacytx.LegacyMsg
+func (msg MsgEngageKillSwitch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgEngageKillSwitch) GetSigners() []sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(msg.Guardian)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{accAddr}
+}
@@ -0,0 +1,184 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var (
+	// KeyTxFaultsEnabled is the store key for the TxFaultsEnabled param
+	KeyTxFaultsEnabled = []byte("TxFaultsEnabled")
+	// KeyTxFaultRules is the store key for the TxFaultRules param
+	KeyTxFaultRules = []byte("TxFaultRules")
+	// KeyGuardian is the store key for the Guardian param
+	KeyGuardian = []byte("Guardian")
+	// KeyMaxFaultWindow is the store key for the MaxFaultWindow param
+	KeyMaxFaultWindow = []byte("MaxFaultWindow")
+	// KeyBlockGasReserve is the store key for the BlockGasReserve param
+	KeyBlockGasReserve = []byte("BlockGasReserve")
+)
+
+const (
+	// DefaultMaxFaultWindow is the default largest window of a fault, about
+	// a week of 6 second blocks.
+	DefaultMaxFaultWindow uint64 = 100_000
+
+	// DefaultBlockGasReserve is the default block gas left to the
+	// transactions by the consume-gas faults, enough for the transactions
+	// engaging the kill switch.
+	DefaultBlockGasReserve uint64 = 1_000_000
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable returns the param key table for the chaos module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new chaos Params instance, without guardian and with
+// the default fault limits.
+func NewParams(txFaultsEnabled bool, txFaultRules []TxFaultRule) Params {
+	return Params{
+		TxFaultsEnabled: txFaultsEnabled,
+		TxFaultRules:    txFaultRules,
+		MaxFaultWindow:  DefaultMaxFaultWindow,
+		BlockGasReserve: DefaultBlockGasReserve,
+	}
+}
+
+// DefaultParams returns the default chaos parameters, which disable the tx
+// faults.
+func DefaultParams() Params {
+	return NewParams(false, []TxFaultRule{})
+}
+
+// Validate validates all chaos parameters
+func (p Params) Validate() error {
+	if err := validateEnabled(p.TxFaultsEnabled); err != nil {
+		return err
+	}
+	if err := validateTxFaultRules(p.TxFaultRules); err != nil {
+		return err
+	}
+	if err := validateGuardian(p.Guardian); err != nil {
+		return err
+	}
+	if err := validateMaxFaultWindow(p.MaxFaultWindow); err != nil {
+		return err
+	}
+
+	return validateBlockGasReserve(p.BlockGasReserve)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyTxFaultsEnabled, &p.TxFaultsEnabled, validateEnabled),
+		paramtypes.NewParamSetPair(KeyTxFaultRules, &p.TxFaultRules, validateTxFaultRules),
+		paramtypes.NewParamSetPair(KeyGuardian, &p.Guardian, validateGuardian),
+		paramtypes.NewParamSetPair(KeyMaxFaultWindow, &p.MaxFaultWindow, validateMaxFaultWindow),
+		paramtypes.NewParamSetPair(KeyBlockGasReserve, &p.BlockGasReserve, validateBlockGasReserve),
+	}
+}
+
+// TxFaultRule returns the tx fault rule of a message type, if any.
+func (p Params) TxFaultRule(msgTypeURL string) (TxFaultRule, bool) {
+	for _, rule := range p.TxFaultRules {
+		if rule.MsgTypeUrl == msgTypeURL {
+			return rule, true
+		}
+	}
+
+	return TxFaultRule{}, false
+}
+
+// Validate performs a stateless validation of the rule.
+func (r TxFaultRule) Validate() error {
+	if !strings.HasPrefix(r.MsgTypeUrl, "/") {
+		return fmt.Errorf("message type URL %q must start with /", r.MsgTypeUrl)
+	}
+	if IsProtectedMsgType(r.MsgTypeUrl) {
+		return fmt.Errorf("message type %s engages the kill switch and cannot be faulted", r.MsgTypeUrl)
+	}
+	if r.RejectRate.IsNil() || r.RejectRate.IsNegative() || r.RejectRate.GT(sdk.OneDec()) {
+		return fmt.Errorf("reject rate of %s is not in [0, 1]", r.MsgTypeUrl)
+	}
+	if r.RejectRate.IsZero() && r.ExtraGas == 0 {
+		return fmt.Errorf("rule of %s neither rejects transactions nor charges extra gas", r.MsgTypeUrl)
+	}
+
+	return nil
+}
+
+func validateEnabled(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateTxFaultRules(i interface{}) error {
+	rules, ok := i.([]TxFaultRule)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	msgTypeURLs := make(map[string]bool)
+	for _, rule := range rules {
+		if err := rule.Validate(); err != nil {
+			return err
+		}
+		if msgTypeURLs[rule.MsgTypeUrl] {
+			return fmt.Errorf("duplicate tx fault rule of %s", rule.MsgTypeUrl)
+		}
+		msgTypeURLs[rule.MsgTypeUrl] = true
+	}
+
+	return nil
+}
+
+func validateGuardian(i interface{}) error {
+	guardian, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if guardian != "" {
+		if _, err := sdk.AccAddressFromBech32(guardian); err != nil {
+			return fmt.Errorf("invalid guardian address: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func validateMaxFaultWindow(i interface{}) error {
+	window, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if window == 0 {
+		return fmt.Errorf("max fault window must be positive")
+	}
+
+	return nil
+}
+
+func validateBlockGasReserve(i interface{}) error {
+	reserve, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if reserve == 0 {
+		return fmt.Errorf("block gas reserve must be positive")
+	}
+
+	return nil
+}
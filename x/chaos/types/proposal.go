@@ -0,0 +1,153 @@
+package types
+
+import (
+	"fmt"
+
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+const (
+	// ProposalTypeScheduleFault defines the type for a ScheduleFaultProposal
+	ProposalTypeScheduleFault = "ScheduleChaosFault"
+	// ProposalTypeCancelFault defines the type for a CancelFaultProposal
+	ProposalTypeCancelFault = "CancelChaosFault"
+	// ProposalTypeKillSwitch defines the type for a KillSwitchProposal
+	ProposalTypeKillSwitch = "ChaosKillSwitch"
+)
+
+var (
+	_ govv1beta1.Content = &ScheduleFaultProposal{}
+	_ govv1beta1.Content = &CancelFaultProposal{}
+	_ govv1beta1.Content = &KillSwitchProposal{}
+)
+
+func init() {
+	govv1beta1.RegisterProposalType(ProposalTypeScheduleFault)
+	govv1beta1.RegisterProposalType(ProposalTypeCancelFault)
+	govv1beta1.RegisterProposalType(ProposalTypeKillSwitch)
+}
+
+// NewScheduleFaultProposal creates a new ScheduleFaultProposal.
+func NewScheduleFaultProposal(title, description string, fault Fault) govv1beta1.Content {
+	return &ScheduleFaultProposal{
+		Title:       title,
+		Description: description,
+		Fault:       fault,
+	}
+}
+
+// GetTitle returns the title of a schedule fault proposal.
+func (p *ScheduleFaultProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a schedule fault proposal.
+func (p *ScheduleFaultProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a schedule fault proposal.
+func (p *ScheduleFaultProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a schedule fault proposal.
+func (p *ScheduleFaultProposal) ProposalType() string { return ProposalTypeScheduleFault }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *ScheduleFaultProposal) ValidateBasic() error {
+	if err := govv1beta1.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	return p.Fault.Validate()
+}
+
+// String implements the Stringer interface.
+func (p ScheduleFaultProposal) String() string {
+	return fmt.Sprintf(`Schedule Chaos Fault Proposal:
+  Title:        %s
+  Description:  %s
+  Fault:        %s
+  Start Height: %d
+  End Height:   %d
+  Phase:        %s
+  Gas:          %d
+  Event Bytes:  %d
+  Msg Type URL: %s
+  Sleep:        %s
+`, p.Title, p.Description, p.Fault.Kind.Name(), p.Fault.StartHeight, p.Fault.EndHeight, p.Fault.Phase.Name(),
+		p.Fault.Gas, p.Fault.EventBytes, p.Fault.MsgTypeUrl, p.Fault.Sleep)
+}
+
+// NewCancelFaultProposal creates a new CancelFaultProposal.
+func NewCancelFaultProposal(title, description string, faultID uint64) govv1beta1.Content {
+	return &CancelFaultProposal{
+		Title:       title,
+		Description: description,
+		FaultId:     faultID,
+	}
+}
+
+// GetTitle returns the title of a cancel fault proposal.
+func (p *CancelFaultProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a cancel fault proposal.
+func (p *CancelFaultProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a cancel fault proposal.
+func (p *CancelFaultProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a cancel fault proposal.
+func (p *CancelFaultProposal) ProposalType() string { return ProposalTypeCancelFault }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *CancelFaultProposal) ValidateBasic() error {
+	if err := govv1beta1.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if p.FaultId == 0 {
+		return ErrFaultNotFound
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p CancelFaultProposal) String() string {
+	return fmt.Sprintf(`Cancel Chaos Fault Proposal:
+  Title:       %s
+  Description: %s
+  Fault:       %d
+`, p.Title, p.Description, p.FaultId)
+}
+
+// NewKillSwitchProposal creates a new KillSwitchProposal.
+func NewKillSwitchProposal(title, description string, engage bool) govv1beta1.Content {
+	return &KillSwitchProposal{
+		Title:       title,
+		Description: description,
+		Engage:      engage,
+	}
+}
+
+// GetTitle returns the title of a kill switch proposal.
+func (p *KillSwitchProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a kill switch proposal.
+func (p *KillSwitchProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a kill switch proposal.
+func (p *KillSwitchProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a kill switch proposal.
+func (p *KillSwitchProposal) ProposalType() string { return ProposalTypeKillSwitch }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *KillSwitchProposal) ValidateBasic() error {
+	return govv1beta1.ValidateAbstract(p)
+}
+
+// String implements the Stringer interface.
+func (p KillSwitchProposal) String() string {
+	return fmt.Sprintf(`Chaos Kill Switch Proposal:
+  Title:       %s
+  Description: %s
+  Engage:      %t
+`, p.Title, p.Description, p.Engage)
+}
@@ -0,0 +1,1550 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: chaos/chaos/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryActiveFaultsRequest is the request type for the Query/ActiveFaults RPC.
+type QueryActiveFaultsRequest struct {
+}
+
+func (m *QueryActiveFaultsRequest) Reset()         { *m = QueryActiveFaultsRequest{} }
+func (m *QueryActiveFaultsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryActiveFaultsRequest) ProtoMessage()    {}
+func (*QueryActiveFaultsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bebf2dc920b2bb, []int{0}
+}
+func (m *QueryActiveFaultsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActiveFaultsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActiveFaultsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryActiveFaultsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActiveFaultsRequest.Merge(m, src)
+}
+func (m *QueryActiveFaultsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActiveFaultsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActiveFaultsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryActiveFaultsRequest proto.InternalMessageInfo
+
+// QueryActiveFaultsResponse is the response type for the Query/ActiveFaults
+// RPC.
+type QueryActiveFaultsResponse struct {
+	Faults []Fault `protobuf:"bytes,1,rep,name=faults,proto3" json:"faults"`
+}
+
+func (m *QueryActiveFaultsResponse) Reset()         { *m = QueryActiveFaultsResponse{} }
+func (m *QueryActiveFaultsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryActiveFaultsResponse) ProtoMessage()    {}
+func (*QueryActiveFaultsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bebf2dc920b2bb, []int{1}
+}
+func (m *QueryActiveFaultsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActiveFaultsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActiveFaultsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryActiveFaultsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActiveFaultsResponse.Merge(m, src)
+}
+func (m *QueryActiveFaultsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActiveFaultsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActiveFaultsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryActiveFaultsResponse proto.InternalMessageInfo
+
+func (m *QueryActiveFaultsResponse) GetFaults() []Fault {
+	if m != nil {
+		return m.Faults
+	}
+	return nil
+}
+
+// QueryUpcomingFaultsRequest is the request type for the Query/UpcomingFaults
+// RPC.
+type QueryUpcomingFaultsRequest struct {
+}
+
+func (m *QueryUpcomingFaultsRequest) Reset()         { *m = QueryUpcomingFaultsRequest{} }
+func (m *QueryUpcomingFaultsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUpcomingFaultsRequest) ProtoMessage()    {}
+func (*QueryUpcomingFaultsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bebf2dc920b2bb, []int{2}
+}
+func (m *QueryUpcomingFaultsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpcomingFaultsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpcomingFaultsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpcomingFaultsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpcomingFaultsRequest.Merge(m, src)
+}
+func (m *QueryUpcomingFaultsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpcomingFaultsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpcomingFaultsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpcomingFaultsRequest proto.InternalMessageInfo
+
+// QueryUpcomingFaultsResponse is the response type for the
+// Query/UpcomingFaults RPC.
+type QueryUpcomingFaultsResponse struct {
+	Faults []Fault `protobuf:"bytes,1,rep,name=faults,proto3" json:"faults"`
+}
+
+func (m *QueryUpcomingFaultsResponse) Reset()         { *m = QueryUpcomingFaultsResponse{} }
+func (m *QueryUpcomingFaultsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUpcomingFaultsResponse) ProtoMessage()    {}
+func (*QueryUpcomingFaultsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bebf2dc920b2bb, []int{3}
+}
+func (m *QueryUpcomingFaultsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpcomingFaultsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpcomingFaultsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpcomingFaultsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpcomingFaultsResponse.Merge(m, src)
+}
+func (m *QueryUpcomingFaultsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpcomingFaultsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpcomingFaultsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpcomingFaultsResponse proto.InternalMessageInfo
+
+func (m *QueryUpcomingFaultsResponse) GetFaults() []Fault {
+	if m != nil {
+		return m.Faults
+	}
+	return nil
+}
+
+// QueryKillSwitchRequest is the request type for the Query/KillSwitch RPC.
+type QueryKillSwitchRequest struct {
+}
+
+func (m *QueryKillSwitchRequest) Reset()         { *m = QueryKillSwitchRequest{} }
+func (m *QueryKillSwitchRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryKillSwitchRequest) ProtoMessage()    {}
+func (*QueryKillSwitchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bebf2dc920b2bb, []int{4}
+}
+func (m *QueryKillSwitchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryKillSwitchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryKillSwitchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryKillSwitchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryKillSwitchRequest.Merge(m, src)
+}
+func (m *QueryKillSwitchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryKillSwitchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryKillSwitchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryKillSwitchRequest proto.InternalMessageInfo
+
+// QueryKillSwitchResponse is the response type for the Query/KillSwitch RPC.
+type QueryKillSwitchResponse struct {
+	Engaged bool `protobuf:"varint,1,opt,name=engaged,proto3" json:"engaged,omitempty"`
+}
+
+func (m *QueryKillSwitchResponse) Reset()         { *m = QueryKillSwitchResponse{} }
+func (m *QueryKillSwitchResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryKillSwitchResponse) ProtoMessage()    {}
+func (*QueryKillSwitchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bebf2dc920b2bb, []int{5}
+}
+func (m *QueryKillSwitchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryKillSwitchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryKillSwitchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryKillSwitchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryKillSwitchResponse.Merge(m, src)
+}
+func (m *QueryKillSwitchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryKillSwitchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryKillSwitchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryKillSwitchResponse proto.InternalMessageInfo
+
+func (m *QueryKillSwitchResponse) GetEngaged() bool {
+	if m != nil {
+		return m.Engaged
+	}
+	return false
+}
+
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bebf2dc920b2bb, []int{6}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bebf2dc920b2bb, []int{7}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func init() {
+	proto.RegisterType((*QueryActiveFaultsRequest)(nil), "chaos.chaos.QueryActiveFaultsRequest")
+	proto.RegisterType((*QueryActiveFaultsResponse)(nil), "chaos.chaos.QueryActiveFaultsResponse")
+	proto.RegisterType((*QueryUpcomingFaultsRequest)(nil), "chaos.chaos.QueryUpcomingFaultsRequest")
+	proto.RegisterType((*QueryUpcomingFaultsResponse)(nil), "chaos.chaos.QueryUpcomingFaultsResponse")
+	proto.RegisterType((*QueryKillSwitchRequest)(nil), "chaos.chaos.QueryKillSwitchRequest")
+	proto.RegisterType((*QueryKillSwitchResponse)(nil), "chaos.chaos.QueryKillSwitchResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "chaos.chaos.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "chaos.chaos.QueryParamsResponse")
+}
+
+func init() { proto.RegisterFile("chaos/chaos/query.proto", fileDescriptor_a3bebf2dc920b2bb) }
+
+var fileDescriptor_a3bebf2dc920b2bb = []byte{
+	// 471 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x1b, 0x06, 0x05, 0xbd, 0x22, 0x0e, 0xee, 0x60, 0xc1, 0xab, 0xb2, 0x2a, 0x0c, 0xe8,
+	0x85, 0x04, 0xba, 0x4f, 0xc0, 0x0e, 0x68, 0x12, 0x42, 0x40, 0x11, 0x17, 0x2e, 0x93, 0x9b, 0x99,
+	0xd4, 0x22, 0x8d, 0xb3, 0xd8, 0x01, 0x7a, 0x84, 0x1b, 0x37, 0x24, 0xbe, 0xd4, 0x8e, 0x93, 0xb8,
+	0x70, 0x42, 0xa8, 0xe5, 0x63, 0x70, 0x40, 0x7b, 0x76, 0xa0, 0x56, 0x3a, 0x2a, 0x71, 0x71, 0x92,
+	0xf7, 0xfe, 0xfe, 0xff, 0xfe, 0xf2, 0x73, 0x60, 0x2b, 0x99, 0x30, 0xa9, 0x62, 0xb3, 0x1e, 0x57,
+	0xbc, 0x9c, 0x45, 0x45, 0x29, 0xb5, 0x24, 0x1d, 0x2c, 0x45, 0xb8, 0xd2, 0xcd, 0x54, 0xa6, 0x12,
+	0xeb, 0xf1, 0xd9, 0x9b, 0x91, 0xd0, 0x5e, 0x2a, 0x65, 0x9a, 0xf1, 0x98, 0x15, 0x22, 0x66, 0x79,
+	0x2e, 0x35, 0xd3, 0x42, 0xe6, 0xca, 0x76, 0x1d, 0x67, 0x63, 0x86, 0x8d, 0x90, 0x82, 0xff, 0xfc,
+	0x0c, 0xf4, 0x30, 0xd1, 0xe2, 0x2d, 0x7f, 0xc4, 0xaa, 0x4c, 0xab, 0x11, 0x3f, 0xae, 0xb8, 0xd2,
+	0xe1, 0x13, 0xb8, 0xb9, 0xa2, 0xa7, 0x0a, 0x99, 0x2b, 0x4e, 0xee, 0x43, 0xfb, 0x35, 0x56, 0x7c,
+	0xaf, 0xbf, 0x31, 0xe8, 0x0c, 0x49, 0xb4, 0x94, 0x31, 0x42, 0xf1, 0xfe, 0xc5, 0x93, 0xef, 0x3b,
+	0xad, 0x91, 0xd5, 0x85, 0x3d, 0xa0, 0x68, 0xf7, 0xb2, 0x48, 0xe4, 0x54, 0xe4, 0xa9, 0x0b, 0x7b,
+	0x0a, 0xdb, 0x2b, 0xbb, 0xff, 0x8d, 0xf3, 0xe1, 0x06, 0x1a, 0x3e, 0x16, 0x59, 0xf6, 0xe2, 0x9d,
+	0xd0, 0xc9, 0xa4, 0x46, 0xed, 0xc1, 0x56, 0xa3, 0x63, 0x31, 0x3e, 0x5c, 0xe6, 0x79, 0xca, 0x52,
+	0x7e, 0xe4, 0x7b, 0x7d, 0x6f, 0x70, 0x65, 0x54, 0x7f, 0x86, 0x9b, 0x40, 0x70, 0xd3, 0x33, 0x56,
+	0xb2, 0xe9, 0x9f, 0xd4, 0x07, 0xd0, 0x75, 0xaa, 0xd6, 0xe6, 0x01, 0xb4, 0x0b, 0xac, 0xa0, 0x4b,
+	0x67, 0xd8, 0x75, 0xd2, 0x1a, 0x71, 0x1d, 0xd7, 0x08, 0x87, 0xbf, 0x36, 0xe0, 0x12, 0x5a, 0x91,
+	0x0f, 0x1e, 0x5c, 0x5d, 0x3e, 0x72, 0x72, 0xdb, 0xd9, 0x7d, 0xde, 0xb8, 0xe8, 0x9d, 0x75, 0x32,
+	0x13, 0x2e, 0x0c, 0x3f, 0x7e, 0xfd, 0xf9, 0xe5, 0x42, 0x8f, 0xd0, 0xe5, 0xeb, 0x10, 0x33, 0x94,
+	0x1e, 0x9a, 0xc3, 0x23, 0x9f, 0x3c, 0xb8, 0xe6, 0x4e, 0x82, 0xdc, 0x6d, 0xda, 0xaf, 0x9c, 0x24,
+	0x1d, 0xac, 0x17, 0xda, 0x24, 0xbb, 0x98, 0x24, 0x20, 0x3d, 0x27, 0x49, 0x65, 0xc5, 0x75, 0x96,
+	0x19, 0xc0, 0xdf, 0x49, 0x91, 0x5b, 0x4d, 0xf7, 0xc6, 0x84, 0xe9, 0xee, 0xbf, 0x45, 0x16, 0xdf,
+	0x47, 0x3c, 0x25, 0xbe, 0x83, 0x7f, 0x23, 0xb2, 0xec, 0x50, 0x19, 0xd8, 0x04, 0xda, 0x66, 0x58,
+	0x64, 0xa7, 0xe9, 0xe8, 0xdc, 0x04, 0xda, 0x3f, 0x5f, 0x60, 0x71, 0xdb, 0x88, 0xbb, 0x4e, 0xba,
+	0x0e, 0xce, 0x8c, 0x7f, 0xff, 0xe0, 0x64, 0x1e, 0x78, 0xa7, 0xf3, 0xc0, 0xfb, 0x31, 0x0f, 0xbc,
+	0xcf, 0x8b, 0xa0, 0x75, 0xba, 0x08, 0x5a, 0xdf, 0x16, 0x41, 0xeb, 0x55, 0x94, 0x0a, 0x3d, 0xa9,
+	0xc6, 0x51, 0x22, 0xa7, 0x71, 0x22, 0xd5, 0x54, 0xaa, 0x7b, 0xe3, 0x4a, 0x64, 0x47, 0xbc, 0xac,
+	0x2d, 0xde, 0xdb, 0xa7, 0x9e, 0x15, 0x5c, 0x8d, 0xdb, 0xf8, 0x63, 0xef, 0xfd, 0x1e, 0x00, 0x08,
+	0x28, 0x6f, 0x1a, 0x4d, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// ActiveFaults returns the faults whose window includes the current height.
+	ActiveFaults(ctx context.Context, in *QueryActiveFaultsRequest, opts ...grpc.CallOption) (*QueryActiveFaultsResponse, error)
+	// UpcomingFaults returns the faults whose window starts after the current
+	// height.
+	UpcomingFaults(ctx context.Context, in *QueryUpcomingFaultsRequest, opts ...grpc.CallOption) (*QueryUpcomingFaultsResponse, error)
+	// KillSwitch returns whether the kill switch is engaged.
+	KillSwitch(ctx context.Context, in *QueryKillSwitchRequest, opts ...grpc.CallOption) (*QueryKillSwitchResponse, error)
+	// Params returns the chaos parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) ActiveFaults(ctx context.Context, in *QueryActiveFaultsRequest, opts ...grpc.CallOption) (*QueryActiveFaultsResponse, error) {
+	out := new(QueryActiveFaultsResponse)
+	err := c.cc.Invoke(ctx, "/chaos.chaos.Query/ActiveFaults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UpcomingFaults(ctx context.Context, in *QueryUpcomingFaultsRequest, opts ...grpc.CallOption) (*QueryUpcomingFaultsResponse, error) {
+	out := new(QueryUpcomingFaultsResponse)
+	err := c.cc.Invoke(ctx, "/chaos.chaos.Query/UpcomingFaults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) KillSwitch(ctx context.Context, in *QueryKillSwitchRequest, opts ...grpc.CallOption) (*QueryKillSwitchResponse, error) {
+	out := new(QueryKillSwitchResponse)
+	err := c.cc.Invoke(ctx, "/chaos.chaos.Query/KillSwitch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/chaos.chaos.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// ActiveFaults returns the faults whose window includes the current height.
+	ActiveFaults(context.Context, *QueryActiveFaultsRequest) (*QueryActiveFaultsResponse, error)
+	// UpcomingFaults returns the faults whose window starts after the current
+	// height.
+	UpcomingFaults(context.Context, *QueryUpcomingFaultsRequest) (*QueryUpcomingFaultsResponse, error)
+	// KillSwitch returns whether the kill switch is engaged.
+	KillSwitch(context.Context, *QueryKillSwitchRequest) (*QueryKillSwitchResponse, error)
+	// Params returns the chaos parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) ActiveFaults(ctx context.Context, req *QueryActiveFaultsRequest) (*QueryActiveFaultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActiveFaults not implemented")
+}
+func (*UnimplementedQueryServer) UpcomingFaults(ctx context.Context, req *QueryUpcomingFaultsRequest) (*QueryUpcomingFaultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpcomingFaults not implemented")
+}
+func (*UnimplementedQueryServer) KillSwitch(ctx context.Context, req *QueryKillSwitchRequest) (*QueryKillSwitchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KillSwitch not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_ActiveFaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActiveFaultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ActiveFaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaos.chaos.Query/ActiveFaults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActiveFaults(ctx, req.(*QueryActiveFaultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UpcomingFaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUpcomingFaultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UpcomingFaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaos.chaos.Query/UpcomingFaults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UpcomingFaults(ctx, req.(*QueryUpcomingFaultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_KillSwitch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryKillSwitchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).KillSwitch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaos.chaos.Query/KillSwitch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).KillSwitch(ctx, req.(*QueryKillSwitchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaos.chaos.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "chaos.chaos.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ActiveFaults",
+			Handler:    _Query_ActiveFaults_Handler,
+		},
+		{
+			MethodName: "UpcomingFaults",
+			Handler:    _Query_UpcomingFaults_Handler,
+		},
+		{
+			MethodName: "KillSwitch",
+			Handler:    _Query_KillSwitch_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "chaos/chaos/query.proto",
+}
+
+func (m *QueryActiveFaultsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveFaultsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveFaultsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActiveFaultsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveFaultsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveFaultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Faults) > 0 {
+		for iNdEx := len(m.Faults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Faults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpcomingFaultsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpcomingFaultsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpcomingFaultsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpcomingFaultsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpcomingFaultsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpcomingFaultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Faults) > 0 {
+		for iNdEx := len(m.Faults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Faults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryKillSwitchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryKillSwitchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryKillSwitchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryKillSwitchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryKillSwitchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryKillSwitchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Engaged {
+		i--
+		if m.Engaged {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryActiveFaultsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryActiveFaultsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Faults) > 0 {
+		for _, e := range m.Faults {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryUpcomingFaultsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryUpcomingFaultsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Faults) > 0 {
+		for _, e := range m.Faults {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryKillSwitchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryKillSwitchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Engaged {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryActiveFaultsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActiveFaultsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActiveFaultsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActiveFaultsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActiveFaultsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActiveFaultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Faults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Faults = append(m.Faults, Fault{})
+			if err := m.Faults[len(m.Faults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUpcomingFaultsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpcomingFaultsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpcomingFaultsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUpcomingFaultsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpcomingFaultsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpcomingFaultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Faults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Faults = append(m.Faults, Fault{})
+			if err := m.Faults[len(m.Faults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryKillSwitchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryKillSwitchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryKillSwitchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryKillSwitchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryKillSwitchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryKillSwitchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Engaged", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Engaged = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
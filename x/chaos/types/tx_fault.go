@@ -0,0 +1,53 @@
+package types
+
+import (
+	"crypto/sha256"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+// drawPrecision is the number of decimals of a draw.
+const drawPrecision = 18
+
+// drawRange is the number of distinct draws, 10^drawPrecision.
+var drawRange = new(big.Int).Exp(big.NewInt(10), big.NewInt(drawPrecision), nil)
+
+type txRejectionKey struct{}
+
+// TxFaultSeed returns the seed of the draws of a delivered transaction. It
+// only depends on the block header and the transaction bytes so that every
+// validator draws the same values.
+func TxFaultSeed(header tmproto.Header, txBytes []byte) []byte {
+	hash := sha256.New()
+	hash.Write(header.LastBlockId.Hash)
+	hash.Write(header.AppHash)
+	hash.Write(sdk.Uint64ToBigEndian(uint64(header.Height)))
+	txHash := sha256.Sum256(txBytes)
+	hash.Write(txHash[:])
+
+	return hash.Sum(nil)
+}
+
+// TxFaultDraw returns the draw of the message at msgIndex in a transaction,
+// uniformly distributed in [0, 1). The message is rejected if its draw is
+// lower than the reject rate of its rule.
+func TxFaultDraw(seed []byte, msgIndex int) sdk.Dec {
+	bz := sha256.Sum256(append(append([]byte{}, seed...), sdk.Uint64ToBigEndian(uint64(msgIndex))...))
+	draw := new(big.Int).SetBytes(bz[:])
+	return sdk.NewDecFromBigIntWithPrec(draw.Mod(draw, drawRange), drawPrecision)
+}
+
+// WithTxRejection returns a context marking its transaction as rejected by a
+// tx fault rule with err.
+func WithTxRejection(ctx sdk.Context, err error) sdk.Context {
+	return ctx.WithValue(txRejectionKey{}, err)
+}
+
+// TxRejection returns the error of a transaction rejected by a tx fault rule,
+// if any.
+func TxRejection(ctx sdk.Context) error {
+	err, _ := ctx.Value(txRejectionKey{}).(error)
+	return err
+}
@@ -0,0 +1,126 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/cosmos-builders/chaos/x/ibcfault/types"
+)
+
+const (
+	flagMaxTriggers = "max-triggers"
+	flagMutatedAck  = "mutated-ack"
+)
+
+// NewCmdSubmitAddFaultRuleProposal implements a command handler for
+// submitting an add IBC fault rule proposal transaction.
+func NewCmdSubmitAddFaultRuleProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-ibc-fault-rule [port]/[channel]/[sequence]:[fault][:delay-blocks]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to inject a fault into the IBC packets received by the chain",
+		Long: "Submit a proposal to inject a fault into the IBC packets received by the chain along with an initial deposit.\n" +
+			"Port, channel and sequence may be the * wildcard. Faults are error-ack, hold-ack, mutate-ack and reject-recv,\n" +
+			"hold-ack takes the number of blocks acknowledgements are held for.",
+		Example: "add-ibc-fault-rule 'transfer/channel-0/*:hold-ack:10' --max-triggers 5",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			rule, err := types.ParseFaultRule(args[0])
+			if err != nil {
+				return err
+			}
+
+			if rule.MaxTriggers, err = cmd.Flags().GetUint64(flagMaxTriggers); err != nil {
+				return err
+			}
+			mutatedAck, err := cmd.Flags().GetString(flagMutatedAck)
+			if err != nil {
+				return err
+			}
+			rule.MutatedAck = []byte(mutatedAck)
+
+			return submitProposal(cmd, func(title, description string) govv1beta1.Content {
+				return types.NewAddFaultRuleProposal(title, description, rule)
+			})
+		},
+	}
+
+	cmd.Flags().Uint64(flagMaxTriggers, 0, "number of packets the rule applies to before it is deleted, 0 for no limit")
+	cmd.Flags().String(flagMutatedAck, "", "acknowledgement written by a mutate-ack rule, the acknowledgement is truncated if empty")
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitRemoveFaultRuleProposal implements a command handler for
+// submitting a remove IBC fault rule proposal transaction.
+func NewCmdSubmitRemoveFaultRuleProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-ibc-fault-rule [rule-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to stop injecting the fault of a rule",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ruleID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			return submitProposal(cmd, func(title, description string) govv1beta1.Content {
+				return types.NewRemoveFaultRuleProposal(title, description, ruleID)
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+func submitProposal(cmd *cobra.Command, newContent func(title, description string) govv1beta1.Content) error {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	title, err := cmd.Flags().GetString(govcli.FlagTitle) //nolint:staticcheck // need this till full govv1 conversion.
+	if err != nil {
+		return err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription) //nolint:staticcheck // need this till full govv1 conversion.
+	if err != nil {
+		return err
+	}
+
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return err
+	}
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return err
+	}
+
+	msg, err := govv1beta1.NewMsgSubmitProposal(newContent(title, description), deposit, clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+
+	if err = msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
+
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")             //nolint:staticcheck // need this till full govv1 conversion.
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal") //nolint:staticcheck // need this till full govv1 conversion.
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+}
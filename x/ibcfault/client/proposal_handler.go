@@ -0,0 +1,12 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/cosmos-builders/chaos/x/ibcfault/client/cli"
+)
+
+var (
+	AddFaultRuleProposalHandler    = govclient.NewProposalHandler(cli.NewCmdSubmitAddFaultRuleProposal)
+	RemoveFaultRuleProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitRemoveFaultRuleProposal)
+)
@@ -0,0 +1,236 @@
+package ibcfault
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v5/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v5/modules/core/exported"
+
+	"github.com/cosmos-builders/chaos/x/ibcfault/keeper"
+	"github.com/cosmos-builders/chaos/x/ibcfault/types"
+)
+
+var _ porttypes.Middleware = IBCMiddleware{}
+
+// IBCMiddleware implements the ICS26 callbacks for the fault injection
+// middleware. It wraps an IBC application and injects the fault of the first
+// rule matching each received packet. Packets matching no rule, and every
+// other callback, go through untouched.
+type IBCMiddleware struct {
+	app    porttypes.IBCModule
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the wrapped application
+// and the keeper
+func NewIBCMiddleware(app porttypes.IBCModule, k keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		app:    app,
+		keeper: k,
+	}
+}
+
+// OnChanOpenInit implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version)
+}
+
+// OnChanOpenTry implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, counterpartyVersion)
+}
+
+// OnChanOpenAck implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCModule interface
+func (im IBCMiddleware) OnChanCloseInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm implements the IBCModule interface
+func (im IBCMiddleware) OnChanCloseConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnRecvPacket implements the IBCModule interface. The fault of the rule
+// matching the packet is injected:
+//   - error-ack acknowledges the packet with an error without running the
+//     application
+//   - hold-ack runs the application and writes its successful
+//     acknowledgement after the delay of the rule
+//   - mutate-ack runs the application and changes the bytes of its
+//     acknowledgement
+//   - reject-recv fails the transaction receiving the packet
+//
+// Core IBC discards the state changes of an unsuccessful acknowledgement, so
+// the trigger count of a rule is only kept when the packet is acknowledged
+// successfully or the application does not run. The trigger count of a
+// reject-recv rule is discarded with the failed transaction, which is why
+// such rules cannot be limited to a number of packets.
+//
+// The middleware must sit below the fee middleware: on fee enabled channels,
+// the acknowledgements returned, changed or held here are those of the
+// application, wrapped into an incentivized acknowledgement by the fee
+// middleware, or by the fee keeper when a held acknowledgement is written.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	rule, found := im.keeper.MatchRule(ctx, packet)
+	if !found {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	switch rule.Fault {
+	case types.FaultErrorAck:
+		emitFaultEvent(ctx, rule, packet)
+		// the acknowledgement is reported successful so that core IBC keeps
+		// the trigger count of the rule, the application did not run
+		ack := channeltypes.NewErrorAcknowledgement(types.ErrInjectedFault)
+		return types.NewAcknowledgement(ack.Acknowledgement(), true)
+
+	case types.FaultHoldAck:
+		ack := im.app.OnRecvPacket(ctx, packet, relayer)
+		// asynchronous and error acknowledgements are not held: the former
+		// are written by the application, the state changes of the latter
+		// must be discarded
+		if ack == nil || !ack.Success() {
+			return ack
+		}
+
+		releaseHeight := im.keeper.HoldAcknowledgement(ctx, rule, packet, ack.Acknowledgement())
+		emitFaultEvent(ctx, rule, packet, sdk.NewAttribute(types.AttributeKeyReleaseHeight, strconv.FormatInt(releaseHeight, 10)))
+		return nil
+
+	case types.FaultMutateAck:
+		ack := im.app.OnRecvPacket(ctx, packet, relayer)
+		if ack == nil {
+			return nil
+		}
+
+		emitFaultEvent(ctx, rule, packet)
+		return types.NewAcknowledgement(rule.MutateAcknowledgement(ack.Acknowledgement()), ack.Success())
+
+	case types.FaultRejectRecv:
+		// the event and the trigger count of the rule are discarded along
+		// with the failed transaction, the fault is logged instead
+		im.keeper.Logger(ctx).Info(
+			"rejected received packet", "rule", rule.RuleAttribute(),
+			"port", packet.DestinationPort, "channel", packet.DestinationChannel, "sequence", packet.Sequence,
+		)
+		panic(sdkerrors.Wrapf(
+			types.ErrPacketRejected, "rule %s, packet %s/%s/%d",
+			rule.RuleAttribute(), packet.DestinationPort, packet.DestinationChannel, packet.Sequence,
+		))
+
+	default:
+		panic(fmt.Sprintf("unknown fault %s", rule.Fault))
+	}
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+}
+
+// OnTimeoutPacket implements the IBCModule interface
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	return im.app.OnTimeoutPacket(ctx, packet, relayer)
+}
+
+// SendPacket implements the ICS4Wrapper interface
+func (im IBCMiddleware) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet ibcexported.PacketI,
+) error {
+	return im.keeper.SendPacket(ctx, chanCap, packet)
+}
+
+// WriteAcknowledgement implements the ICS4Wrapper interface
+func (im IBCMiddleware) WriteAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet ibcexported.PacketI,
+	ack ibcexported.Acknowledgement,
+) error {
+	return im.keeper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// GetAppVersion implements the ICS4Wrapper interface
+func (im IBCMiddleware) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return im.keeper.GetAppVersion(ctx, portID, channelID)
+}
+
+// emitFaultEvent emits the event of a fault injected into a received packet.
+func emitFaultEvent(ctx sdk.Context, rule types.FaultRule, packet channeltypes.Packet, attributes ...sdk.Attribute) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeFault,
+		append([]sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyFault, rule.Fault.Name()),
+			sdk.NewAttribute(types.AttributeKeyRule, rule.RuleAttribute()),
+			sdk.NewAttribute(types.AttributeKeyPortID, packet.DestinationPort),
+			sdk.NewAttribute(types.AttributeKeyChannelID, packet.DestinationChannel),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+		}, attributes...)...,
+	))
+}
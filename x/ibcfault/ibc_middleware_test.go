@@ -0,0 +1,533 @@
+package ibcfault_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	ibcfeetypes "github.com/cosmos/ibc-go/v5/modules/apps/29-fee/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos-builders/chaos/app"
+	"github.com/cosmos-builders/chaos/x/ibcfault"
+	"github.com/cosmos-builders/chaos/x/ibcfault/types"
+	icqhosttypes "github.com/cosmos-builders/chaos/x/icqhost/types"
+)
+
+func chaosApp(chain *ibctesting.TestChain) *app.App {
+	return chain.App.(*app.App)
+}
+
+// appOptions are the app.toml options of an App.
+type appOptions map[string]interface{}
+
+// Get implements AppOptions
+func (opts appOptions) Get(key string) interface{} {
+	return opts[key]
+}
+
+// IBCFaultTestSuite injects faults into the transfers received by chain B
+// from chain A.
+type IBCFaultTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+}
+
+func TestIBCFaultTestSuite(t *testing.T) {
+	suite.Run(t, new(IBCFaultTestSuite))
+}
+
+func (suite *IBCFaultTestSuite) SetupTest() {
+	ibctesting.DefaultTestingAppInit = app.SetupTestingApp
+
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	suite.path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	suite.path.EndpointA.ChannelConfig.Version = ibctransfertypes.Version
+	suite.path.EndpointB.ChannelConfig.Version = ibctransfertypes.Version
+	suite.coordinator.Setup(suite.path)
+}
+
+// transfer sends amount of the bond denom from chain A to chain B and
+// returns the packet, which is not relayed.
+func (suite *IBCFaultTestSuite) transfer(amount int64) channeltypes.Packet {
+	msg := ibctransfertypes.NewMsgTransfer(
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+		sdk.NewInt64Coin(sdk.DefaultBondDenom, amount),
+		suite.chainA.SenderAccount.GetAddress().String(),
+		suite.chainB.SenderAccount.GetAddress().String(),
+		clienttypes.NewHeight(1, 110), 0,
+	)
+
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	return packet
+}
+
+// recvPacket relays packet to chain B and returns the result of the
+// transaction receiving it.
+func (suite *IBCFaultTestSuite) recvPacket(packet channeltypes.Packet) *sdk.Result {
+	suite.Require().NoError(suite.path.EndpointB.UpdateClient())
+	res, err := suite.path.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	return res
+}
+
+// acknowledgePacket relays the acknowledgement of packet to chain A.
+func (suite *IBCFaultTestSuite) acknowledgePacket(packet channeltypes.Packet, ack []byte) {
+	suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+	suite.Require().NoError(suite.path.EndpointA.AcknowledgePacket(packet, ack))
+}
+
+// faultEvents returns the fault events of a result. The events of a message
+// may be listed more than once in the result of its transaction.
+func faultEvents(res *sdk.Result) []sdk.Event {
+	var events []sdk.Event
+	for _, event := range res.GetEvents() {
+		if event.Type == types.EventTypeFault {
+			events = append(events, sdk.Event(event))
+		}
+	}
+
+	return events
+}
+
+func eventAttribute(event sdk.Event, key string) string {
+	for _, attr := range event.Attributes {
+		if string(attr.Key) == key {
+			return string(attr.Value)
+		}
+	}
+
+	return ""
+}
+
+func (suite *IBCFaultTestSuite) voucherBalance() sdk.Int {
+	voucherDenom := ibctransfertypes.ParseDenomTrace(
+		ibctransfertypes.GetPrefixedDenom(suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, sdk.DefaultBondDenom),
+	).IBCDenom()
+
+	return chaosApp(suite.chainB).BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), voucherDenom).Amount
+}
+
+// executeProposal runs a proposal through the ibcfault proposal handler of
+// chain B.
+func (suite *IBCFaultTestSuite) executeProposal(content govv1beta1.Content) error {
+	suite.Require().NoError(content.ValidateBasic())
+	suite.Require().Equal(types.RouterKey, content.ProposalRoute())
+
+	handler := ibcfault.NewIBCFaultProposalHandler(chaosApp(suite.chainB).IBCFaultKeeper)
+	if err := handler(suite.chainB.GetContext(), content); err != nil {
+		return err
+	}
+	suite.chainB.NextBlock()
+
+	return nil
+}
+
+// addRule adds a fault rule on the transfer channel of chain B and returns
+// its id.
+func (suite *IBCFaultTestSuite) addRule(rule types.FaultRule) uint64 {
+	rule.PortId = suite.path.EndpointB.ChannelConfig.PortID
+	rule.ChannelId = suite.path.EndpointB.ChannelID
+
+	id := chaosApp(suite.chainB).IBCFaultKeeper.GetNextRuleID(suite.chainB.GetContext())
+	suite.Require().NoError(suite.executeProposal(types.NewAddFaultRuleProposal("title", "description", rule)))
+
+	return id
+}
+
+func (suite *IBCFaultTestSuite) TestNoFault() {
+	faultApp := chaosApp(suite.chainB)
+	suite.Require().Equal(types.DefaultGenesis(), faultApp.IBCFaultKeeper.ExportGenesis(suite.chainB.GetContext()))
+	suite.Require().Empty(faultApp.IBCFaultKeeper.GetLocalRules())
+
+	packet := suite.transfer(100)
+	res := suite.recvPacket(packet)
+	suite.Require().Empty(faultEvents(res))
+
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().Equal(channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement(), ack)
+	suite.Require().Equal(sdk.NewInt(100), suite.voucherBalance())
+}
+
+func (suite *IBCFaultTestSuite) TestErrorAck() {
+	escrow := ibctransfertypes.GetEscrowAddress(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+	id := suite.addRule(types.FaultRule{Fault: types.FaultErrorAck, MaxTriggers: 1})
+
+	packet := suite.transfer(100)
+	res := suite.recvPacket(packet)
+
+	events := faultEvents(res)
+	suite.Require().NotEmpty(events)
+	suite.Require().Equal("error-ack", eventAttribute(events[0], types.AttributeKeyFault))
+	suite.Require().Equal(strconv.FormatUint(id, 10), eventAttribute(events[0], types.AttributeKeyRule))
+	suite.Require().Equal(strconv.FormatUint(packet.Sequence, 10), eventAttribute(events[0], types.AttributeKeySequence))
+
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().Equal(channeltypes.NewErrorAcknowledgement(types.ErrInjectedFault).Acknowledgement(), ack)
+	suite.Require().True(suite.voucherBalance().IsZero())
+
+	// chain A refunds the transfer
+	suite.acknowledgePacket(packet, ack)
+	suite.Require().True(chaosApp(suite.chainA).BankKeeper.GetBalance(suite.chainA.GetContext(), escrow, sdk.DefaultBondDenom).IsZero())
+
+	// the rule is deleted once exhausted
+	suite.Require().Empty(chaosApp(suite.chainB).IBCFaultKeeper.GetAllRules(suite.chainB.GetContext()))
+	packet = suite.transfer(100)
+	suite.Require().Empty(faultEvents(suite.recvPacket(packet)))
+	suite.Require().Equal(sdk.NewInt(100), suite.voucherBalance())
+}
+
+func (suite *IBCFaultTestSuite) TestHoldAck() {
+	faultApp := chaosApp(suite.chainB)
+	channelKeeper := faultApp.IBCKeeper.ChannelKeeper
+	suite.addRule(types.FaultRule{Fault: types.FaultHoldAck, DelayBlocks: 2})
+
+	packet := suite.transfer(100)
+	res := suite.recvPacket(packet)
+
+	events := faultEvents(res)
+	suite.Require().NotEmpty(events)
+	suite.Require().Equal("hold-ack", eventAttribute(events[0], types.AttributeKeyFault))
+	_, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().Error(err)
+
+	// the transfer is executed but its acknowledgement is held
+	suite.Require().Equal(sdk.NewInt(100), suite.voucherBalance())
+	heldAcks, err := faultApp.IBCFaultKeeper.HeldAcknowledgements(sdk.WrapSDKContext(suite.chainB.GetContext()), &types.QueryHeldAcknowledgementsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(heldAcks.HeldAcknowledgements, 1)
+	heldAck := heldAcks.HeldAcknowledgements[0]
+	suite.Require().Equal(packet, heldAck.Packet)
+	suite.Require().Equal(eventAttribute(events[0], types.AttributeKeyReleaseHeight), strconv.FormatInt(heldAck.ReleaseHeight, 10))
+
+	hasAck := func() bool {
+		return channelKeeper.HasPacketAcknowledgement(suite.chainB.GetContext(), packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+	}
+	for suite.chainB.GetContext().BlockHeight() <= heldAck.ReleaseHeight {
+		suite.Require().False(hasAck())
+		suite.chainB.NextBlock()
+	}
+	suite.Require().True(hasAck())
+	suite.Require().Empty(faultApp.IBCFaultKeeper.GetAllHeldAcknowledgements(suite.chainB.GetContext()))
+
+	ackCommitment, _ := channelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+	suite.Require().Equal(channeltypes.CommitAcknowledgement(heldAck.Acknowledgement), ackCommitment)
+	suite.acknowledgePacket(packet, heldAck.Acknowledgement)
+}
+
+func (suite *IBCFaultTestSuite) TestMutateAck() {
+	id := suite.addRule(types.FaultRule{Fault: types.FaultMutateAck, MutatedAck: []byte("mutated")})
+
+	res := suite.recvPacket(suite.transfer(100))
+	suite.Require().NotEmpty(faultEvents(res))
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().Equal([]byte("mutated"), ack)
+
+	// the state changes of the application are kept
+	suite.Require().Equal(sdk.NewInt(100), suite.voucherBalance())
+
+	// acknowledgements are truncated by default
+	suite.Require().NoError(suite.executeProposal(types.NewRemoveFaultRuleProposal("title", "description", id)))
+	suite.addRule(types.FaultRule{Fault: types.FaultMutateAck})
+
+	res = suite.recvPacket(suite.transfer(100))
+	ack, err = ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	successAck := channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()
+	suite.Require().Equal(successAck[:(len(successAck)+1)/2], ack)
+	suite.Require().Equal(sdk.NewInt(200), suite.voucherBalance())
+}
+
+func (suite *IBCFaultTestSuite) TestRejectRecv() {
+	faultApp := chaosApp(suite.chainB)
+	packet := suite.transfer(100)
+	id := suite.addRule(types.FaultRule{Sequence: packet.Sequence, Fault: types.FaultRejectRecv})
+
+	suite.Require().NoError(suite.path.EndpointB.UpdateClient())
+	proof, proofHeight := suite.chainA.QueryProof(host.PacketCommitmentKey(packet.SourcePort, packet.SourceChannel, packet.Sequence))
+	msg := channeltypes.NewMsgRecvPacket(packet, proof, proofHeight, suite.chainB.SenderAccount.GetAddress().String())
+
+	// the transaction receiving the packet fails, its state changes are
+	// discarded with the cache context
+	ctx, _ := suite.chainB.GetContext().CacheContext()
+	err := func() (err error) {
+		defer func() {
+			err, _ = recover().(error)
+		}()
+		_, _ = faultApp.IBCKeeper.RecvPacket(sdk.WrapSDKContext(ctx), msg)
+		return nil
+	}()
+	suite.Require().ErrorIs(err, types.ErrPacketRejected)
+
+	// the packet is received once the rule is removed
+	suite.Require().NoError(suite.executeProposal(types.NewRemoveFaultRuleProposal("title", "description", id)))
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+	suite.Require().Equal(sdk.NewInt(100), suite.voucherBalance())
+}
+
+// TestFeeEnabledChannel checks that the acknowledgements of the faults are
+// wrapped by the fee middleware on a fee enabled channel.
+func (suite *IBCFaultTestSuite) TestFeeEnabledChannel() {
+	feeVersion := string(ibcfeetypes.ModuleCdc.MustMarshalJSON(&ibcfeetypes.Metadata{
+		FeeVersion: ibcfeetypes.Version,
+		AppVersion: ibctransfertypes.Version,
+	}))
+	suite.path = ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	suite.path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	suite.path.EndpointA.ChannelConfig.Version = feeVersion
+	suite.path.EndpointB.ChannelConfig.Version = feeVersion
+	suite.coordinator.Setup(suite.path)
+
+	faultApp := chaosApp(suite.chainB)
+	suite.Require().True(faultApp.IBCFeeKeeper.IsFeeEnabled(suite.chainB.GetContext(), suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID))
+	escrow := ibctransfertypes.GetEscrowAddress(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+
+	// no counterparty payee is registered for the relayer
+	incentivizedAck := func(appAck []byte) []byte {
+		return ibcfeetypes.NewIncentivizedAcknowledgement("", appAck, true).Acknowledgement()
+	}
+
+	// error-ack
+	suite.addRule(types.FaultRule{Fault: types.FaultErrorAck, MaxTriggers: 1})
+	packet := suite.transfer(100)
+	res := suite.recvPacket(packet)
+	suite.Require().NotEmpty(faultEvents(res))
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().Equal(incentivizedAck(channeltypes.NewErrorAcknowledgement(types.ErrInjectedFault).Acknowledgement()), ack)
+	suite.Require().True(suite.voucherBalance().IsZero())
+
+	// chain A refunds the transfer
+	suite.acknowledgePacket(packet, ack)
+	suite.Require().True(chaosApp(suite.chainA).BankKeeper.GetBalance(suite.chainA.GetContext(), escrow, sdk.DefaultBondDenom).IsZero())
+	suite.Require().Empty(faultApp.IBCFaultKeeper.GetAllRules(suite.chainB.GetContext()))
+
+	// mutate-ack only changes the acknowledgement of the application
+	suite.addRule(types.FaultRule{Fault: types.FaultMutateAck, MutatedAck: []byte("mutated"), MaxTriggers: 1})
+	res = suite.recvPacket(suite.transfer(100))
+	suite.Require().NotEmpty(faultEvents(res))
+	ack, err = ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().Equal(incentivizedAck([]byte("mutated")), ack)
+	suite.Require().Equal(sdk.NewInt(100), suite.voucherBalance())
+
+	// hold-ack writes the acknowledgement through the fee middleware
+	suite.addRule(types.FaultRule{Fault: types.FaultHoldAck, DelayBlocks: 2, MaxTriggers: 1})
+	packet = suite.transfer(100)
+	res = suite.recvPacket(packet)
+	suite.Require().NotEmpty(faultEvents(res))
+	_, err = ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().Error(err)
+
+	heldAcks := faultApp.IBCFaultKeeper.GetAllHeldAcknowledgements(suite.chainB.GetContext())
+	suite.Require().Len(heldAcks, 1)
+	for suite.chainB.GetContext().BlockHeight() <= heldAcks[0].ReleaseHeight {
+		suite.chainB.NextBlock()
+	}
+	ack = incentivizedAck(heldAcks[0].Acknowledgement)
+	ackCommitment, found := faultApp.IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(
+		suite.chainB.GetContext(), packet.DestinationPort, packet.DestinationChannel, packet.Sequence,
+	)
+	suite.Require().True(found)
+	suite.Require().Equal(channeltypes.CommitAcknowledgement(ack), ackCommitment)
+	suite.acknowledgePacket(packet, ack)
+	suite.Require().Equal(sdk.NewInt(200), suite.voucherBalance())
+}
+
+func (suite *IBCFaultTestSuite) TestWrappedStacks() {
+	client, err := app.NewICQTestClient(suite.coordinator, suite.chainA, suite.chainB)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.executeProposal(types.NewAddFaultRuleProposal("title", "description", types.FaultRule{
+		PortId: icqhosttypes.PortID,
+		Fault:  types.FaultErrorAck,
+	})))
+
+	ack, err := client.SendQuery()
+	suite.Require().NoError(err)
+	suite.Require().Equal(channeltypes.NewErrorAcknowledgement(types.ErrInjectedFault), ack)
+
+	// the rule does not apply to the transfer port
+	suite.Require().Empty(faultEvents(suite.recvPacket(suite.transfer(100))))
+}
+
+func (suite *IBCFaultTestSuite) TestProposals() {
+	testCases := []struct {
+		name    string
+		content govv1beta1.Content
+		expErr  error
+	}{
+		{
+			"remove unknown rule",
+			types.NewRemoveFaultRuleProposal("title", "description", 5),
+			types.ErrRuleNotFound,
+		},
+		{
+			"add rule",
+			types.NewAddFaultRuleProposal("title", "description", types.FaultRule{Id: 7, Fault: types.FaultErrorAck, Triggers: 3}),
+			nil,
+		},
+		{
+			"add second rule",
+			types.NewAddFaultRuleProposal("title", "description", types.FaultRule{PortId: ibctesting.TransferPort, Fault: types.FaultHoldAck, DelayBlocks: 1}),
+			nil,
+		},
+		{
+			"remove rule",
+			types.NewRemoveFaultRuleProposal("title", "description", 1),
+			nil,
+		},
+		{
+			"remove removed rule",
+			types.NewRemoveFaultRuleProposal("title", "description", 1),
+			types.ErrRuleNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := suite.executeProposal(tc.content)
+		if tc.expErr != nil {
+			suite.Require().ErrorIs(err, tc.expErr, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+		}
+	}
+
+	res, err := chaosApp(suite.chainB).IBCFaultKeeper.Rules(sdk.WrapSDKContext(suite.chainB.GetContext()), &types.QueryRulesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.FaultRule{{Id: 2, PortId: ibctesting.TransferPort, Fault: types.FaultHoldAck, DelayBlocks: 1}}, res.Rules)
+	suite.Require().Empty(res.LocalRules)
+
+	for _, rule := range []types.FaultRule{
+		{},
+		{PortId: "x", Fault: types.FaultErrorAck},
+		{ChannelId: "invalid", Fault: types.FaultErrorAck},
+		{Fault: types.FaultHoldAck},
+		{Fault: types.FaultErrorAck, DelayBlocks: 1},
+		{Fault: types.FaultErrorAck, MutatedAck: []byte("ack")},
+		{Fault: types.FaultRejectRecv, MaxTriggers: 1},
+	} {
+		content := types.NewAddFaultRuleProposal("title", "description", rule)
+		suite.Require().ErrorIs(content.ValidateBasic(), types.ErrInvalidRule, rule.String())
+	}
+}
+
+func (suite *IBCFaultTestSuite) TestGenesis() {
+	faultApp := chaosApp(suite.chainB)
+	suite.addRule(types.FaultRule{Fault: types.FaultHoldAck, DelayBlocks: 100})
+	suite.addRule(types.FaultRule{Fault: types.FaultErrorAck, MaxTriggers: 2})
+	suite.recvPacket(suite.transfer(100))
+
+	genesis := faultApp.IBCFaultKeeper.ExportGenesis(suite.chainB.GetContext())
+	suite.Require().NoError(genesis.Validate())
+	suite.Require().Len(genesis.Rules, 2)
+	suite.Require().Equal(uint64(3), genesis.NextRuleId)
+	suite.Require().Equal(uint64(1), genesis.Rules[0].Triggers)
+	suite.Require().Len(genesis.HeldAcknowledgements, 1)
+
+	ctx := suite.chainA.GetContext()
+	chaosApp(suite.chainA).IBCFaultKeeper.InitGenesis(ctx, *genesis)
+	suite.Require().Equal(genesis, chaosApp(suite.chainA).IBCFaultKeeper.ExportGenesis(ctx))
+
+	invalid := *genesis
+	invalid.NextRuleId = 2
+	suite.Require().Error(invalid.Validate())
+	invalid = *genesis
+	invalid.HeldAcknowledgements = append(invalid.HeldAcknowledgements, invalid.HeldAcknowledgements[0])
+	suite.Require().Error(invalid.Validate())
+}
+
+func TestParseFaultRule(t *testing.T) {
+	testCases := []struct {
+		rule    string
+		expRule types.FaultRule
+		expPass bool
+	}{
+		{"transfer/channel-0/*:error-ack", types.FaultRule{PortId: "transfer", ChannelId: "channel-0", Fault: types.FaultErrorAck}, true},
+		{"icahost/*/*:hold-ack:10", types.FaultRule{PortId: "icahost", Fault: types.FaultHoldAck, DelayBlocks: 10}, true},
+		{"*/*/5:reject-recv", types.FaultRule{Sequence: 5, Fault: types.FaultRejectRecv}, true},
+		{"*/channel-3/*:mutate-ack", types.FaultRule{ChannelId: "channel-3", Fault: types.FaultMutateAck}, true},
+		{"transfer/channel-0/*", types.FaultRule{}, false},
+		{"transfer/channel-0:error-ack", types.FaultRule{}, false},
+		{"transfer/channel-0/*:drop", types.FaultRule{}, false},
+		{"transfer/channel-0/0:error-ack", types.FaultRule{}, false},
+		{"transfer/channel-0/*:hold-ack", types.FaultRule{}, false},
+		{"transfer/channel-0/*:error-ack:3", types.FaultRule{}, false},
+		{"transfer/channel-0/*:hold-ack:3:4", types.FaultRule{}, false},
+	}
+
+	for _, tc := range testCases {
+		rule, err := types.ParseFaultRule(tc.rule)
+		if !tc.expPass {
+			require.ErrorIs(t, err, types.ErrInvalidRule, tc.rule)
+			continue
+		}
+		require.NoError(t, err, tc.rule)
+		require.Equal(t, tc.expRule, rule, tc.rule)
+	}
+}
+
+func TestLocalRules(t *testing.T) {
+	newApp := func(rules []string) *app.App {
+		return app.New(
+			log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, app.DefaultNodeHome, 5,
+			app.MakeEncodingConfig(), appOptions{types.ConfigKeyRules: rules},
+		)
+	}
+
+	chaosApp := newApp([]string{"transfer/*/*:error-ack", "*/*/*:reject-recv"})
+	ctx := chaosApp.BaseApp.NewContext(true, tmproto.Header{})
+	res, err := chaosApp.IBCFaultKeeper.Rules(sdk.WrapSDKContext(ctx), &types.QueryRulesRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Rules)
+	require.Len(t, res.LocalRules, 2)
+
+	// local rules apply in order
+	for _, tc := range []struct {
+		port     string
+		expFault types.FaultType
+	}{
+		{"transfer", types.FaultErrorAck},
+		{"icahost", types.FaultRejectRecv},
+	} {
+		packet := channeltypes.NewPacket(nil, 1, "transfer", "channel-0", tc.port, "channel-1", clienttypes.ZeroHeight(), 1)
+		rule, found := chaosApp.IBCFaultKeeper.MatchRule(ctx, packet)
+		require.True(t, found, tc.port)
+		require.Equal(t, tc.expFault, rule.Fault, tc.port)
+		require.Equal(t, types.AttributeValueLocalRule, rule.RuleAttribute(), tc.port)
+	}
+
+	require.Panics(t, func() { newApp([]string{"transfer/*/*:drop"}) })
+}
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v5/modules/core/exported"
+
+	"github.com/cosmos-builders/chaos/x/ibcfault/types"
+)
+
+// AddRule stores a new fault rule set through governance and returns its id.
+func (k Keeper) AddRule(ctx sdk.Context, rule types.FaultRule) (uint64, error) {
+	rule.Id = k.GetNextRuleID(ctx)
+	rule.Triggers = 0
+	if err := rule.Validate(); err != nil {
+		return 0, err
+	}
+
+	k.SetRule(ctx, rule)
+	k.SetNextRuleID(ctx, rule.Id+1)
+
+	k.Logger(ctx).Info("added IBC fault rule", "id", rule.Id, "port", rule.PortId, "channel", rule.ChannelId, "sequence", rule.Sequence, "fault", rule.Fault.Name())
+
+	return rule.Id, nil
+}
+
+// DeleteRule deletes a fault rule set through governance. The
+// acknowledgements it holds are still released.
+func (k Keeper) DeleteRule(ctx sdk.Context, id uint64) error {
+	if _, found := k.GetRule(ctx, id); !found {
+		return sdkerrors.Wrapf(types.ErrRuleNotFound, "rule %d", id)
+	}
+
+	k.RemoveRule(ctx, id)
+
+	k.Logger(ctx).Info("removed IBC fault rule", "id", id)
+
+	return nil
+}
+
+// MatchRule returns the rule that applies to a received packet: the first
+// matching rule set through governance, by id, else the first matching rule
+// of the node-local configuration. The trigger count of a governance rule is
+// incremented and the rule is deleted once exhausted.
+func (k Keeper) MatchRule(ctx sdk.Context, packet ibcexported.PacketI) (types.FaultRule, bool) {
+	for _, rule := range k.GetAllRules(ctx) {
+		if !rule.Matches(packet) {
+			continue
+		}
+
+		rule.Triggers++
+		if rule.Exhausted() {
+			k.RemoveRule(ctx, rule.Id)
+		} else {
+			k.SetRule(ctx, rule)
+		}
+
+		return rule, true
+	}
+
+	for _, rule := range k.localRules {
+		if rule.Matches(packet) {
+			return rule, true
+		}
+	}
+
+	return types.FaultRule{}, false
+}
+
+// HoldAcknowledgement holds the acknowledgement of a packet for the delay of
+// rule. It is written by ReleaseAcknowledgements.
+func (k Keeper) HoldAcknowledgement(ctx sdk.Context, rule types.FaultRule, packet channeltypes.Packet, ack []byte) int64 {
+	heldAck := types.HeldAcknowledgement{
+		Packet:          packet,
+		Acknowledgement: ack,
+		ReleaseHeight:   ctx.BlockHeight() + int64(rule.DelayBlocks),
+		RuleId:          rule.Id,
+	}
+	k.SetHeldAcknowledgement(ctx, heldAck)
+
+	return heldAck.ReleaseHeight
+}
+
+// ReleaseAcknowledgements writes the held acknowledgements whose release
+// height is reached. An acknowledgement that cannot be written, e.g. because
+// its channel was closed in the meantime, is dropped.
+func (k Keeper) ReleaseAcknowledgements(ctx sdk.Context) {
+	for _, heldAck := range k.GetReleasedAcknowledgements(ctx) {
+		k.RemoveHeldAcknowledgement(ctx, heldAck)
+
+		packet := heldAck.Packet
+		attributes := []sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyRule, types.FaultRule{Id: heldAck.RuleId}.RuleAttribute()),
+			sdk.NewAttribute(types.AttributeKeyPortID, packet.DestinationPort),
+			sdk.NewAttribute(types.AttributeKeyChannelID, packet.DestinationChannel),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+		}
+
+		if err := k.writeAcknowledgement(ctx, heldAck); err != nil {
+			k.Logger(ctx).Error("dropped held acknowledgement", "port", packet.DestinationPort, "channel", packet.DestinationChannel, "sequence", packet.Sequence, "error", err)
+			attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyError, err.Error()))
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeReleaseAck, attributes...))
+	}
+}
+
+func (k Keeper) writeAcknowledgement(ctx sdk.Context, heldAck types.HeldAcknowledgement) error {
+	packet := heldAck.Packet
+	chanCap, found := k.scopedIBCKeeper.GetCapability(ctx, host.ChannelCapabilityPath(packet.DestinationPort, packet.DestinationChannel))
+	if !found {
+		return sdkerrors.Wrapf(types.ErrChannelCapNotFound, "%s/%s", packet.DestinationPort, packet.DestinationChannel)
+	}
+
+	cacheCtx, writeFn := ctx.CacheContext()
+	if err := k.ics4Wrapper.WriteAcknowledgement(cacheCtx, chanCap, packet, types.NewAcknowledgement(heldAck.Acknowledgement, true)); err != nil {
+		return err
+	}
+	writeFn()
+	// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return nil
+}
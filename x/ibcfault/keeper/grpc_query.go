@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos-builders/chaos/x/ibcfault/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Rules implements the Query/Rules gRPC method
+func (k Keeper) Rules(goCtx context.Context, req *types.QueryRulesRequest) (*types.QueryRulesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryRulesResponse{Rules: k.GetAllRules(ctx), LocalRules: k.GetLocalRules()}, nil
+}
+
+// HeldAcknowledgements implements the Query/HeldAcknowledgements gRPC method
+func (k Keeper) HeldAcknowledgements(goCtx context.Context, req *types.QueryHeldAcknowledgementsRequest) (*types.QueryHeldAcknowledgementsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryHeldAcknowledgementsResponse{HeldAcknowledgements: k.GetAllHeldAcknowledgements(ctx)}, nil
+}
@@ -0,0 +1,194 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	porttypes "github.com/cosmos/ibc-go/v5/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v5/modules/core/exported"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos-builders/chaos/x/ibcfault/types"
+)
+
+var _ porttypes.ICS4Wrapper = Keeper{}
+
+// Keeper is the ibcfault keeper. It stores the fault rules set through
+// governance and the acknowledgements held by them, and holds the rules of
+// the node-local configuration.
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
+
+	ics4Wrapper     porttypes.ICS4Wrapper
+	scopedIBCKeeper types.ScopedKeeper
+
+	localRules []types.FaultRule
+}
+
+// NewKeeper creates a new ibcfault Keeper instance. The scoped keeper of the
+// IBC module provides the channel capabilities required to write held
+// acknowledgements.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	key storetypes.StoreKey,
+	ics4Wrapper porttypes.ICS4Wrapper,
+	scopedIBCKeeper types.ScopedKeeper,
+	localRules []types.FaultRule,
+) Keeper {
+	return Keeper{
+		cdc:             cdc,
+		storeKey:        key,
+		ics4Wrapper:     ics4Wrapper,
+		scopedIBCKeeper: scopedIBCKeeper,
+		localRules:      localRules,
+	}
+}
+
+// Logger returns the application logger, scoped to the associated module
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// SendPacket implements the ICS4Wrapper interface
+func (k Keeper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI) error {
+	return k.ics4Wrapper.SendPacket(ctx, chanCap, packet)
+}
+
+// WriteAcknowledgement implements the ICS4Wrapper interface
+func (k Keeper) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI, ack ibcexported.Acknowledgement) error {
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// GetAppVersion implements the ICS4Wrapper interface
+func (k Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
+// GetLocalRules returns the rules of the node-local configuration.
+func (k Keeper) GetLocalRules() []types.FaultRule {
+	return k.localRules
+}
+
+// SetRule stores a fault rule.
+func (k Keeper) SetRule(ctx sdk.Context, rule types.FaultRule) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.FaultRuleKey(rule.Id), k.cdc.MustMarshal(&rule))
+}
+
+// GetRule returns a fault rule.
+func (k Keeper) GetRule(ctx sdk.Context, id uint64) (types.FaultRule, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.FaultRuleKey(id))
+	if bz == nil {
+		return types.FaultRule{}, false
+	}
+
+	var rule types.FaultRule
+	k.cdc.MustUnmarshal(bz, &rule)
+
+	return rule, true
+}
+
+// RemoveRule deletes a fault rule.
+func (k Keeper) RemoveRule(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.FaultRuleKey(id))
+}
+
+// GetAllRules returns every fault rule set through governance, by id.
+func (k Keeper) GetAllRules(ctx sdk.Context) []types.FaultRule {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FaultRuleKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var rules []types.FaultRule
+	for ; iterator.Valid(); iterator.Next() {
+		var rule types.FaultRule
+		k.cdc.MustUnmarshal(iterator.Value(), &rule)
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+// GetNextRuleID returns the id of the next fault rule.
+func (k Keeper) GetNextRuleID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextRuleIDKey)
+	if bz == nil {
+		return 1
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextRuleID sets the id of the next fault rule.
+func (k Keeper) SetNextRuleID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextRuleIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// SetHeldAcknowledgement stores a held acknowledgement.
+func (k Keeper) SetHeldAcknowledgement(ctx sdk.Context, ack types.HeldAcknowledgement) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(heldAcknowledgementKey(ack), k.cdc.MustMarshal(&ack))
+}
+
+// RemoveHeldAcknowledgement deletes a held acknowledgement.
+func (k Keeper) RemoveHeldAcknowledgement(ctx sdk.Context, ack types.HeldAcknowledgement) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(heldAcknowledgementKey(ack))
+}
+
+// GetAllHeldAcknowledgements returns every held acknowledgement, by release
+// height.
+func (k Keeper) GetAllHeldAcknowledgements(ctx sdk.Context) []types.HeldAcknowledgement {
+	return k.getHeldAcknowledgements(ctx, nil)
+}
+
+// GetReleasedAcknowledgements returns the held acknowledgements whose
+// release height is reached.
+func (k Keeper) GetReleasedAcknowledgements(ctx sdk.Context) []types.HeldAcknowledgement {
+	return k.getHeldAcknowledgements(ctx, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())+1))
+}
+
+func (k Keeper) getHeldAcknowledgements(ctx sdk.Context, end []byte) []types.HeldAcknowledgement {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.HeldAcknowledgementKeyPrefix)
+	iterator := store.Iterator(nil, end)
+	defer iterator.Close()
+
+	var acks []types.HeldAcknowledgement
+	for ; iterator.Valid(); iterator.Next() {
+		var ack types.HeldAcknowledgement
+		k.cdc.MustUnmarshal(iterator.Value(), &ack)
+		acks = append(acks, ack)
+	}
+
+	return acks
+}
+
+func heldAcknowledgementKey(ack types.HeldAcknowledgement) []byte {
+	return types.HeldAcknowledgementKey(ack.ReleaseHeight, ack.Packet.DestinationPort, ack.Packet.DestinationChannel, ack.Packet.Sequence)
+}
+
+// InitGenesis initializes the ibcfault state from a genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
+	for _, rule := range gs.Rules {
+		k.SetRule(ctx, rule)
+	}
+	k.SetNextRuleID(ctx, gs.NextRuleId)
+	for _, ack := range gs.HeldAcknowledgements {
+		k.SetHeldAcknowledgement(ctx, ack)
+	}
+}
+
+// ExportGenesis exports the ibcfault state. The rules of the node-local
+// configuration are not exported.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetAllRules(ctx), k.GetNextRuleID(ctx), k.GetAllHeldAcknowledgements(ctx))
+}
@@ -0,0 +1,18 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos-builders/chaos/x/ibcfault/types"
+)
+
+// HandleAddFaultRuleProposal handles an AddFaultRuleProposal
+func (k Keeper) HandleAddFaultRuleProposal(ctx sdk.Context, p *types.AddFaultRuleProposal) error {
+	_, err := k.AddRule(ctx, p.Rule)
+	return err
+}
+
+// HandleRemoveFaultRuleProposal handles a RemoveFaultRuleProposal
+func (k Keeper) HandleRemoveFaultRuleProposal(ctx sdk.Context, p *types.RemoveFaultRuleProposal) error {
+	return k.DeleteRule(ctx, p.RuleId)
+}
@@ -0,0 +1,25 @@
+package ibcfault
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/cosmos-builders/chaos/x/ibcfault/keeper"
+	"github.com/cosmos-builders/chaos/x/ibcfault/types"
+)
+
+// NewIBCFaultProposalHandler defines the ibcfault proposal handler
+func NewIBCFaultProposalHandler(k keeper.Keeper) govv1beta1.Handler {
+	return func(ctx sdk.Context, content govv1beta1.Content) error {
+		switch c := content.(type) {
+		case *types.AddFaultRuleProposal:
+			return k.HandleAddFaultRuleProposal(ctx, c)
+		case *types.RemoveFaultRuleProposal:
+			return k.HandleRemoveFaultRuleProposal(ctx, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized ibcfault proposal content type: %T", c)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	ibcexported "github.com/cosmos/ibc-go/v5/modules/core/exported"
+)
+
+var _ ibcexported.Acknowledgement = Acknowledgement{}
+
+// Acknowledgement is an acknowledgement whose bytes are written as is. It is
+// used for the acknowledgements changed or held by fault rules.
+type Acknowledgement struct {
+	bz      []byte
+	success bool
+}
+
+// NewAcknowledgement creates a new Acknowledgement. success tells core IBC
+// whether to commit the state changes of the OnRecvPacket callback.
+func NewAcknowledgement(bz []byte, success bool) Acknowledgement {
+	return Acknowledgement{
+		bz:      bz,
+		success: success,
+	}
+}
+
+// Success implements the Acknowledgement interface
+func (ack Acknowledgement) Success() bool {
+	return ack.success
+}
+
+// Acknowledgement implements the Acknowledgement interface
+func (ack Acknowledgement) Acknowledgement() []byte {
+	return ack.bz
+}
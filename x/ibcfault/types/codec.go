@@ -0,0 +1,35 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+var (
+	amino = codec.NewLegacyAmino()
+
+	// ModuleCdc references the global ibcfault module codec. Note, the codec
+	// should ONLY be used in certain instances of tests and for JSON encoding.
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	amino.Seal()
+}
+
+// RegisterLegacyAminoCodec registers the ibcfault types on the provided LegacyAmino codec.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&AddFaultRuleProposal{}, "ibcfault/AddFaultRuleProposal", nil)
+	cdc.RegisterConcrete(&RemoveFaultRuleProposal{}, "ibcfault/RemoveFaultRuleProposal", nil)
+}
+
+// RegisterInterfaces registers the ibcfault proposals as governance content
+// with the interface registry
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*govv1beta1.Content)(nil),
+		&AddFaultRuleProposal{},
+		&RemoveFaultRuleProposal{},
+	)
+}
@@ -0,0 +1,6 @@
+package types
+
+// ConfigKeyRules is the app.toml key of the fault rules of the node-local
+// configuration. Local rules change the state of the node they are set on,
+// so every node of the network must be configured with the same rules.
+const ConfigKeyRules = "ibc-fault.rules"
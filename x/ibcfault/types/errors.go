@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ibcfault sentinel errors
+var (
+	ErrInvalidRule        = sdkerrors.Register(ModuleName, 2, "invalid fault rule")
+	ErrRuleNotFound       = sdkerrors.Register(ModuleName, 3, "fault rule not found")
+	ErrInjectedFault      = sdkerrors.Register(ModuleName, 4, "injected fault")
+	ErrPacketRejected     = sdkerrors.Register(ModuleName, 5, "packet rejected by fault rule")
+	ErrInvalidHeldAck     = sdkerrors.Register(ModuleName, 6, "invalid held acknowledgement")
+	ErrChannelCapNotFound = sdkerrors.Register(ModuleName, 7, "channel capability not found")
+)
@@ -0,0 +1,19 @@
+package types
+
+// ibcfault events
+const (
+	EventTypeFault      = "ibc_fault"
+	EventTypeReleaseAck = "ibc_fault_release_ack"
+
+	AttributeKeyFault         = "fault"
+	AttributeKeyRule          = "rule"
+	AttributeKeyPortID        = "port_id"
+	AttributeKeyChannelID     = "channel_id"
+	AttributeKeySequence      = "sequence"
+	AttributeKeyReleaseHeight = "release_height"
+	AttributeKeyError         = "error"
+
+	// AttributeValueLocalRule is the rule attribute of the faults injected by
+	// the rules of the node-local configuration.
+	AttributeValueLocalRule = "local"
+)
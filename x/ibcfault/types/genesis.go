@@ -0,0 +1,75 @@
+package types
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DefaultGenesis returns the default ibcfault genesis state, which injects
+// no fault.
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState(nil, 1, nil)
+}
+
+// NewGenesisState creates a new ibcfault GenesisState
+func NewGenesisState(rules []FaultRule, nextRuleID uint64, heldAcks []HeldAcknowledgement) *GenesisState {
+	return &GenesisState{
+		Rules:                rules,
+		NextRuleId:           nextRuleID,
+		HeldAcknowledgements: heldAcks,
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if gs.NextRuleId == 0 {
+		return fmt.Errorf("next rule id must be positive")
+	}
+
+	ids := make(map[uint64]bool)
+	for _, rule := range gs.Rules {
+		if rule.Id == 0 || rule.Id >= gs.NextRuleId {
+			return fmt.Errorf("rule id %d is not in [1, %d)", rule.Id, gs.NextRuleId)
+		}
+		if ids[rule.Id] {
+			return fmt.Errorf("duplicate rule %d", rule.Id)
+		}
+		ids[rule.Id] = true
+
+		if err := rule.Validate(); err != nil {
+			return fmt.Errorf("rule %d: %w", rule.Id, err)
+		}
+	}
+
+	acks := make(map[string]bool)
+	for _, ack := range gs.HeldAcknowledgements {
+		if err := ack.Validate(); err != nil {
+			return err
+		}
+
+		key := string(HeldAcknowledgementKey(ack.ReleaseHeight, ack.Packet.DestinationPort, ack.Packet.DestinationChannel, ack.Packet.Sequence))
+		if acks[key] {
+			return fmt.Errorf("duplicate held acknowledgement %s/%s/%d", ack.Packet.DestinationPort, ack.Packet.DestinationChannel, ack.Packet.Sequence)
+		}
+		acks[key] = true
+	}
+
+	return nil
+}
+
+// Validate performs a stateless validation of the held acknowledgement.
+func (ack HeldAcknowledgement) Validate() error {
+	if err := ack.Packet.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidHeldAck, err.Error())
+	}
+	if len(ack.Acknowledgement) == 0 {
+		return sdkerrors.Wrap(ErrInvalidHeldAck, "acknowledgement cannot be empty")
+	}
+	if ack.ReleaseHeight <= 0 {
+		return sdkerrors.Wrap(ErrInvalidHeldAck, "release height must be positive")
+	}
+
+	return nil
+}
@@ -0,0 +1,434 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: chaos/ibcfault/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the ibcfault module's genesis state.
+type GenesisState struct {
+	Rules                []FaultRule           `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules"`
+	NextRuleId           uint64                `protobuf:"varint,2,opt,name=next_rule_id,json=nextRuleId,proto3" json:"next_rule_id,omitempty" yaml:"next_rule_id"`
+	HeldAcknowledgements []HeldAcknowledgement `protobuf:"bytes,3,rep,name=held_acknowledgements,json=heldAcknowledgements,proto3" json:"held_acknowledgements" yaml:"held_acknowledgements"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05efae0051d59dd0, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetRules() []FaultRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextRuleId() uint64 {
+	if m != nil {
+		return m.NextRuleId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetHeldAcknowledgements() []HeldAcknowledgement {
+	if m != nil {
+		return m.HeldAcknowledgements
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "chaos.ibcfault.GenesisState")
+}
+
+func init() { proto.RegisterFile("chaos/ibcfault/genesis.proto", fileDescriptor_05efae0051d59dd0) }
+
+var fileDescriptor_05efae0051d59dd0 = []byte{
+	// 310 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xbf, 0x4e, 0xf3, 0x30,
+	0x14, 0xc5, 0x93, 0xb6, 0xdf, 0x37, 0x98, 0x8a, 0x21, 0x14, 0x51, 0xaa, 0xe2, 0x56, 0x81, 0xa1,
+	0x0b, 0x89, 0x00, 0x31, 0xc0, 0x46, 0x06, 0xfe, 0x88, 0x2d, 0x6c, 0x2c, 0x55, 0x12, 0x5f, 0x92,
+	0x08, 0x27, 0xae, 0x62, 0x47, 0xb4, 0x0b, 0xe2, 0x11, 0x78, 0xac, 0x8e, 0x1d, 0x99, 0x22, 0x94,
+	0xbc, 0x41, 0x9f, 0x00, 0x39, 0x56, 0x05, 0x2d, 0x2c, 0xd6, 0xbd, 0xfa, 0x1d, 0x9f, 0x7b, 0x74,
+	0x50, 0x3f, 0x88, 0x3c, 0xc6, 0xed, 0xd8, 0x0f, 0x9e, 0xbc, 0x9c, 0x0a, 0x3b, 0x84, 0x14, 0x78,
+	0xcc, 0xad, 0x49, 0xc6, 0x04, 0x33, 0xb6, 0x6b, 0x6a, 0xad, 0x68, 0xaf, 0x13, 0xb2, 0x90, 0xd5,
+	0xc8, 0x96, 0x93, 0x52, 0xf5, 0x0e, 0x36, 0x3c, 0x56, 0x83, 0xc2, 0xe6, 0x5b, 0x03, 0xb5, 0x6f,
+	0x94, 0xed, 0x83, 0xf0, 0x04, 0x18, 0xe7, 0xe8, 0x5f, 0x96, 0x53, 0xe0, 0x5d, 0x7d, 0xd8, 0x1c,
+	0x6d, 0x9d, 0xee, 0x5b, 0xeb, 0x57, 0xac, 0x6b, 0xf9, 0xba, 0x39, 0x05, 0xa7, 0x35, 0x2f, 0x06,
+	0x9a, 0xab, 0xd4, 0xc6, 0x05, 0x6a, 0xa7, 0x30, 0x15, 0x63, 0xb9, 0x8d, 0x63, 0xd2, 0x6d, 0x0c,
+	0xf5, 0x51, 0xcb, 0xd9, 0x5b, 0x16, 0x83, 0x9d, 0x99, 0x97, 0xd0, 0x4b, 0xf3, 0x27, 0x35, 0x5d,
+	0x24, 0x57, 0xe9, 0x72, 0x47, 0x8c, 0x57, 0xb4, 0x1b, 0x01, 0x25, 0x63, 0x2f, 0x78, 0x4e, 0xd9,
+	0x0b, 0x05, 0x12, 0x42, 0x02, 0xa9, 0xe0, 0xdd, 0x66, 0x9d, 0xe0, 0x70, 0x33, 0xc1, 0x2d, 0x50,
+	0x72, 0xb5, 0xae, 0x75, 0x8e, 0x64, 0x96, 0x65, 0x31, 0xe8, 0xab, 0x63, 0x7f, 0xfa, 0x99, 0x6e,
+	0x27, 0xfa, 0xfd, 0x95, 0x3b, 0xf7, 0xf3, 0x12, 0xeb, 0x8b, 0x12, 0xeb, 0x9f, 0x25, 0xd6, 0xdf,
+	0x2b, 0xac, 0x2d, 0x2a, 0xac, 0x7d, 0x54, 0x58, 0x7b, 0x3c, 0x09, 0x63, 0x11, 0xe5, 0xbe, 0x15,
+	0xb0, 0xc4, 0x0e, 0x18, 0x4f, 0x18, 0x3f, 0xf6, 0xf3, 0x98, 0x12, 0xc8, 0xb8, 0xad, 0x6a, 0x9d,
+	0x7e, 0x17, 0x2b, 0x66, 0x13, 0xe0, 0xfe, 0xff, 0xba, 0xd6, 0xb3, 0xaf, 0x01, 0x00, 0x0e, 0xd7,
+	0xca, 0xc9, 0xbb, 0x01, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HeldAcknowledgements) > 0 {
+		for iNdEx := len(m.HeldAcknowledgements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HeldAcknowledgements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.NextRuleId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextRuleId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Rules) > 0 {
+		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextRuleId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextRuleId))
+	}
+	if len(m.HeldAcknowledgements) > 0 {
+		for _, e := range m.HeldAcknowledgements {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rules = append(m.Rules, FaultRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRuleId", wireType)
+			}
+			m.NextRuleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRuleId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeldAcknowledgements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeldAcknowledgements = append(m.HeldAcknowledgements, HeldAcknowledgement{})
+			if err := m.HeldAcknowledgements[len(m.HeldAcknowledgements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,1021 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: chaos/ibcfault/ibcfault.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FaultType enumerates the faults injected into the packets received by the
+// wrapped IBC applications.
+type FaultType int32
+
+const (
+	// FAULT_TYPE_UNSPECIFIED is an invalid fault.
+	FaultUnspecified FaultType = 0
+	// FAULT_TYPE_ERROR_ACK acknowledges the packet with an error without
+	// running the application.
+	FaultErrorAck FaultType = 1
+	// FAULT_TYPE_HOLD_ACK runs the application and holds its successful
+	// acknowledgement for a number of blocks before writing it.
+	FaultHoldAck FaultType = 2
+	// FAULT_TYPE_MUTATE_ACK runs the application and changes the bytes of its
+	// acknowledgement.
+	FaultMutateAck FaultType = 3
+	// FAULT_TYPE_REJECT_RECV fails the transaction receiving the packet, which
+	// stays unreceived.
+	FaultRejectRecv FaultType = 4
+)
+
+var FaultType_name = map[int32]string{
+	0: "FAULT_TYPE_UNSPECIFIED",
+	1: "FAULT_TYPE_ERROR_ACK",
+	2: "FAULT_TYPE_HOLD_ACK",
+	3: "FAULT_TYPE_MUTATE_ACK",
+	4: "FAULT_TYPE_REJECT_RECV",
+}
+
+var FaultType_value = map[string]int32{
+	"FAULT_TYPE_UNSPECIFIED": 0,
+	"FAULT_TYPE_ERROR_ACK":   1,
+	"FAULT_TYPE_HOLD_ACK":    2,
+	"FAULT_TYPE_MUTATE_ACK":  3,
+	"FAULT_TYPE_REJECT_RECV": 4,
+}
+
+func (x FaultType) String() string {
+	return proto.EnumName(FaultType_name, int32(x))
+}
+
+func (FaultType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4fe2e0f7973f0407, []int{0}
+}
+
+// FaultRule injects a fault into the packets received on a port and channel.
+type FaultRule struct {
+	// id of the rule, zero for the rules of the node-local configuration.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// port_id of the receiving end, empty for any port.
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	// channel_id of the receiving end, empty for any channel.
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// sequence of the packet, zero for any sequence.
+	Sequence uint64    `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Fault    FaultType `protobuf:"varint,5,opt,name=fault,proto3,enum=chaos.ibcfault.FaultType" json:"fault,omitempty"`
+	// delay_blocks is the number of blocks a held acknowledgement is held for.
+	// FAULT_TYPE_HOLD_ACK only.
+	DelayBlocks uint64 `protobuf:"varint,6,opt,name=delay_blocks,json=delayBlocks,proto3" json:"delay_blocks,omitempty" yaml:"delay_blocks"`
+	// mutated_ack are the acknowledgement bytes written instead of the
+	// acknowledgement of the application. The acknowledgement is truncated if
+	// empty. FAULT_TYPE_MUTATE_ACK only.
+	MutatedAck []byte `protobuf:"bytes,7,opt,name=mutated_ack,json=mutatedAck,proto3" json:"mutated_ack,omitempty" yaml:"mutated_ack"`
+	// max_triggers is the number of packets the rule applies to before it is
+	// deleted, zero for no limit. Not supported by FAULT_TYPE_REJECT_RECV, whose
+	// trigger count is discarded with the failed transaction.
+	MaxTriggers uint64 `protobuf:"varint,8,opt,name=max_triggers,json=maxTriggers,proto3" json:"max_triggers,omitempty" yaml:"max_triggers"`
+	// triggers is the number of packets the rule applied to.
+	Triggers uint64 `protobuf:"varint,9,opt,name=triggers,proto3" json:"triggers,omitempty"`
+}
+
+func (m *FaultRule) Reset()         { *m = FaultRule{} }
+func (m *FaultRule) String() string { return proto.CompactTextString(m) }
+func (*FaultRule) ProtoMessage()    {}
+func (*FaultRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fe2e0f7973f0407, []int{0}
+}
+func (m *FaultRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FaultRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FaultRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FaultRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FaultRule.Merge(m, src)
+}
+func (m *FaultRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *FaultRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_FaultRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FaultRule proto.InternalMessageInfo
+
+func (m *FaultRule) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *FaultRule) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *FaultRule) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *FaultRule) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *FaultRule) GetFault() FaultType {
+	if m != nil {
+		return m.Fault
+	}
+	return FaultUnspecified
+}
+
+func (m *FaultRule) GetDelayBlocks() uint64 {
+	if m != nil {
+		return m.DelayBlocks
+	}
+	return 0
+}
+
+func (m *FaultRule) GetMutatedAck() []byte {
+	if m != nil {
+		return m.MutatedAck
+	}
+	return nil
+}
+
+func (m *FaultRule) GetMaxTriggers() uint64 {
+	if m != nil {
+		return m.MaxTriggers
+	}
+	return 0
+}
+
+func (m *FaultRule) GetTriggers() uint64 {
+	if m != nil {
+		return m.Triggers
+	}
+	return 0
+}
+
+// HeldAcknowledgement is a successful acknowledgement held by a
+// FAULT_TYPE_HOLD_ACK rule.
+type HeldAcknowledgement struct {
+	Packet          types.Packet `protobuf:"bytes,1,opt,name=packet,proto3" json:"packet"`
+	Acknowledgement []byte       `protobuf:"bytes,2,opt,name=acknowledgement,proto3" json:"acknowledgement,omitempty"`
+	// release_height is the height at which the acknowledgement is written.
+	ReleaseHeight int64  `protobuf:"varint,3,opt,name=release_height,json=releaseHeight,proto3" json:"release_height,omitempty" yaml:"release_height"`
+	RuleId        uint64 `protobuf:"varint,4,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty" yaml:"rule_id"`
+}
+
+func (m *HeldAcknowledgement) Reset()         { *m = HeldAcknowledgement{} }
+func (m *HeldAcknowledgement) String() string { return proto.CompactTextString(m) }
+func (*HeldAcknowledgement) ProtoMessage()    {}
+func (*HeldAcknowledgement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fe2e0f7973f0407, []int{1}
+}
+func (m *HeldAcknowledgement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeldAcknowledgement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeldAcknowledgement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HeldAcknowledgement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeldAcknowledgement.Merge(m, src)
+}
+func (m *HeldAcknowledgement) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeldAcknowledgement) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeldAcknowledgement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeldAcknowledgement proto.InternalMessageInfo
+
+func (m *HeldAcknowledgement) GetPacket() types.Packet {
+	if m != nil {
+		return m.Packet
+	}
+	return types.Packet{}
+}
+
+func (m *HeldAcknowledgement) GetAcknowledgement() []byte {
+	if m != nil {
+		return m.Acknowledgement
+	}
+	return nil
+}
+
+func (m *HeldAcknowledgement) GetReleaseHeight() int64 {
+	if m != nil {
+		return m.ReleaseHeight
+	}
+	return 0
+}
+
+func (m *HeldAcknowledgement) GetRuleId() uint64 {
+	if m != nil {
+		return m.RuleId
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("chaos.ibcfault.FaultType", FaultType_name, FaultType_value)
+	proto.RegisterType((*FaultRule)(nil), "chaos.ibcfault.FaultRule")
+	proto.RegisterType((*HeldAcknowledgement)(nil), "chaos.ibcfault.HeldAcknowledgement")
+}
+
+func init() { proto.RegisterFile("chaos/ibcfault/ibcfault.proto", fileDescriptor_4fe2e0f7973f0407) }
+
+var fileDescriptor_4fe2e0f7973f0407 = []byte{
+	// 687 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x94, 0xc1, 0x53, 0xda, 0x4e,
+	0x14, 0xc7, 0x09, 0x20, 0xca, 0x82, 0x88, 0x8b, 0xfa, 0xc3, 0xfc, 0xa6, 0x90, 0xe6, 0x44, 0xdb,
+	0x31, 0xa9, 0xb6, 0x33, 0x9d, 0x7a, 0x2a, 0x60, 0x1c, 0xa8, 0x5a, 0x9d, 0x2d, 0x74, 0xa6, 0xbd,
+	0x64, 0xc2, 0x66, 0x85, 0x94, 0xc0, 0xd2, 0x24, 0x58, 0xf9, 0x0f, 0x3a, 0x9c, 0x7a, 0xec, 0x85,
+	0x53, 0xff, 0x19, 0x8f, 0x1e, 0x7b, 0x62, 0x3a, 0x7a, 0xea, 0x95, 0x6b, 0x2f, 0x9d, 0xdd, 0x44,
+	0x0c, 0xde, 0xde, 0xdb, 0xef, 0x67, 0xf7, 0xed, 0xcb, 0xf7, 0x65, 0xc1, 0x23, 0xdc, 0x31, 0xa8,
+	0xab, 0x5a, 0x2d, 0x7c, 0x6e, 0x0c, 0x6d, 0x6f, 0x1e, 0x28, 0x03, 0x87, 0x7a, 0x14, 0x66, 0xb8,
+	0xac, 0xdc, 0xad, 0x8a, 0x1b, 0x6d, 0xda, 0xa6, 0x5c, 0x52, 0x59, 0xe4, 0x53, 0xe2, 0x63, 0xab,
+	0x85, 0x55, 0x4c, 0x1d, 0xa2, 0xe2, 0x8e, 0xd1, 0xef, 0x13, 0x5b, 0xbd, 0xd8, 0xbd, 0x0b, 0x7d,
+	0x44, 0xfe, 0x11, 0x03, 0xc9, 0x43, 0x76, 0x04, 0x1a, 0xda, 0x04, 0x66, 0x40, 0xd4, 0x32, 0xf3,
+	0x82, 0x24, 0x94, 0xe2, 0x28, 0x6a, 0x99, 0xf0, 0x19, 0x58, 0x1e, 0x50, 0xc7, 0xd3, 0x2d, 0x33,
+	0x1f, 0x95, 0x84, 0x52, 0xb2, 0x02, 0x67, 0xd3, 0x62, 0x66, 0x64, 0xf4, 0xec, 0x7d, 0x39, 0x10,
+	0x64, 0x94, 0x60, 0x51, 0xdd, 0x84, 0x2f, 0x01, 0x08, 0xce, 0x66, 0x7c, 0x8c, 0xf3, 0x9b, 0xb3,
+	0x69, 0x71, 0xdd, 0xe7, 0xef, 0x35, 0x19, 0x25, 0x83, 0xa4, 0x6e, 0x42, 0x11, 0xac, 0xb8, 0xe4,
+	0xcb, 0x90, 0xf4, 0x31, 0xc9, 0xc7, 0x79, 0xe1, 0x79, 0x0e, 0x55, 0xb0, 0xc4, 0xdb, 0xcb, 0x2f,
+	0x49, 0x42, 0x29, 0xb3, 0xb7, 0xad, 0x2c, 0x76, 0xad, 0xf0, 0x8b, 0x37, 0x46, 0x03, 0x82, 0x7c,
+	0x0e, 0xee, 0x83, 0xb4, 0x49, 0x6c, 0x63, 0xa4, 0xb7, 0x6c, 0x8a, 0xbb, 0x6e, 0x3e, 0xc1, 0x0e,
+	0xac, 0xfc, 0x37, 0x9b, 0x16, 0x73, 0xfe, 0x25, 0xc2, 0xaa, 0x8c, 0x52, 0x3c, 0xad, 0xf0, 0x0c,
+	0xbe, 0x02, 0xa9, 0xde, 0xd0, 0x33, 0x3c, 0x62, 0xea, 0x06, 0xee, 0xe6, 0x97, 0x25, 0xa1, 0x94,
+	0xae, 0x6c, 0xcd, 0xa6, 0x45, 0xe8, 0x6f, 0x0d, 0x89, 0x32, 0x02, 0x41, 0x56, 0xc6, 0x5d, 0x56,
+	0xb4, 0x67, 0x5c, 0xea, 0x9e, 0x63, 0xb5, 0xdb, 0xc4, 0x71, 0xf3, 0x2b, 0x0f, 0x8b, 0x86, 0x55,
+	0x19, 0xa5, 0x7a, 0xc6, 0x65, 0x23, 0xc8, 0x58, 0xf7, 0xf3, 0x7d, 0x49, 0xbf, 0xfb, 0x39, 0xf9,
+	0x47, 0x00, 0xb9, 0x1a, 0xb1, 0x59, 0x8d, 0x3e, 0xfd, 0x6a, 0x13, 0xb3, 0x4d, 0x7a, 0xa4, 0xef,
+	0xc1, 0xd7, 0x20, 0x31, 0x30, 0x70, 0x97, 0x78, 0xdc, 0xa8, 0xd4, 0xde, 0xff, 0xec, 0x83, 0x28,
+	0xcc, 0x66, 0xe5, 0xce, 0xdb, 0x8b, 0x5d, 0xe5, 0x8c, 0x23, 0x95, 0xf8, 0xd5, 0xb4, 0x18, 0x41,
+	0xc1, 0x06, 0x58, 0x02, 0x6b, 0xc6, 0xe2, 0x69, 0xdc, 0xd7, 0x34, 0x7a, 0xb8, 0x0c, 0xdf, 0x80,
+	0x8c, 0x43, 0x6c, 0x62, 0xb8, 0x44, 0xef, 0x10, 0xab, 0xdd, 0xf1, 0xb8, 0xa1, 0xb1, 0xca, 0xf6,
+	0x6c, 0x5a, 0xdc, 0xf4, 0xdb, 0x5a, 0xd4, 0x65, 0xb4, 0x1a, 0x2c, 0xd4, 0x78, 0xce, 0x66, 0xc7,
+	0x19, 0xda, 0x84, 0xcd, 0x02, 0xf7, 0x35, 0x3c, 0x3b, 0x81, 0x20, 0xa3, 0x04, 0x8b, 0xea, 0xe6,
+	0xd3, 0xbf, 0x02, 0x48, 0xce, 0xdd, 0x84, 0xcf, 0xc1, 0xd6, 0x61, 0xb9, 0x79, 0xdc, 0xd0, 0x1b,
+	0x1f, 0xcf, 0x34, 0xbd, 0xf9, 0xee, 0xfd, 0x99, 0x56, 0xad, 0x1f, 0xd6, 0xb5, 0x83, 0x6c, 0x44,
+	0xdc, 0x18, 0x4f, 0xa4, 0x2c, 0x47, 0x9b, 0x7d, 0x77, 0x40, 0xb0, 0x75, 0x6e, 0x11, 0x36, 0xa8,
+	0x1b, 0xa1, 0x1d, 0x1a, 0x42, 0xa7, 0x48, 0x2f, 0x57, 0x8f, 0xb2, 0x82, 0xb8, 0x3e, 0x9e, 0x48,
+	0xab, 0x9c, 0xd7, 0x1c, 0x87, 0x3a, 0xcc, 0xb0, 0x27, 0x20, 0x17, 0x82, 0x6b, 0xa7, 0xc7, 0x07,
+	0x9c, 0x8d, 0x8a, 0xd9, 0xf1, 0x44, 0x4a, 0x73, 0xb6, 0x46, 0xf9, 0x77, 0x87, 0x3b, 0x60, 0x33,
+	0x84, 0x9e, 0x34, 0x1b, 0xe5, 0x86, 0xc6, 0xe1, 0x98, 0x08, 0xc7, 0x13, 0x29, 0xc3, 0xe1, 0x13,
+	0x3e, 0x0b, 0x0c, 0x57, 0x17, 0x2e, 0x8e, 0xb4, 0xb7, 0x5a, 0xb5, 0xa1, 0x23, 0xad, 0xfa, 0x21,
+	0x1b, 0x17, 0x73, 0xe3, 0x89, 0xb4, 0xe6, 0xff, 0x6a, 0xe4, 0x33, 0xc1, 0x1e, 0x22, 0xf8, 0x42,
+	0x8c, 0x7f, 0xfb, 0x59, 0x88, 0x54, 0x8e, 0xae, 0x6e, 0x0a, 0xc2, 0xf5, 0x4d, 0x41, 0xf8, 0x7d,
+	0x53, 0x10, 0xbe, 0xdf, 0x16, 0x22, 0xd7, 0xb7, 0x85, 0xc8, 0xaf, 0xdb, 0x42, 0xe4, 0xd3, 0x6e,
+	0xdb, 0xf2, 0x3a, 0xc3, 0x96, 0x82, 0x69, 0x4f, 0xc5, 0xd4, 0xed, 0x51, 0x77, 0xa7, 0x35, 0xb4,
+	0x6c, 0x93, 0x38, 0xae, 0xea, 0xbf, 0x10, 0x97, 0xf7, 0x6f, 0x84, 0x37, 0x1a, 0x10, 0xb7, 0x95,
+	0xe0, 0x3f, 0xf6, 0x8b, 0x7f, 0x03, 0x00, 0x10, 0x94, 0xcc, 0x5d, 0x42, 0x04, 0x00, 0x00,
+}
+
+func (m *FaultRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FaultRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FaultRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Triggers != 0 {
+		i = encodeVarintIbcfault(dAtA, i, uint64(m.Triggers))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxTriggers != 0 {
+		i = encodeVarintIbcfault(dAtA, i, uint64(m.MaxTriggers))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.MutatedAck) > 0 {
+		i -= len(m.MutatedAck)
+		copy(dAtA[i:], m.MutatedAck)
+		i = encodeVarintIbcfault(dAtA, i, uint64(len(m.MutatedAck)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.DelayBlocks != 0 {
+		i = encodeVarintIbcfault(dAtA, i, uint64(m.DelayBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Fault != 0 {
+		i = encodeVarintIbcfault(dAtA, i, uint64(m.Fault))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintIbcfault(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintIbcfault(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintIbcfault(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintIbcfault(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HeldAcknowledgement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeldAcknowledgement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeldAcknowledgement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RuleId != 0 {
+		i = encodeVarintIbcfault(dAtA, i, uint64(m.RuleId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReleaseHeight != 0 {
+		i = encodeVarintIbcfault(dAtA, i, uint64(m.ReleaseHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Acknowledgement) > 0 {
+		i -= len(m.Acknowledgement)
+		copy(dAtA[i:], m.Acknowledgement)
+		i = encodeVarintIbcfault(dAtA, i, uint64(len(m.Acknowledgement)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintIbcfault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIbcfault(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIbcfault(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FaultRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovIbcfault(uint64(m.Id))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovIbcfault(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovIbcfault(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovIbcfault(uint64(m.Sequence))
+	}
+	if m.Fault != 0 {
+		n += 1 + sovIbcfault(uint64(m.Fault))
+	}
+	if m.DelayBlocks != 0 {
+		n += 1 + sovIbcfault(uint64(m.DelayBlocks))
+	}
+	l = len(m.MutatedAck)
+	if l > 0 {
+		n += 1 + l + sovIbcfault(uint64(l))
+	}
+	if m.MaxTriggers != 0 {
+		n += 1 + sovIbcfault(uint64(m.MaxTriggers))
+	}
+	if m.Triggers != 0 {
+		n += 1 + sovIbcfault(uint64(m.Triggers))
+	}
+	return n
+}
+
+func (m *HeldAcknowledgement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Packet.Size()
+	n += 1 + l + sovIbcfault(uint64(l))
+	l = len(m.Acknowledgement)
+	if l > 0 {
+		n += 1 + l + sovIbcfault(uint64(l))
+	}
+	if m.ReleaseHeight != 0 {
+		n += 1 + sovIbcfault(uint64(m.ReleaseHeight))
+	}
+	if m.RuleId != 0 {
+		n += 1 + sovIbcfault(uint64(m.RuleId))
+	}
+	return n
+}
+
+func sovIbcfault(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIbcfault(x uint64) (n int) {
+	return sovIbcfault(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FaultRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIbcfault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FaultRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FaultRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcfault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcfault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcfault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcfault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcfault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcfault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcfault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcfault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fault", wireType)
+			}
+			m.Fault = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcfault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fault |= FaultType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayBlocks", wireType)
+			}
+			m.DelayBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcfault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelayBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MutatedAck", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcfault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthIbcfault
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcfault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MutatedAck = append(m.MutatedAck[:0], dAtA[iNdEx:postIndex]...)
+			if m.MutatedAck == nil {
+				m.MutatedAck = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTriggers", wireType)
+			}
+			m.MaxTriggers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcfault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTriggers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Triggers", wireType)
+			}
+			m.Triggers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcfault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Triggers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIbcfault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIbcfault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HeldAcknowledgement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIbcfault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeldAcknowledgement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeldAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcfault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIbcfault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcfault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledgement", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcfault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthIbcfault
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcfault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Acknowledgement = append(m.Acknowledgement[:0], dAtA[iNdEx:postIndex]...)
+			if m.Acknowledgement == nil {
+				m.Acknowledgement = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseHeight", wireType)
+			}
+			m.ReleaseHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcfault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReleaseHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuleId", wireType)
+			}
+			m.RuleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcfault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RuleId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIbcfault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIbcfault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipIbcfault(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowIbcfault
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIbcfault
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIbcfault
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthIbcfault
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupIbcfault
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthIbcfault
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthIbcfault        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowIbcfault          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupIbcfault = fmt.Errorf("proto: unexpected end of group")
+)
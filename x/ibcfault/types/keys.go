@@ -0,0 +1,48 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the IBC fault injection module name
+	ModuleName = "ibcfault"
+
+	// StoreKey is the store key string for the ibcfault module. It differs
+	// from the module name, which has the IBC store key as a prefix.
+	StoreKey = "fault-for-ibc"
+
+	// RouterKey is the proposal route for the ibcfault module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the ibcfault module
+	QuerierRoute = ModuleName
+)
+
+var (
+	// FaultRuleKeyPrefix is the prefix of the fault rule store
+	FaultRuleKeyPrefix = []byte{0x01}
+
+	// NextRuleIDKey is the key of the id of the next fault rule
+	NextRuleIDKey = []byte{0x02}
+
+	// HeldAcknowledgementKeyPrefix is the prefix of the held acknowledgement
+	// store
+	HeldAcknowledgementKeyPrefix = []byte{0x03}
+)
+
+// FaultRuleKey returns the store key of a fault rule.
+func FaultRuleKey(id uint64) []byte {
+	return append(FaultRuleKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// HeldAcknowledgementKey returns the store key of the acknowledgement of a
+// packet held until releaseHeight. Held acknowledgements are ordered by
+// release height.
+func HeldAcknowledgementKey(releaseHeight int64, portID, channelID string, sequence uint64) []byte {
+	key := append(HeldAcknowledgementKeyPrefix, sdk.Uint64ToBigEndian(uint64(releaseHeight))...)
+	key = append(key, []byte(fmt.Sprintf("%s/%s/", portID, channelID))...)
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
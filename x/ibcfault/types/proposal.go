@@ -0,0 +1,111 @@
+package types
+
+import (
+	"fmt"
+
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+const (
+	// ProposalTypeAddFaultRule defines the type for an AddFaultRuleProposal
+	ProposalTypeAddFaultRule = "AddIBCFaultRule"
+	// ProposalTypeRemoveFaultRule defines the type for a RemoveFaultRuleProposal
+	ProposalTypeRemoveFaultRule = "RemoveIBCFaultRule"
+)
+
+var (
+	_ govv1beta1.Content = &AddFaultRuleProposal{}
+	_ govv1beta1.Content = &RemoveFaultRuleProposal{}
+)
+
+func init() {
+	govv1beta1.RegisterProposalType(ProposalTypeAddFaultRule)
+	govv1beta1.RegisterProposalType(ProposalTypeRemoveFaultRule)
+}
+
+// NewAddFaultRuleProposal creates a new AddFaultRuleProposal.
+func NewAddFaultRuleProposal(title, description string, rule FaultRule) govv1beta1.Content {
+	return &AddFaultRuleProposal{
+		Title:       title,
+		Description: description,
+		Rule:        rule,
+	}
+}
+
+// GetTitle returns the title of an add fault rule proposal.
+func (p *AddFaultRuleProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an add fault rule proposal.
+func (p *AddFaultRuleProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an add fault rule proposal.
+func (p *AddFaultRuleProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an add fault rule proposal.
+func (p *AddFaultRuleProposal) ProposalType() string { return ProposalTypeAddFaultRule }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *AddFaultRuleProposal) ValidateBasic() error {
+	if err := govv1beta1.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	return p.Rule.Validate()
+}
+
+// String implements the Stringer interface.
+func (p AddFaultRuleProposal) String() string {
+	return fmt.Sprintf(`Add IBC Fault Rule Proposal:
+  Title:        %s
+  Description:  %s
+  Port:         %s
+  Channel:      %s
+  Sequence:     %d
+  Fault:        %s
+  Delay Blocks: %d
+  Max Triggers: %d
+`, p.Title, p.Description, p.Rule.PortId, p.Rule.ChannelId, p.Rule.Sequence, p.Rule.Fault.Name(), p.Rule.DelayBlocks, p.Rule.MaxTriggers)
+}
+
+// NewRemoveFaultRuleProposal creates a new RemoveFaultRuleProposal.
+func NewRemoveFaultRuleProposal(title, description string, ruleID uint64) govv1beta1.Content {
+	return &RemoveFaultRuleProposal{
+		Title:       title,
+		Description: description,
+		RuleId:      ruleID,
+	}
+}
+
+// GetTitle returns the title of a remove fault rule proposal.
+func (p *RemoveFaultRuleProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a remove fault rule proposal.
+func (p *RemoveFaultRuleProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a remove fault rule proposal.
+func (p *RemoveFaultRuleProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a remove fault rule proposal.
+func (p *RemoveFaultRuleProposal) ProposalType() string { return ProposalTypeRemoveFaultRule }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *RemoveFaultRuleProposal) ValidateBasic() error {
+	if err := govv1beta1.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if p.RuleId == 0 {
+		return ErrRuleNotFound
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p RemoveFaultRuleProposal) String() string {
+	return fmt.Sprintf(`Remove IBC Fault Rule Proposal:
+  Title:       %s
+  Description: %s
+  Rule:        %d
+`, p.Title, p.Description, p.RuleId)
+}
@@ -0,0 +1,956 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: chaos/ibcfault/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryRulesRequest is the request type for the Query/Rules RPC.
+type QueryRulesRequest struct {
+}
+
+func (m *QueryRulesRequest) Reset()         { *m = QueryRulesRequest{} }
+func (m *QueryRulesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRulesRequest) ProtoMessage()    {}
+func (*QueryRulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0bc888a7cb1c21e5, []int{0}
+}
+func (m *QueryRulesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRulesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRulesRequest.Merge(m, src)
+}
+func (m *QueryRulesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRulesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRulesRequest proto.InternalMessageInfo
+
+// QueryRulesResponse is the response type for the Query/Rules RPC.
+type QueryRulesResponse struct {
+	Rules      []FaultRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules"`
+	LocalRules []FaultRule `protobuf:"bytes,2,rep,name=local_rules,json=localRules,proto3" json:"local_rules"`
+}
+
+func (m *QueryRulesResponse) Reset()         { *m = QueryRulesResponse{} }
+func (m *QueryRulesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRulesResponse) ProtoMessage()    {}
+func (*QueryRulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0bc888a7cb1c21e5, []int{1}
+}
+func (m *QueryRulesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRulesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRulesResponse.Merge(m, src)
+}
+func (m *QueryRulesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRulesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRulesResponse proto.InternalMessageInfo
+
+func (m *QueryRulesResponse) GetRules() []FaultRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+func (m *QueryRulesResponse) GetLocalRules() []FaultRule {
+	if m != nil {
+		return m.LocalRules
+	}
+	return nil
+}
+
+// QueryHeldAcknowledgementsRequest is the request type for the
+// Query/HeldAcknowledgements RPC.
+type QueryHeldAcknowledgementsRequest struct {
+}
+
+func (m *QueryHeldAcknowledgementsRequest) Reset()         { *m = QueryHeldAcknowledgementsRequest{} }
+func (m *QueryHeldAcknowledgementsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHeldAcknowledgementsRequest) ProtoMessage()    {}
+func (*QueryHeldAcknowledgementsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0bc888a7cb1c21e5, []int{2}
+}
+func (m *QueryHeldAcknowledgementsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHeldAcknowledgementsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHeldAcknowledgementsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHeldAcknowledgementsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHeldAcknowledgementsRequest.Merge(m, src)
+}
+func (m *QueryHeldAcknowledgementsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHeldAcknowledgementsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHeldAcknowledgementsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHeldAcknowledgementsRequest proto.InternalMessageInfo
+
+// QueryHeldAcknowledgementsResponse is the response type for the
+// Query/HeldAcknowledgements RPC.
+type QueryHeldAcknowledgementsResponse struct {
+	HeldAcknowledgements []HeldAcknowledgement `protobuf:"bytes,1,rep,name=held_acknowledgements,json=heldAcknowledgements,proto3" json:"held_acknowledgements"`
+}
+
+func (m *QueryHeldAcknowledgementsResponse) Reset()         { *m = QueryHeldAcknowledgementsResponse{} }
+func (m *QueryHeldAcknowledgementsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHeldAcknowledgementsResponse) ProtoMessage()    {}
+func (*QueryHeldAcknowledgementsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0bc888a7cb1c21e5, []int{3}
+}
+func (m *QueryHeldAcknowledgementsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHeldAcknowledgementsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHeldAcknowledgementsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHeldAcknowledgementsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHeldAcknowledgementsResponse.Merge(m, src)
+}
+func (m *QueryHeldAcknowledgementsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHeldAcknowledgementsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHeldAcknowledgementsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHeldAcknowledgementsResponse proto.InternalMessageInfo
+
+func (m *QueryHeldAcknowledgementsResponse) GetHeldAcknowledgements() []HeldAcknowledgement {
+	if m != nil {
+		return m.HeldAcknowledgements
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryRulesRequest)(nil), "chaos.ibcfault.QueryRulesRequest")
+	proto.RegisterType((*QueryRulesResponse)(nil), "chaos.ibcfault.QueryRulesResponse")
+	proto.RegisterType((*QueryHeldAcknowledgementsRequest)(nil), "chaos.ibcfault.QueryHeldAcknowledgementsRequest")
+	proto.RegisterType((*QueryHeldAcknowledgementsResponse)(nil), "chaos.ibcfault.QueryHeldAcknowledgementsResponse")
+}
+
+func init() { proto.RegisterFile("chaos/ibcfault/query.proto", fileDescriptor_0bc888a7cb1c21e5) }
+
+var fileDescriptor_0bc888a7cb1c21e5 = []byte{
+	// 394 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x4d, 0x4b, 0xe3, 0x40,
+	0x18, 0xc7, 0x93, 0xee, 0x76, 0x0f, 0x53, 0x58, 0xd8, 0xd9, 0x96, 0xdd, 0x0d, 0xdb, 0x6c, 0x9b,
+	0x45, 0xf4, 0xd2, 0xc4, 0x56, 0xbc, 0x6b, 0x0f, 0x22, 0x78, 0xb2, 0x47, 0x0f, 0x96, 0xbc, 0x8c,
+	0x49, 0x70, 0x92, 0x27, 0xcd, 0x4c, 0xd0, 0x5e, 0xf5, 0x2c, 0x08, 0x7e, 0x04, 0x6f, 0x7e, 0x92,
+	0x1e, 0x0b, 0x5e, 0x3c, 0x89, 0xb4, 0x7e, 0x10, 0xc9, 0x24, 0xf5, 0x25, 0x86, 0xa2, 0x97, 0x32,
+	0xcc, 0xff, 0x65, 0x7e, 0xcf, 0xd3, 0x20, 0xc5, 0xf6, 0x4c, 0x60, 0x86, 0x6f, 0xd9, 0x47, 0x66,
+	0x42, 0xb9, 0x31, 0x4a, 0x48, 0x3c, 0xd6, 0xa3, 0x18, 0x38, 0xe0, 0xef, 0x42, 0xd3, 0x17, 0x9a,
+	0x52, 0x77, 0xc1, 0x05, 0x21, 0x19, 0xe9, 0x29, 0x73, 0x29, 0x7f, 0x5d, 0x00, 0x97, 0x12, 0xc3,
+	0x8c, 0x7c, 0xc3, 0x0c, 0x43, 0xe0, 0x26, 0xf7, 0x21, 0x64, 0xb9, 0xda, 0x2c, 0xf4, 0x2f, 0x0e,
+	0x99, 0xac, 0xfd, 0x44, 0x3f, 0xf6, 0xd3, 0x17, 0x07, 0x09, 0x25, 0x6c, 0x40, 0x46, 0x09, 0x61,
+	0x5c, 0xbb, 0x90, 0x11, 0x7e, 0x7d, 0xcb, 0x22, 0x08, 0x19, 0xc1, 0x9b, 0xa8, 0x1a, 0xa7, 0x17,
+	0xbf, 0xe5, 0xd6, 0x97, 0xb5, 0x5a, 0xef, 0x8f, 0xfe, 0x16, 0x4f, 0xdf, 0x49, 0x7f, 0xd3, 0x48,
+	0xff, 0xeb, 0xe4, 0xfe, 0x9f, 0x34, 0xc8, 0xdc, 0x78, 0x0b, 0xd5, 0x28, 0xd8, 0x26, 0x1d, 0x66,
+	0xe1, 0xca, 0xc7, 0xc2, 0x48, 0x64, 0x04, 0x80, 0xa6, 0xa1, 0x96, 0xc0, 0xd9, 0x25, 0xd4, 0xd9,
+	0xb6, 0x8f, 0x43, 0x38, 0xa1, 0xc4, 0x71, 0x49, 0x40, 0x42, 0xfe, 0xcc, 0x7c, 0x2e, 0xa3, 0xf6,
+	0x12, 0x53, 0x3e, 0xc2, 0x21, 0x6a, 0x78, 0x84, 0x3a, 0x43, 0xb3, 0x60, 0xc8, 0x47, 0xfa, 0x5f,
+	0xa4, 0x2a, 0x29, 0xcb, 0xf9, 0xea, 0x5e, 0xc9, 0x3b, 0xbd, 0xeb, 0x0a, 0xaa, 0x0a, 0x0a, 0x1c,
+	0xa0, 0xaa, 0x80, 0xc7, 0xed, 0x62, 0xe7, 0xbb, 0x7d, 0x2b, 0xda, 0x32, 0x4b, 0x46, 0xae, 0x35,
+	0xcf, 0x6e, 0x1f, 0xaf, 0x2a, 0xbf, 0x70, 0xc3, 0x28, 0xfc, 0xa1, 0xd9, 0x92, 0x6f, 0x64, 0x54,
+	0x2f, 0x9b, 0x1c, 0xaf, 0x97, 0x76, 0x2f, 0xd9, 0xa4, 0xd2, 0xfd, 0x44, 0x22, 0x87, 0xeb, 0x08,
+	0xb8, 0x55, 0xbc, 0x52, 0x84, 0x2b, 0x5d, 0x76, 0x7f, 0x6f, 0x32, 0x53, 0xe5, 0xe9, 0x4c, 0x95,
+	0x1f, 0x66, 0xaa, 0x7c, 0x39, 0x57, 0xa5, 0xe9, 0x5c, 0x95, 0xee, 0xe6, 0xaa, 0x74, 0xd0, 0x75,
+	0x7d, 0xee, 0x25, 0x96, 0x6e, 0x43, 0x60, 0xd8, 0xc0, 0x02, 0x60, 0x1d, 0x2b, 0xf1, 0xa9, 0x43,
+	0x62, 0x96, 0x57, 0x9f, 0xbe, 0x94, 0xf3, 0x71, 0x44, 0x98, 0xf5, 0x4d, 0x7c, 0xc8, 0x1b, 0x4f,
+	0x03, 0x00, 0x85, 0x21, 0xc6, 0xbe, 0x49, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Rules returns the fault rules set through governance and the rules of
+	// the node-local configuration of the queried node.
+	Rules(ctx context.Context, in *QueryRulesRequest, opts ...grpc.CallOption) (*QueryRulesResponse, error)
+	// HeldAcknowledgements returns the acknowledgements held by fault rules.
+	HeldAcknowledgements(ctx context.Context, in *QueryHeldAcknowledgementsRequest, opts ...grpc.CallOption) (*QueryHeldAcknowledgementsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Rules(ctx context.Context, in *QueryRulesRequest, opts ...grpc.CallOption) (*QueryRulesResponse, error) {
+	out := new(QueryRulesResponse)
+	err := c.cc.Invoke(ctx, "/chaos.ibcfault.Query/Rules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HeldAcknowledgements(ctx context.Context, in *QueryHeldAcknowledgementsRequest, opts ...grpc.CallOption) (*QueryHeldAcknowledgementsResponse, error) {
+	out := new(QueryHeldAcknowledgementsResponse)
+	err := c.cc.Invoke(ctx, "/chaos.ibcfault.Query/HeldAcknowledgements", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Rules returns the fault rules set through governance and the rules of
+	// the node-local configuration of the queried node.
+	Rules(context.Context, *QueryRulesRequest) (*QueryRulesResponse, error)
+	// HeldAcknowledgements returns the acknowledgements held by fault rules.
+	HeldAcknowledgements(context.Context, *QueryHeldAcknowledgementsRequest) (*QueryHeldAcknowledgementsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Rules(ctx context.Context, req *QueryRulesRequest) (*QueryRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rules not implemented")
+}
+func (*UnimplementedQueryServer) HeldAcknowledgements(ctx context.Context, req *QueryHeldAcknowledgementsRequest) (*QueryHeldAcknowledgementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeldAcknowledgements not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Rules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Rules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaos.ibcfault.Query/Rules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Rules(ctx, req.(*QueryRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HeldAcknowledgements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeldAcknowledgementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HeldAcknowledgements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaos.ibcfault.Query/HeldAcknowledgements",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeldAcknowledgements(ctx, req.(*QueryHeldAcknowledgementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "chaos.ibcfault.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Rules",
+			Handler:    _Query_Rules_Handler,
+		},
+		{
+			MethodName: "HeldAcknowledgements",
+			Handler:    _Query_HeldAcknowledgements_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "chaos/ibcfault/query.proto",
+}
+
+func (m *QueryRulesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRulesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRulesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRulesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRulesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LocalRules) > 0 {
+		for iNdEx := len(m.LocalRules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LocalRules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Rules) > 0 {
+		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHeldAcknowledgementsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeldAcknowledgementsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeldAcknowledgementsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHeldAcknowledgementsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeldAcknowledgementsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeldAcknowledgementsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HeldAcknowledgements) > 0 {
+		for iNdEx := len(m.HeldAcknowledgements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HeldAcknowledgements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryRulesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.LocalRules) > 0 {
+		for _, e := range m.LocalRules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryHeldAcknowledgementsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryHeldAcknowledgementsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HeldAcknowledgements) > 0 {
+		for _, e := range m.HeldAcknowledgements {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryRulesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRulesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRulesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRulesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRulesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rules = append(m.Rules, FaultRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LocalRules = append(m.LocalRules, FaultRule{})
+			if err := m.LocalRules[len(m.LocalRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHeldAcknowledgementsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHeldAcknowledgementsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHeldAcknowledgementsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHeldAcknowledgementsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHeldAcknowledgementsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHeldAcknowledgementsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeldAcknowledgements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeldAcknowledgements = append(m.HeldAcknowledgements, HeldAcknowledgement{})
+			if err := m.HeldAcknowledgements[len(m.HeldAcknowledgements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
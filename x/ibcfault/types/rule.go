@@ -0,0 +1,186 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v5/modules/core/exported"
+)
+
+// Wildcard matches any port, channel or sequence in the rules of the
+// node-local configuration.
+const Wildcard = "*"
+
+// faultNames are the names of the faults in the rules of the node-local
+// configuration.
+var faultNames = map[FaultType]string{
+	FaultErrorAck:   "error-ack",
+	FaultHoldAck:    "hold-ack",
+	FaultMutateAck:  "mutate-ack",
+	FaultRejectRecv: "reject-recv",
+}
+
+// ParseFaultType returns the fault of the given name, e.g. error-ack.
+func ParseFaultType(name string) (FaultType, error) {
+	for fault, faultName := range faultNames {
+		if name == faultName {
+			return fault, nil
+		}
+	}
+
+	return FaultUnspecified, fmt.Errorf("unknown fault %q", name)
+}
+
+// Name returns the name of the fault in the rules of the node-local
+// configuration.
+func (f FaultType) Name() string {
+	if name, ok := faultNames[f]; ok {
+		return name
+	}
+
+	return f.String()
+}
+
+// ParseFaultRule parses a rule of the node-local configuration. Rules are
+// given as <port>/<channel>/<sequence>:<fault>[:<delay-blocks>], where port,
+// channel and sequence may be the * wildcard, e.g. transfer/channel-0/*:error-ack
+// or icahost/*/*:hold-ack:10.
+func ParseFaultRule(s string) (FaultRule, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return FaultRule{}, sdkerrors.Wrapf(ErrInvalidRule, "expected <port>/<channel>/<sequence>:<fault>[:<delay-blocks>], got %q", s)
+	}
+
+	path := strings.Split(parts[0], "/")
+	if len(path) != 3 {
+		return FaultRule{}, sdkerrors.Wrapf(ErrInvalidRule, "expected <port>/<channel>/<sequence>, got %q", parts[0])
+	}
+
+	var rule FaultRule
+	if path[0] != Wildcard {
+		rule.PortId = path[0]
+	}
+	if path[1] != Wildcard {
+		rule.ChannelId = path[1]
+	}
+	if path[2] != Wildcard {
+		sequence, err := strconv.ParseUint(path[2], 10, 64)
+		if err != nil || sequence == 0 {
+			return FaultRule{}, sdkerrors.Wrapf(ErrInvalidRule, "invalid sequence %q", path[2])
+		}
+		rule.Sequence = sequence
+	}
+
+	fault, err := ParseFaultType(parts[1])
+	if err != nil {
+		return FaultRule{}, sdkerrors.Wrap(ErrInvalidRule, err.Error())
+	}
+	rule.Fault = fault
+
+	if len(parts) == 3 {
+		delay, err := strconv.ParseUint(parts[2], 10, 64)
+		if err != nil {
+			return FaultRule{}, sdkerrors.Wrapf(ErrInvalidRule, "invalid delay %q", parts[2])
+		}
+		rule.DelayBlocks = delay
+	}
+
+	if err := rule.Validate(); err != nil {
+		return FaultRule{}, err
+	}
+
+	return rule, nil
+}
+
+// ParseFaultRules parses the rules of the node-local configuration.
+func ParseFaultRules(rules []string) ([]FaultRule, error) {
+	faultRules := make([]FaultRule, 0, len(rules))
+	for _, s := range rules {
+		rule, err := ParseFaultRule(s)
+		if err != nil {
+			return nil, err
+		}
+		faultRules = append(faultRules, rule)
+	}
+
+	return faultRules, nil
+}
+
+// Validate performs a stateless validation of the rule. The id and triggers
+// are set by the chain and are not checked.
+func (r FaultRule) Validate() error {
+	if r.PortId != "" {
+		if err := host.PortIdentifierValidator(r.PortId); err != nil {
+			return sdkerrors.Wrap(ErrInvalidRule, err.Error())
+		}
+	}
+	if r.ChannelId != "" {
+		if err := host.ChannelIdentifierValidator(r.ChannelId); err != nil {
+			return sdkerrors.Wrap(ErrInvalidRule, err.Error())
+		}
+	}
+
+	if _, ok := faultNames[r.Fault]; !ok {
+		return sdkerrors.Wrapf(ErrInvalidRule, "invalid fault %s", r.Fault)
+	}
+
+	if r.Fault == FaultHoldAck && r.DelayBlocks == 0 {
+		return sdkerrors.Wrap(ErrInvalidRule, "held acknowledgements must be delayed by at least one block")
+	}
+	if r.Fault != FaultHoldAck && r.DelayBlocks != 0 {
+		return sdkerrors.Wrapf(ErrInvalidRule, "delay is not supported by the %s fault", r.Fault.Name())
+	}
+	if r.Fault != FaultMutateAck && len(r.MutatedAck) != 0 {
+		return sdkerrors.Wrapf(ErrInvalidRule, "mutated acknowledgement is not supported by the %s fault", r.Fault.Name())
+	}
+
+	// the transaction of a rejected packet fails, which reverts the trigger
+	// count of the rule along with the rest of its state changes
+	if r.Fault == FaultRejectRecv && r.MaxTriggers != 0 {
+		return sdkerrors.Wrap(ErrInvalidRule, "max triggers is not supported by the reject-recv fault")
+	}
+
+	if r.MaxTriggers != 0 && r.Triggers >= r.MaxTriggers {
+		return sdkerrors.Wrapf(ErrInvalidRule, "rule triggered %d times out of %d", r.Triggers, r.MaxTriggers)
+	}
+
+	return nil
+}
+
+// Matches returns true if the rule applies to a packet received by the
+// chain.
+func (r FaultRule) Matches(packet ibcexported.PacketI) bool {
+	return (r.PortId == "" || r.PortId == packet.GetDestPort()) &&
+		(r.ChannelId == "" || r.ChannelId == packet.GetDestChannel()) &&
+		(r.Sequence == 0 || r.Sequence == packet.GetSequence())
+}
+
+// Exhausted returns true if the rule applied to its maximum number of
+// packets.
+func (r FaultRule) Exhausted() bool {
+	return r.MaxTriggers != 0 && r.Triggers >= r.MaxTriggers
+}
+
+// MutateAcknowledgement returns the bytes written instead of the
+// acknowledgement of the application: the mutated acknowledgement of the
+// rule if set, else the acknowledgement truncated to half its length.
+func (r FaultRule) MutateAcknowledgement(ack []byte) []byte {
+	if len(r.MutatedAck) != 0 {
+		return r.MutatedAck
+	}
+
+	return ack[:(len(ack)+1)/2]
+}
+
+// RuleAttribute returns the rule attribute of the events of the faults
+// injected by the rule.
+func (r FaultRule) RuleAttribute() string {
+	if r.Id == 0 {
+		return AttributeValueLocalRule
+	}
+
+	return strconv.FormatUint(r.Id, 10)
+}
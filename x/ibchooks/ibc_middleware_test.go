@@ -0,0 +1,396 @@
+package ibchooks_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	transfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+
+	"github.com/cosmos-builders/chaos/app"
+	"github.com/cosmos-builders/chaos/x/ibchooks/types"
+)
+
+func chaosApp(chain *ibctesting.TestChain) *app.App {
+	return chain.App.(*app.App)
+}
+
+// mockModule is the name of the module whose packet callbacks are recorded
+// by callbackHandler.
+const mockModule = "mock"
+
+type callbackHandler struct {
+	acks     []channeltypes.Acknowledgement
+	timeouts []channeltypes.Packet
+	err      error
+}
+
+func (h *callbackHandler) OnAcknowledgementPacketCallback(ctx sdk.Context, packet channeltypes.Packet, ack channeltypes.Acknowledgement) error {
+	h.acks = append(h.acks, ack)
+	return h.err
+}
+
+func (h *callbackHandler) OnTimeoutPacketCallback(ctx sdk.Context, packet channeltypes.Packet) error {
+	h.timeouts = append(h.timeouts, packet)
+	return h.err
+}
+
+type IBCHooksTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+}
+
+func TestIBCHooksTestSuite(t *testing.T) {
+	suite.Run(t, new(IBCHooksTestSuite))
+}
+
+func (suite *IBCHooksTestSuite) SetupTest() {
+	ibctesting.DefaultTestingAppInit = app.SetupTestingApp
+
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	suite.path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	suite.path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	suite.path.EndpointB.ChannelConfig.Version = transfertypes.Version
+	suite.coordinator.Setup(suite.path)
+}
+
+func hooksMemo(cdc codec.Codec, msgs ...sdk.Msg) string {
+	metadata := types.PacketMetadata{Hooks: &types.HooksMetadata{}}
+	for _, msg := range msgs {
+		bz, err := cdc.MarshalInterfaceJSON(msg)
+		if err != nil {
+			panic(err)
+		}
+		metadata.Hooks.Msgs = append(metadata.Hooks.Msgs, bz)
+	}
+
+	bz, err := json.Marshal(metadata)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(bz)
+}
+
+// sendBack sends vouchers of chain A stake from chain B back to chain A with
+// the given memo and returns the result of the packet on chain A.
+func (suite *IBCHooksTestSuite) sendBack(memo string) *sdk.Result {
+	senderB := suite.chainB.SenderAccount.GetAddress()
+
+	// chain B receives the vouchers first
+	msg := transfertypes.NewMsgTransfer(
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)),
+		suite.chainA.SenderAccount.GetAddress().String(), senderB.String(),
+		clienttypes.NewHeight(1, 110), 0,
+	)
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+
+	msg = transfertypes.NewMsgTransfer(
+		suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID,
+		sdk.NewCoin(suite.voucherDenom(), sdk.NewInt(1000)),
+		senderB.String(), suite.chainA.SenderAccount.GetAddress().String(),
+		clienttypes.NewHeight(1, 110), 0,
+	)
+	msg.Memo = memo
+	res, err = suite.chainB.SendMsgs(msg)
+	suite.Require().NoError(err)
+	packet, err = ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+	res, err = suite.path.EndpointA.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.path.EndpointB.UpdateClient())
+	suite.Require().NoError(suite.path.EndpointB.AcknowledgePacket(packet, ack))
+
+	return res
+}
+
+func (suite *IBCHooksTestSuite) voucherDenom() string {
+	return transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(
+		suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, sdk.DefaultBondDenom,
+	)).IBCDenom()
+}
+
+// intermediateAccount returns the account of chain A receiving the funds sent
+// by the sender of chain B.
+func (suite *IBCHooksTestSuite) intermediateAccount() sdk.AccAddress {
+	return types.IntermediateAccount(suite.path.EndpointA.ChannelID, suite.chainB.SenderAccount.GetAddress().String())
+}
+
+func (suite *IBCHooksTestSuite) hooksEvent(res *sdk.Result) (bool, bool) {
+	for _, event := range res.GetEvents() {
+		if event.Type != types.EventTypeHooks {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeKeySuccess {
+				return true, string(attr.Value) == "true"
+			}
+		}
+	}
+
+	return false, false
+}
+
+func (suite *IBCHooksTestSuite) TestDelegateFromMemo() {
+	appA := chaosApp(suite.chainA)
+	account := suite.intermediateAccount()
+	validator := appA.StakingKeeper.GetAllValidators(suite.chainA.GetContext())[0].GetOperator()
+
+	msg := stakingtypes.NewMsgDelegate(account, validator, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(400)))
+	res := suite.sendBack(hooksMemo(appA.AppCodec(), msg))
+
+	found, success := suite.hooksEvent(res)
+	suite.Require().True(found)
+	suite.Require().True(success)
+
+	delegation, found := appA.StakingKeeper.GetDelegation(suite.chainA.GetContext(), account, validator)
+	suite.Require().True(found)
+	suite.Require().False(delegation.Shares.IsZero())
+	suite.Require().Equal(sdk.NewInt(600), appA.BankKeeper.GetBalance(suite.chainA.GetContext(), account, sdk.DefaultBondDenom).Amount)
+
+	// the vouchers were spent
+	appB := chaosApp(suite.chainB)
+	suite.Require().True(appB.BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), suite.voucherDenom()).IsZero())
+}
+
+func (suite *IBCHooksTestSuite) TestFailedHooks() {
+	testCases := []struct {
+		name string
+		memo func(cdc codec.Codec, account sdk.AccAddress) string
+	}{
+		{
+			"message fails",
+			func(cdc codec.Codec, account sdk.AccAddress) string {
+				return hooksMemo(cdc, banktypes.NewMsgSend(account, suite.chainA.SenderAccount.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1001)))))
+			},
+		},
+		{
+			"message not signed by the intermediate account",
+			func(cdc codec.Codec, account sdk.AccAddress) string {
+				return hooksMemo(cdc, banktypes.NewMsgSend(suite.chainA.SenderAccount.GetAddress(), account, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1)))))
+			},
+		},
+		{
+			"later message fails",
+			func(cdc codec.Codec, account sdk.AccAddress) string {
+				coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(600)))
+				return hooksMemo(cdc,
+					banktypes.NewMsgSend(account, suite.chainA.SenderAccount.GetAddress(), coins),
+					banktypes.NewMsgSend(account, suite.chainA.SenderAccount.GetAddress(), coins),
+				)
+			},
+		},
+		{
+			"invalid message",
+			func(cdc codec.Codec, account sdk.AccAddress) string {
+				return `{"hooks":{"msgs":[{"@type":"/cosmos.bank.v1beta1.MsgSend","from_address":"invalid"}]}}`
+			},
+		},
+		{
+			"unknown message",
+			func(cdc codec.Codec, account sdk.AccAddress) string {
+				return `{"hooks":{"msgs":[{"@type":"/chaos.unknown.MsgUnknown"}]}}`
+			},
+		},
+		{
+			"no messages",
+			func(cdc codec.Codec, account sdk.AccAddress) string {
+				return `{"hooks":{"msgs":[]}}`
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			appA := chaosApp(suite.chainA)
+			account := suite.intermediateAccount()
+			res := suite.sendBack(tc.memo(appA.AppCodec(), account))
+
+			found, success := suite.hooksEvent(res)
+			suite.Require().True(found)
+			suite.Require().False(success)
+
+			// the transfer is reverted and refunded
+			suite.Require().True(appA.BankKeeper.GetAllBalances(suite.chainA.GetContext(), account).IsZero())
+			appB := chaosApp(suite.chainB)
+			suite.Require().Equal(sdk.NewInt(1000), appB.BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), suite.voucherDenom()).Amount)
+		})
+	}
+}
+
+func (suite *IBCHooksTestSuite) TestPassThrough() {
+	for _, memo := range []string{"", "not json", `{"forward":null}`, `{"hooks":null}`} {
+		suite.Run(memo, func() {
+			suite.SetupTest()
+
+			appA := chaosApp(suite.chainA)
+			receiver := suite.chainA.SenderAccount.GetAddress()
+			balance := appA.BankKeeper.GetBalance(suite.chainA.GetContext(), receiver, sdk.DefaultBondDenom)
+
+			// the funds sent to chain B come back to the receiver
+			res := suite.sendBack(memo)
+			found, _ := suite.hooksEvent(res)
+			suite.Require().False(found)
+
+			suite.Require().Equal(balance, appA.BankKeeper.GetBalance(suite.chainA.GetContext(), receiver, sdk.DefaultBondDenom))
+		})
+	}
+}
+
+// sendFromModule sends a transfer from the module account of the mock module
+// with a callback memo and returns the packet.
+func (suite *IBCHooksTestSuite) sendFromModule(receiver string, timeoutTimestamp uint64) channeltypes.Packet {
+	moduleAddr := authtypes.NewModuleAddress(mockModule)
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)))
+	_, err := suite.chainA.SendMsgs(banktypes.NewMsgSend(suite.chainA.SenderAccount.GetAddress(), moduleAddr, coins))
+	suite.Require().NoError(err)
+
+	timeoutHeight := clienttypes.NewHeight(1, 110)
+	if timeoutTimestamp != 0 {
+		timeoutHeight = clienttypes.ZeroHeight()
+	}
+	msg := transfertypes.NewMsgTransfer(
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+		coins[0], moduleAddr.String(), receiver, timeoutHeight, timeoutTimestamp,
+	)
+	msg.Memo = fmt.Sprintf(`{"ibc_callback":%q}`, mockModule)
+
+	ctx := suite.chainA.GetContext()
+	_, err = chaosApp(suite.chainA).TransferKeeper.Transfer(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().NoError(err)
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	packet, err := ibctesting.ParsePacketFromEvents(ctx.EventManager().Events())
+	suite.Require().NoError(err)
+
+	return packet
+}
+
+func (suite *IBCHooksTestSuite) registerCallbackHandler() *callbackHandler {
+	handler := &callbackHandler{}
+	chaosApp(suite.chainA).IBCHooksKeeper.CallbackRouter().AddRoute(mockModule, handler)
+
+	return handler
+}
+
+func (suite *IBCHooksTestSuite) TestAcknowledgementCallback() {
+	handler := suite.registerCallbackHandler()
+	appA := chaosApp(suite.chainA)
+
+	packet := suite.sendFromModule(suite.chainB.SenderAccount.GetAddress().String(), 0)
+	_, found := appA.IBCHooksKeeper.GetPacketCallback(suite.chainA.GetContext(), packet.SourceChannel, packet.Sequence)
+	suite.Require().True(found)
+
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+	suite.Require().Len(handler.acks, 1)
+	suite.Require().True(handler.acks[0].Success())
+	_, found = appA.IBCHooksKeeper.GetPacketCallback(suite.chainA.GetContext(), packet.SourceChannel, packet.Sequence)
+	suite.Require().False(found)
+
+	// failed packets are reported as well
+	packet = suite.sendFromModule("invalid", 0)
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+	suite.Require().Len(handler.acks, 2)
+	suite.Require().False(handler.acks[1].Success())
+
+	// a failing callback does not fail the acknowledgement
+	handler.err = errors.New("callback failed")
+	packet = suite.sendFromModule(suite.chainB.SenderAccount.GetAddress().String(), 0)
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+	suite.Require().Len(handler.acks, 3)
+	suite.Require().Empty(appA.IBCHooksKeeper.GetAllPacketCallbacks(suite.chainA.GetContext()))
+}
+
+func (suite *IBCHooksTestSuite) TestTimeoutCallback() {
+	handler := suite.registerCallbackHandler()
+
+	timeout := uint64(suite.chainB.CurrentHeader.Time.Add(time.Minute).UnixNano())
+	packet := suite.sendFromModule(suite.chainB.SenderAccount.GetAddress().String(), timeout)
+
+	suite.coordinator.IncrementTimeBy(time.Hour)
+	suite.coordinator.CommitBlock(suite.chainB)
+	suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+	suite.Require().NoError(suite.path.EndpointA.TimeoutPacket(packet))
+
+	suite.Require().Empty(handler.acks)
+	suite.Require().Len(handler.timeouts, 1)
+	suite.Require().Equal(packet.Sequence, handler.timeouts[0].Sequence)
+}
+
+func (suite *IBCHooksTestSuite) TestInvalidCallback() {
+	suite.registerCallbackHandler()
+	appA := chaosApp(suite.chainA)
+
+	testCases := []struct {
+		name   string
+		sender sdk.AccAddress
+		module string
+	}{
+		{"sender is not the module account", suite.chainA.SenderAccount.GetAddress(), mockModule},
+		{"module without handler", suite.chainA.SenderAccount.GetAddress(), "unknown"},
+	}
+
+	for _, tc := range testCases {
+		msg := transfertypes.NewMsgTransfer(
+			suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+			sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1)), tc.sender.String(),
+			suite.chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(1, 110), 0,
+		)
+		msg.Memo = fmt.Sprintf(`{"ibc_callback":%q}`, tc.module)
+
+		_, err := appA.TransferKeeper.Transfer(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+		suite.Require().ErrorIs(err, types.ErrInvalidCallback, tc.name)
+	}
+}
+
+func (suite *IBCHooksTestSuite) TestGenesis() {
+	suite.registerCallbackHandler()
+	suite.sendFromModule(suite.chainB.SenderAccount.GetAddress().String(), 0)
+
+	gs := chaosApp(suite.chainA).IBCHooksKeeper.ExportGenesis(suite.chainA.GetContext())
+	suite.Require().NoError(gs.Validate())
+	suite.Require().Len(gs.PacketCallbacks, 1)
+
+	appB := chaosApp(suite.chainB)
+	ctxB := suite.chainB.GetContext()
+	appB.IBCHooksKeeper.InitGenesis(ctxB, *gs)
+	suite.Require().Equal(gs, appB.IBCHooksKeeper.ExportGenesis(ctxB))
+
+	gs.PacketCallbacks = append(gs.PacketCallbacks, gs.PacketCallbacks[0])
+	suite.Require().Error(gs.Validate())
+}
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	transfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v5/modules/core/exported"
+
+	"github.com/cosmos-builders/chaos/x/ibchooks/types"
+)
+
+// packetCallback returns the callback requested by the memo of a packet to
+// be sent, or nil if there is none. A callback can only be requested by a
+// module with a registered handler, for the packets sent by its module
+// account.
+func (k Keeper) packetCallback(packet ibcexported.PacketI) (*types.PacketCallback, error) {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		// not an ICS-20 packet
+		return nil, nil
+	}
+
+	metadata, ok := types.ParsePacketMetadata(data.Memo)
+	if !ok || metadata.Callback == "" {
+		return nil, nil
+	}
+
+	if !k.callbackRouter.HasRoute(metadata.Callback) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidCallback, "module %s has no packet callback handler", metadata.Callback)
+	}
+	if data.Sender != authtypes.NewModuleAddress(metadata.Callback).String() {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidCallback, "sender %s is not the module account of %s", data.Sender, metadata.Callback)
+	}
+
+	return &types.PacketCallback{
+		ChannelId: packet.GetSourceChannel(),
+		Sequence:  packet.GetSequence(),
+		Module:    metadata.Callback,
+	}, nil
+}
+
+// AcknowledgementCallback notifies the module that sent a packet of its
+// acknowledgement.
+func (k Keeper) AcknowledgementCallback(ctx sdk.Context, packet channeltypes.Packet, ack channeltypes.Acknowledgement) {
+	k.callback(ctx, packet, func(cacheCtx sdk.Context, handler types.PacketCallbackHandler) error {
+		return handler.OnAcknowledgementPacketCallback(cacheCtx, packet, ack)
+	})
+}
+
+// TimeoutCallback notifies the module that sent a packet of its timeout.
+func (k Keeper) TimeoutCallback(ctx sdk.Context, packet channeltypes.Packet) {
+	k.callback(ctx, packet, func(cacheCtx sdk.Context, handler types.PacketCallbackHandler) error {
+		return handler.OnTimeoutPacketCallback(cacheCtx, packet)
+	})
+}
+
+// callback runs the callback of a packet, if any. The state changes of a
+// failed callback are discarded, it does not fail the packet.
+func (k Keeper) callback(ctx sdk.Context, packet channeltypes.Packet, fn func(sdk.Context, types.PacketCallbackHandler) error) {
+	callback, found := k.GetPacketCallback(ctx, packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return
+	}
+	k.RemovePacketCallback(ctx, callback.ChannelId, callback.Sequence)
+
+	var err error
+	if handler, ok := k.callbackRouter.GetRoute(callback.Module); ok {
+		cacheCtx, writeFn := ctx.CacheContext()
+		if err = fn(cacheCtx, handler); err == nil {
+			writeFn()
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		}
+	} else {
+		err = sdkerrors.Wrapf(types.ErrInvalidCallback, "module %s has no packet callback handler", callback.Module)
+	}
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyModule, callback.Module),
+		sdk.NewAttribute(types.AttributeKeyChannelID, callback.ChannelId),
+		sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(callback.Sequence, 10)),
+		sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(err == nil)),
+	}
+	if err != nil {
+		k.Logger(ctx).Error("packet callback failed", "module", callback.Module, "channel", callback.ChannelId, "sequence", callback.Sequence, "error", err)
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyError, err.Error()))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeCallback, attributes...))
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos-builders/chaos/x/ibchooks/types"
+)
+
+// ExecuteHooks executes the messages of the hooks of a received packet on
+// behalf of the intermediate account that received its funds. The messages
+// are executed atomically and must only be signed by the intermediate
+// account.
+func (k Keeper) ExecuteHooks(ctx sdk.Context, account sdk.AccAddress, hooks types.HooksMetadata) error {
+	msgs, err := hooks.GetMsgs(k.cdc)
+	if err != nil {
+		return err
+	}
+
+	for _, msg := range msgs {
+		for _, signer := range msg.GetSigners() {
+			if !signer.Equals(account) {
+				return sdkerrors.Wrapf(types.ErrInvalidSigner, "%s signed by %s, expected %s", sdk.MsgTypeURL(msg), signer, account)
+			}
+		}
+	}
+
+	cacheCtx, writeFn := ctx.CacheContext()
+	for _, msg := range msgs {
+		handler := k.msgRouter.Handler(msg)
+		if handler == nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "no message handler found for %s", sdk.MsgTypeURL(msg))
+		}
+
+		res, err := handler(cacheCtx, msg)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "failed to execute %s", sdk.MsgTypeURL(msg))
+		}
+
+		// the message events are emitted only if every message succeeds
+		cacheCtx.EventManager().EmitEvents(res.GetEvents())
+	}
+
+	writeFn()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	porttypes "github.com/cosmos/ibc-go/v5/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v5/modules/core/exported"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos-builders/chaos/x/ibchooks/types"
+)
+
+var _ porttypes.ICS4Wrapper = Keeper{}
+
+// Keeper is the ibchooks keeper. It executes the hooks of received packets
+// and is the ICS4Wrapper of the transfer application, recording the callbacks
+// of the packets it sends.
+type Keeper struct {
+	cdc      codec.Codec
+	storeKey storetypes.StoreKey
+
+	msgRouter      types.MessageRouter
+	callbackRouter *types.CallbackRouter
+	ics4Wrapper    porttypes.ICS4Wrapper
+}
+
+// NewKeeper creates a new ibchooks Keeper instance
+func NewKeeper(
+	cdc codec.Codec,
+	key storetypes.StoreKey,
+	msgRouter types.MessageRouter,
+	callbackRouter *types.CallbackRouter,
+	ics4Wrapper porttypes.ICS4Wrapper,
+) Keeper {
+	return Keeper{
+		cdc:            cdc,
+		storeKey:       key,
+		msgRouter:      msgRouter,
+		callbackRouter: callbackRouter,
+		ics4Wrapper:    ics4Wrapper,
+	}
+}
+
+// Logger returns the application logger, scoped to the associated module
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// CallbackRouter returns the router of the packet callback handlers. Modules
+// add their handler to it while the application is built.
+func (k Keeper) CallbackRouter() *types.CallbackRouter {
+	return k.callbackRouter
+}
+
+// SendPacket implements the ICS4Wrapper interface. The callback requested by
+// the memo of the packet, if any, is recorded once the packet is sent.
+func (k Keeper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI) error {
+	callback, err := k.packetCallback(packet)
+	if err != nil {
+		return err
+	}
+
+	if err := k.ics4Wrapper.SendPacket(ctx, chanCap, packet); err != nil {
+		return err
+	}
+
+	if callback != nil {
+		k.SetPacketCallback(ctx, *callback)
+	}
+
+	return nil
+}
+
+// WriteAcknowledgement implements the ICS4Wrapper interface
+func (k Keeper) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI, ack ibcexported.Acknowledgement) error {
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// GetAppVersion implements the ICS4Wrapper interface
+func (k Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
+// SetPacketCallback stores the callback of a sent packet.
+func (k Keeper) SetPacketCallback(ctx sdk.Context, callback types.PacketCallback) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PacketCallbackKey(callback.ChannelId, callback.Sequence), k.cdc.MustMarshal(&callback))
+}
+
+// GetPacketCallback returns the callback of the packet sent with the given
+// channel and sequence.
+func (k Keeper) GetPacketCallback(ctx sdk.Context, channelID string, sequence uint64) (types.PacketCallback, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PacketCallbackKey(channelID, sequence))
+	if bz == nil {
+		return types.PacketCallback{}, false
+	}
+
+	var callback types.PacketCallback
+	k.cdc.MustUnmarshal(bz, &callback)
+
+	return callback, true
+}
+
+// RemovePacketCallback deletes the callback of a packet.
+func (k Keeper) RemovePacketCallback(ctx sdk.Context, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PacketCallbackKey(channelID, sequence))
+}
+
+// GetAllPacketCallbacks returns every packet callback.
+func (k Keeper) GetAllPacketCallbacks(ctx sdk.Context) []types.PacketCallback {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PacketCallbackKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var callbacks []types.PacketCallback
+	for ; iterator.Valid(); iterator.Next() {
+		var callback types.PacketCallback
+		k.cdc.MustUnmarshal(iterator.Value(), &callback)
+		callbacks = append(callbacks, callback)
+	}
+
+	return callbacks
+}
+
+// InitGenesis initializes the ibchooks state from a genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
+	for _, callback := range gs.PacketCallbacks {
+		k.SetPacketCallback(ctx, callback)
+	}
+}
+
+// ExportGenesis exports the ibchooks state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetAllPacketCallbacks(ctx))
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+)
+
+// PacketCallbackHandler is implemented by the modules that want to learn the
+// outcome of the ICS-20 packets sent by their module account. A callback
+// error is logged and does not affect the packet.
+type PacketCallbackHandler interface {
+	// OnAcknowledgementPacketCallback is called once the packet is
+	// acknowledged, successfully or not.
+	OnAcknowledgementPacketCallback(ctx sdk.Context, packet channeltypes.Packet, ack channeltypes.Acknowledgement) error
+	// OnTimeoutPacketCallback is called once the packet timed out.
+	OnTimeoutPacketCallback(ctx sdk.Context, packet channeltypes.Packet) error
+}
+
+// CallbackRouter maps module names to their packet callback handlers.
+type CallbackRouter struct {
+	routes map[string]PacketCallbackHandler
+}
+
+// NewCallbackRouter creates an empty CallbackRouter.
+func NewCallbackRouter() *CallbackRouter {
+	return &CallbackRouter{
+		routes: make(map[string]PacketCallbackHandler),
+	}
+}
+
+// AddRoute registers the packet callback handler of a module. It panics if the
+// module already has one.
+func (rtr *CallbackRouter) AddRoute(module string, handler PacketCallbackHandler) *CallbackRouter {
+	if rtr.HasRoute(module) {
+		panic(fmt.Sprintf("packet callback handler for module %s has already been registered", module))
+	}
+
+	rtr.routes[module] = handler
+	return rtr
+}
+
+// HasRoute returns true if the module has a packet callback handler.
+func (rtr *CallbackRouter) HasRoute(module string) bool {
+	_, ok := rtr.routes[module]
+	return ok
+}
+
+// GetRoute returns the packet callback handler of a module.
+func (rtr *CallbackRouter) GetRoute(module string) (PacketCallbackHandler, bool) {
+	handler, ok := rtr.routes[module]
+	return handler, ok
+}
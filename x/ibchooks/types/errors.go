@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ibchooks sentinel errors
+var (
+	ErrInvalidHooksMetadata = sdkerrors.Register(ModuleName, 2, "invalid hooks metadata")
+	ErrInvalidSigner        = sdkerrors.Register(ModuleName, 3, "message signer is not the intermediate account")
+	ErrInvalidCallback      = sdkerrors.Register(ModuleName, 4, "invalid packet callback")
+)
@@ -0,0 +1,14 @@
+package types
+
+// ibchooks events
+const (
+	EventTypeHooks    = "ibc_hooks"
+	EventTypeCallback = "ibc_hooks_callback"
+
+	AttributeKeyIntermediateAccount = "intermediate_account"
+	AttributeKeyChannelID           = "channel_id"
+	AttributeKeySequence            = "sequence"
+	AttributeKeyModule              = "module"
+	AttributeKeySuccess             = "success"
+	AttributeKeyError               = "error"
+)
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"fmt"
+
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+)
+
+// DefaultGenesis returns the default ibchooks genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{}
+}
+
+// NewGenesisState creates a new ibchooks GenesisState
+func NewGenesisState(packetCallbacks []PacketCallback) *GenesisState {
+	return &GenesisState{PacketCallbacks: packetCallbacks}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool)
+	for _, c := range gs.PacketCallbacks {
+		if err := host.ChannelIdentifierValidator(c.ChannelId); err != nil {
+			return err
+		}
+		if c.Module == "" {
+			return fmt.Errorf("packet callback %s/%d has no module", c.ChannelId, c.Sequence)
+		}
+
+		key := string(PacketCallbackKey(c.ChannelId, c.Sequence))
+		if seen[key] {
+			return fmt.Errorf("duplicate packet callback %s/%d", c.ChannelId, c.Sequence)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName defines the IBC hooks middleware module name
+	ModuleName = "ibchooks"
+
+	// StoreKey is the store key string for the ibchooks module. It differs
+	// from the module name, which has the IBC store key as a prefix.
+	StoreKey = "hooks-for-ibc"
+
+	// RouterKey is the message route for the ibchooks module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the ibchooks module
+	QuerierRoute = ModuleName
+)
+
+// PacketCallbackKeyPrefix is the prefix of the packet callback store.
+var PacketCallbackKeyPrefix = []byte{0x01}
+
+// PacketCallbackKey returns the store key of the callback of the packet sent
+// with the given channel and sequence.
+func PacketCallbackKey(channelID string, sequence uint64) []byte {
+	return append(PacketCallbackKeyPrefix, []byte(fmt.Sprintf("%s/%d", channelID, sequence))...)
+}
+
+// IntermediateAccount returns the account that receives funds sent by sender
+// over the given channel and executes the messages of their hooks. The
+// account is derived so that nobody holds its private key, and is scoped to
+// the sender so that nobody else can spend from it.
+func IntermediateAccount(channelID, sender string) sdk.AccAddress {
+	return address.Module(ModuleName, []byte(channelID+"/"+sender))
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// PacketMetadata is the part of an ICS-20 memo read by the IBC hooks.
+//
+// The hooks of a received packet are messages executed by the intermediate
+// account of its sender once the funds are received, e.g.
+//
+//	{"hooks":{"msgs":[{"@type":"/cosmos.staking.v1beta1.MsgDelegate","delegator_address":"chaos1...","validator_address":"chaosvaloper1...","amount":{"denom":"stake","amount":"100"}}]}}
+//
+// The callback of a sent packet names the module, whose module account must
+// be the sender, to notify of the outcome of the packet, e.g.
+//
+//	{"ibc_callback":"mymodule"}
+type PacketMetadata struct {
+	Hooks    *HooksMetadata `json:"hooks,omitempty"`
+	Callback string         `json:"ibc_callback,omitempty"`
+}
+
+// HooksMetadata holds the messages to execute upon receipt of a packet.
+type HooksMetadata struct {
+	// Msgs are JSON encoded sdk.Msg wrapped in an Any.
+	Msgs []json.RawMessage `json:"msgs"`
+}
+
+// ParsePacketMetadata parses an ICS-20 memo. It returns false if the memo is
+// not a JSON object.
+func ParsePacketMetadata(memo string) (PacketMetadata, bool) {
+	var metadata PacketMetadata
+	if memo == "" {
+		return metadata, false
+	}
+
+	if err := json.Unmarshal([]byte(memo), &metadata); err != nil {
+		// the memo is not meant for us
+		return PacketMetadata{}, false
+	}
+
+	return metadata, true
+}
+
+// GetMsgs decodes and statelessly validates the messages of the hooks.
+func (m HooksMetadata) GetMsgs(cdc codec.JSONCodec) ([]sdk.Msg, error) {
+	if len(m.Msgs) == 0 {
+		return nil, sdkerrors.Wrap(ErrInvalidHooksMetadata, "hooks must contain at least one message")
+	}
+
+	msgs := make([]sdk.Msg, len(m.Msgs))
+	for i, bz := range m.Msgs {
+		if err := cdc.UnmarshalInterfaceJSON(bz, &msgs[i]); err != nil {
+			return nil, sdkerrors.Wrapf(ErrInvalidHooksMetadata, "cannot decode message %d: %s", i, err)
+		}
+		if err := msgs[i].ValidateBasic(); err != nil {
+			return nil, sdkerrors.Wrapf(err, "message %d", i)
+		}
+	}
+
+	return msgs, nil
+}
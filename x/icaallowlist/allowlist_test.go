@@ -0,0 +1,274 @@
+package icaallowlist_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	icatypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+
+	"github.com/cosmos-builders/chaos/app"
+	"github.com/cosmos-builders/chaos/x/icaallowlist"
+	"github.com/cosmos-builders/chaos/x/icaallowlist/types"
+	intertxtypes "github.com/cosmos-builders/chaos/x/intertx/types"
+)
+
+var msgSendTypeURL = sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+func chaosApp(chain *ibctesting.TestChain) *app.App {
+	return chain.App.(*app.App)
+}
+
+// ICAAllowlistTestSuite executes messages through an interchain account
+// registered by chain A on chain B.
+type ICAAllowlistTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path       *ibctesting.Path
+	owner      string
+	icaAddress sdk.AccAddress
+}
+
+func TestICAAllowlistTestSuite(t *testing.T) {
+	suite.Run(t, new(ICAAllowlistTestSuite))
+}
+
+func (suite *ICAAllowlistTestSuite) SetupTest() {
+	ibctesting.DefaultTestingAppInit = app.SetupTestingApp
+
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	version := string(icatypes.ModuleCdc.MustMarshalJSON(&icatypes.Metadata{
+		Version:                icatypes.Version,
+		ControllerConnectionId: ibctesting.FirstConnectionID,
+		HostConnectionId:       ibctesting.FirstConnectionID,
+		Encoding:               icatypes.EncodingProtobuf,
+		TxType:                 icatypes.TxTypeSDKMultiMsg,
+	}))
+
+	suite.path = ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.path.EndpointA.ChannelConfig.PortID = icatypes.PortID
+	suite.path.EndpointB.ChannelConfig.PortID = icatypes.PortID
+	suite.path.EndpointA.ChannelConfig.Order = channeltypes.ORDERED
+	suite.path.EndpointB.ChannelConfig.Order = channeltypes.ORDERED
+	suite.path.EndpointA.ChannelConfig.Version = version
+	suite.path.EndpointB.ChannelConfig.Version = version
+	suite.coordinator.SetupConnections(suite.path)
+
+	// the controller channel is opened by registering an interchain account
+	suite.owner = suite.chainA.SenderAccount.GetAddress().String()
+	portID, err := icatypes.NewControllerPortID(suite.owner)
+	suite.Require().NoError(err)
+
+	channelSequence := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextChannelSequence(suite.chainA.GetContext())
+	_, err = suite.chainA.SendMsgs(intertxtypes.NewMsgRegisterAccount(suite.owner, suite.path.EndpointA.ConnectionID, version))
+	suite.Require().NoError(err)
+
+	suite.path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(channelSequence)
+	suite.path.EndpointA.ChannelConfig.PortID = portID
+	suite.Require().NoError(suite.path.EndpointB.ChanOpenTry())
+	suite.Require().NoError(suite.path.EndpointA.ChanOpenAck())
+	suite.Require().NoError(suite.path.EndpointB.ChanOpenConfirm())
+
+	icaAddress, found := chaosApp(suite.chainB).ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), suite.path.EndpointB.ConnectionID, portID)
+	suite.Require().True(found)
+	suite.icaAddress, err = sdk.AccAddressFromBech32(icaAddress)
+	suite.Require().NoError(err)
+
+	_, err = suite.chainB.SendMsgs(banktypes.NewMsgSend(suite.chainB.SenderAccount.GetAddress(), suite.icaAddress, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000))))
+	suite.Require().NoError(err)
+}
+
+// submitTx executes msgs through the interchain account and returns the
+// acknowledgement written by the host chain.
+func (suite *ICAAllowlistTestSuite) submitTx(msgs ...sdk.Msg) channeltypes.Acknowledgement {
+	msg, err := intertxtypes.NewMsgSubmitTx(suite.owner, suite.path.EndpointA.ConnectionID, msgs, uint64(time.Hour))
+	suite.Require().NoError(err)
+
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.path.EndpointB.UpdateClient())
+	res, err = suite.path.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+	bz, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+	suite.Require().NoError(suite.path.EndpointA.AcknowledgePacket(packet, bz))
+
+	var ack channeltypes.Acknowledgement
+	suite.Require().NoError(icatypes.ModuleCdc.UnmarshalJSON(bz, &ack))
+
+	return ack
+}
+
+// executeProposal runs a proposal through the icaallowlist proposal handler
+// of chain B.
+func (suite *ICAAllowlistTestSuite) executeProposal(content govv1beta1.Content) error {
+	suite.Require().NoError(content.ValidateBasic())
+	suite.Require().Equal(types.RouterKey, content.ProposalRoute())
+
+	handler := icaallowlist.NewICAAllowlistProposalHandler(chaosApp(suite.chainB).ICAAllowlistKeeper)
+	if err := handler(suite.chainB.GetContext(), content); err != nil {
+		return err
+	}
+	suite.chainB.NextBlock()
+
+	return nil
+}
+
+func (suite *ICAAllowlistTestSuite) queryAllowedMessages() *types.QueryAllowedMessagesResponse {
+	res, err := chaosApp(suite.chainB).ICAAllowlistKeeper.AllowedMessages(
+		sdk.WrapSDKContext(suite.chainB.GetContext()),
+		&types.QueryAllowedMessagesRequest{},
+	)
+	suite.Require().NoError(err)
+
+	return res
+}
+
+func (suite *ICAAllowlistTestSuite) TestDefaultAllowlist() {
+	hostApp := chaosApp(suite.chainB)
+	suite.Require().Equal(types.DefaultHostAllowMessages, hostApp.ICAHostKeeper.GetParams(suite.chainB.GetContext()).AllowMessages)
+
+	res := suite.queryAllowedMessages()
+	suite.Require().True(res.HostEnabled)
+	suite.Require().False(res.AllowAll)
+	suite.Require().Len(res.AllowedMessages, len(types.DefaultHostAllowMessages))
+	for i, msg := range res.AllowedMessages {
+		suite.Require().Equal(types.DefaultHostAllowMessages[i], msg.TypeUrl)
+		suite.Require().True(msg.Registered, msg.TypeUrl)
+	}
+}
+
+func (suite *ICAAllowlistTestSuite) TestDisallowedMessage() {
+	hostApp := chaosApp(suite.chainB)
+	recipient := suite.chainB.SenderAccounts[1].SenderAccount.GetAddress()
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	balance := func() sdk.Coins {
+		return hostApp.BankKeeper.GetAllBalances(suite.chainB.GetContext(), suite.icaAddress)
+	}
+
+	// messages outside of the default allowlist are rejected
+	deposit := govv1beta1.NewMsgDeposit(suite.icaAddress, 1, amount)
+	suite.Require().NotContains(types.DefaultHostAllowMessages, sdk.MsgTypeURL(deposit))
+	suite.Require().False(suite.submitTx(deposit).Success())
+
+	// default messages are executed until governance removes them
+	suite.Require().True(suite.submitTx(banktypes.NewMsgSend(suite.icaAddress, recipient, amount)).Success())
+
+	suite.Require().NoError(suite.executeProposal(types.NewRemoveAllowedMessagesProposal("title", "description", []string{msgSendTypeURL})))
+	before := balance()
+	suite.Require().False(suite.submitTx(banktypes.NewMsgSend(suite.icaAddress, recipient, amount)).Success())
+	suite.Require().Equal(before, balance())
+
+	suite.Require().NoError(suite.executeProposal(types.NewAddAllowedMessagesProposal("title", "description", []string{msgSendTypeURL})))
+	suite.Require().True(suite.submitTx(banktypes.NewMsgSend(suite.icaAddress, recipient, amount)).Success())
+	suite.Require().Equal(before.Sub(amount...), balance())
+}
+
+func (suite *ICAAllowlistTestSuite) TestProposals() {
+	depositTypeURL := sdk.MsgTypeURL(&govv1beta1.MsgDeposit{})
+
+	testCases := []struct {
+		name    string
+		content govv1beta1.Content
+		expErr  error
+	}{
+		{
+			"add unregistered message",
+			types.NewAddAllowedMessagesProposal("title", "description", []string{"/chaos.unknown.MsgUnknown"}),
+			types.ErrUnregisteredMessage,
+		},
+		{
+			"add allowed message",
+			types.NewAddAllowedMessagesProposal("title", "description", []string{depositTypeURL, msgSendTypeURL}),
+			types.ErrAlreadyAllowed,
+		},
+		{
+			"remove message not allowed",
+			types.NewRemoveAllowedMessagesProposal("title", "description", []string{depositTypeURL}),
+			types.ErrNotAllowed,
+		},
+		{
+			"add message",
+			types.NewAddAllowedMessagesProposal("title", "description", []string{depositTypeURL}),
+			nil,
+		},
+		{
+			"add every message to a non-empty allowlist",
+			types.NewAddAllowedMessagesProposal("title", "description", []string{types.AllowAllMessages}),
+			types.ErrInvalidMessages,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := suite.executeProposal(tc.content)
+		if tc.expErr != nil {
+			suite.Require().ErrorIs(err, tc.expErr, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+		}
+	}
+
+	res := suite.queryAllowedMessages()
+	suite.Require().False(res.AllowAll)
+	suite.Require().Len(res.AllowedMessages, len(types.DefaultHostAllowMessages)+1)
+	suite.Require().Equal(types.AllowedMessage{TypeUrl: depositTypeURL, Registered: true}, res.AllowedMessages[len(res.AllowedMessages)-1])
+}
+
+func (suite *ICAAllowlistTestSuite) TestAllowAllMessages() {
+	recipient := suite.chainB.SenderAccounts[1].SenderAccount.GetAddress()
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	msgSend := banktypes.NewMsgSend(suite.icaAddress, recipient, amount)
+
+	// the wildcard is only added to an empty allowlist, as the host ignores
+	// it otherwise
+	suite.Require().NoError(suite.executeProposal(types.NewRemoveAllowedMessagesProposal("title", "description", types.DefaultHostAllowMessages)))
+	suite.Require().False(suite.submitTx(msgSend).Success())
+
+	suite.Require().NoError(suite.executeProposal(types.NewAddAllowedMessagesProposal("title", "description", []string{types.AllowAllMessages})))
+	res := suite.queryAllowedMessages()
+	suite.Require().True(res.AllowAll)
+	suite.Require().Empty(res.AllowedMessages)
+	suite.Require().True(suite.submitTx(msgSend).Success())
+
+	// no message is added to the wildcard, which would disable it
+	err := suite.executeProposal(types.NewAddAllowedMessagesProposal("title", "description", []string{msgSendTypeURL}))
+	suite.Require().ErrorIs(err, types.ErrInvalidMessages)
+	suite.Require().True(suite.queryAllowedMessages().AllowAll)
+
+	suite.Require().NoError(suite.executeProposal(types.NewRemoveAllowedMessagesProposal("title", "description", []string{types.AllowAllMessages})))
+	suite.Require().False(suite.queryAllowedMessages().AllowAll)
+	suite.Require().False(suite.submitTx(msgSend).Success())
+}
+
+func (suite *ICAAllowlistTestSuite) TestInvalidMessages() {
+	for _, messages := range [][]string{
+		nil,
+		{"cosmos.bank.v1beta1.MsgSend"},
+		{msgSendTypeURL, msgSendTypeURL},
+		{types.AllowAllMessages, msgSendTypeURL},
+	} {
+		content := types.NewAddAllowedMessagesProposal("title", "description", messages)
+		suite.Require().ErrorIs(content.ValidateBasic(), types.ErrInvalidMessages)
+		content = types.NewRemoveAllowedMessagesProposal("title", "description", messages)
+		suite.Require().ErrorIs(content.ValidateBasic(), types.ErrInvalidMessages)
+	}
+}
@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/cosmos-builders/chaos/x/icaallowlist/types"
+)
+
+// NewCmdSubmitAddAllowedMessagesProposal implements a command handler for
+// submitting an add ICA host allowed messages proposal transaction.
+func NewCmdSubmitAddAllowedMessagesProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-ica-host-allowed-messages [type-url]...",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Submit a proposal to allow interchain accounts to execute messages",
+		Long: "Submit a proposal to allow interchain accounts to execute messages along with an initial deposit.\n" +
+			"Messages are given by type URL, e.g. /cosmos.bank.v1beta1.MsgSend, and must be registered on this chain.\n" +
+			"The wildcard \"*\" allows every message: it must be added alone to an empty allowlist.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(title, description string) govv1beta1.Content {
+				return types.NewAddAllowedMessagesProposal(title, description, args)
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitRemoveAllowedMessagesProposal implements a command handler for
+// submitting a remove ICA host allowed messages proposal transaction.
+func NewCmdSubmitRemoveAllowedMessagesProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-ica-host-allowed-messages [type-url]...",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Submit a proposal to stop interchain accounts from executing messages",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(title, description string) govv1beta1.Content {
+				return types.NewRemoveAllowedMessagesProposal(title, description, args)
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+func submitProposal(cmd *cobra.Command, newContent func(title, description string) govv1beta1.Content) error {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	title, err := cmd.Flags().GetString(govcli.FlagTitle) //nolint:staticcheck // need this till full govv1 conversion.
+	if err != nil {
+		return err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription) //nolint:staticcheck // need this till full govv1 conversion.
+	if err != nil {
+		return err
+	}
+
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return err
+	}
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return err
+	}
+
+	msg, err := govv1beta1.NewMsgSubmitProposal(newContent(title, description), deposit, clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+
+	if err = msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
+
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")             //nolint:staticcheck // need this till full govv1 conversion.
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal") //nolint:staticcheck // need this till full govv1 conversion.
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+}
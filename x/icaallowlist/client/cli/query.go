@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/cosmos-builders/chaos/x/icaallowlist/types"
+)
+
+// GetQueryCmd creates and returns the icaallowlist query command
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(getAllowedMessagesCmd())
+
+	return cmd
+}
+
+func getAllowedMessagesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allowed-messages",
+		Short: "Query the messages interchain accounts are allowed to execute on this chain",
+		Long: "Query the messages interchain accounts are allowed to execute on this chain.\n" +
+			"Each message is flagged as registered if this chain knows how to route it.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AllowedMessages(cmd.Context(), &types.QueryAllowedMessagesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,12 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/cosmos-builders/chaos/x/icaallowlist/client/cli"
+)
+
+var (
+	AddAllowedMessagesProposalHandler    = govclient.NewProposalHandler(cli.NewCmdSubmitAddAllowedMessagesProposal)
+	RemoveAllowedMessagesProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitRemoveAllowedMessagesProposal)
+)
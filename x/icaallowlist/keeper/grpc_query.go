@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos-builders/chaos/x/icaallowlist/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// AllowedMessages implements the Query/AllowedMessages gRPC method
+func (k Keeper) AllowedMessages(goCtx context.Context, req *types.QueryAllowedMessagesRequest) (*types.QueryAllowedMessagesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.icaHostKeeper.GetParams(ctx)
+
+	res := &types.QueryAllowedMessagesResponse{
+		HostEnabled:     params.HostEnabled,
+		AllowedMessages: make([]types.AllowedMessage, 0, len(params.AllowMessages)),
+	}
+	// the host ignores AllowAllMessages unless it is the single entry
+	if len(params.AllowMessages) == 1 && params.AllowMessages[0] == types.AllowAllMessages {
+		res.AllowAll = true
+		return res, nil
+	}
+	for _, msg := range params.AllowMessages {
+		res.AllowedMessages = append(res.AllowedMessages, types.AllowedMessage{
+			TypeUrl:    msg,
+			Registered: k.IsRegisteredMsg(msg),
+		})
+	}
+
+	return res, nil
+}
@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos-builders/chaos/x/icaallowlist/types"
+)
+
+// Keeper is the icaallowlist keeper. It manages the message allowlist held
+// in the params of the interchain accounts host submodule.
+type Keeper struct {
+	interfaceRegistry codectypes.InterfaceRegistry
+
+	icaHostKeeper types.ICAHostKeeper
+}
+
+// NewKeeper creates a new icaallowlist Keeper instance
+func NewKeeper(interfaceRegistry codectypes.InterfaceRegistry, icaHostKeeper types.ICAHostKeeper) Keeper {
+	return Keeper{
+		interfaceRegistry: interfaceRegistry,
+		icaHostKeeper:     icaHostKeeper,
+	}
+}
+
+// Logger returns the application logger, scoped to the associated module
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// IsRegisteredMsg returns true if typeURL is the type URL of a message
+// registered in the interface registry.
+func (k Keeper) IsRegisteredMsg(typeURL string) bool {
+	for _, impl := range k.interfaceRegistry.ListImplementations(sdk.MsgInterfaceProtoName) {
+		if impl == typeURL {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetAllowedMessages returns the allowlist of the interchain accounts host.
+func (k Keeper) GetAllowedMessages(ctx sdk.Context) []string {
+	return k.icaHostKeeper.GetParams(ctx).AllowMessages
+}
+
+// AddAllowedMessages allows interchain accounts to execute messages. Every
+// message must be registered in the interface registry and not allowed yet.
+// As the host allows every message only if AllowAllMessages is its single
+// entry, AllowAllMessages is only added to an empty allowlist, and no message
+// is added to it.
+func (k Keeper) AddAllowedMessages(ctx sdk.Context, messages []string) error {
+	params := k.icaHostKeeper.GetParams(ctx)
+
+	allowed := make(map[string]bool)
+	for _, msg := range params.AllowMessages {
+		allowed[msg] = true
+	}
+
+	if allowed[types.AllowAllMessages] {
+		return sdkerrors.Wrapf(types.ErrInvalidMessages, "every message is allowed by %q, remove it first", types.AllowAllMessages)
+	}
+	for _, msg := range messages {
+		if msg == types.AllowAllMessages && (len(params.AllowMessages) > 0 || len(messages) > 1) {
+			return sdkerrors.Wrapf(types.ErrInvalidMessages, "%q must be the only allowed message, remove the others first", types.AllowAllMessages)
+		}
+	}
+
+	for _, msg := range messages {
+		if msg != types.AllowAllMessages && !k.IsRegisteredMsg(msg) {
+			return sdkerrors.Wrap(types.ErrUnregisteredMessage, msg)
+		}
+		if allowed[msg] {
+			return sdkerrors.Wrap(types.ErrAlreadyAllowed, msg)
+		}
+	}
+
+	params.AllowMessages = append(params.AllowMessages, messages...)
+	k.icaHostKeeper.SetParams(ctx, params)
+
+	k.Logger(ctx).Info("allowed interchain account messages", "messages", messages)
+
+	return nil
+}
+
+// RemoveAllowedMessages stops interchain accounts from executing messages.
+// Every message must be allowed.
+func (k Keeper) RemoveAllowedMessages(ctx sdk.Context, messages []string) error {
+	params := k.icaHostKeeper.GetParams(ctx)
+
+	removed := make(map[string]bool)
+	for _, msg := range messages {
+		removed[msg] = true
+	}
+
+	allowMessages := make([]string, 0, len(params.AllowMessages))
+	for _, msg := range params.AllowMessages {
+		if removed[msg] {
+			delete(removed, msg)
+			continue
+		}
+		allowMessages = append(allowMessages, msg)
+	}
+
+	for _, msg := range messages {
+		if removed[msg] {
+			return sdkerrors.Wrap(types.ErrNotAllowed, msg)
+		}
+	}
+
+	params.AllowMessages = allowMessages
+	k.icaHostKeeper.SetParams(ctx, params)
+
+	k.Logger(ctx).Info("disallowed interchain account messages", "messages", messages)
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos-builders/chaos/x/icaallowlist/types"
+)
+
+// HandleAddAllowedMessagesProposal handles an AddAllowedMessagesProposal
+func (k Keeper) HandleAddAllowedMessagesProposal(ctx sdk.Context, p *types.AddAllowedMessagesProposal) error {
+	return k.AddAllowedMessages(ctx, p.Messages)
+}
+
+// HandleRemoveAllowedMessagesProposal handles a RemoveAllowedMessagesProposal
+func (k Keeper) HandleRemoveAllowedMessagesProposal(ctx sdk.Context, p *types.RemoveAllowedMessagesProposal) error {
+	return k.RemoveAllowedMessages(ctx, p.Messages)
+}
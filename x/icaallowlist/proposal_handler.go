@@ -0,0 +1,25 @@
+package icaallowlist
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/cosmos-builders/chaos/x/icaallowlist/keeper"
+	"github.com/cosmos-builders/chaos/x/icaallowlist/types"
+)
+
+// NewICAAllowlistProposalHandler defines the icaallowlist proposal handler
+func NewICAAllowlistProposalHandler(k keeper.Keeper) govv1beta1.Handler {
+	return func(ctx sdk.Context, content govv1beta1.Content) error {
+		switch c := content.(type) {
+		case *types.AddAllowedMessagesProposal:
+			return k.HandleAddAllowedMessagesProposal(ctx, c)
+		case *types.RemoveAllowedMessagesProposal:
+			return k.HandleRemoveAllowedMessagesProposal(ctx, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized icaallowlist proposal content type: %T", c)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	transfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+)
+
+// AllowAllMessages is the allowlist entry allowing every message.
+const AllowAllMessages = "*"
+
+// DefaultHostAllowMessages are the messages interchain accounts hosted on
+// chaos may execute unless governance changes it: moving funds, staking,
+// claiming rewards and voting. Messages executing other messages, such as
+// authz MsgExec, are left out as they would bypass the allowlist.
+var DefaultHostAllowMessages = []string{
+	sdk.MsgTypeURL(&banktypes.MsgSend{}),
+	sdk.MsgTypeURL(&banktypes.MsgMultiSend{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgBeginRedelegate{}),
+	sdk.MsgTypeURL(&distrtypes.MsgWithdrawDelegatorReward{}),
+	sdk.MsgTypeURL(&distrtypes.MsgSetWithdrawAddress{}),
+	sdk.MsgTypeURL(&govv1beta1.MsgVote{}),
+	sdk.MsgTypeURL(&govv1beta1.MsgVoteWeighted{}),
+	sdk.MsgTypeURL(&govv1.MsgVote{}),
+	sdk.MsgTypeURL(&govv1.MsgVoteWeighted{}),
+	sdk.MsgTypeURL(&transfertypes.MsgTransfer{}),
+}
+
+// ValidateMessages checks that messages is a non-empty list of distinct type
+// URLs, or AllowAllMessages alone.
+func ValidateMessages(messages []string) error {
+	if len(messages) == 0 {
+		return sdkerrors.Wrap(ErrInvalidMessages, "messages cannot be empty")
+	}
+
+	seen := make(map[string]bool)
+	for _, msg := range messages {
+		if msg != AllowAllMessages && !strings.HasPrefix(msg, "/") {
+			return sdkerrors.Wrapf(ErrInvalidMessages, "%q is neither a type URL nor %q", msg, AllowAllMessages)
+		}
+		if msg == AllowAllMessages && len(messages) > 1 {
+			return sdkerrors.Wrapf(ErrInvalidMessages, "%q cannot be listed with other messages", AllowAllMessages)
+		}
+		if seen[msg] {
+			return sdkerrors.Wrapf(ErrInvalidMessages, "duplicate message %s", msg)
+		}
+		seen[msg] = true
+	}
+
+	return nil
+}
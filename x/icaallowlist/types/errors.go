@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// icaallowlist sentinel errors
+var (
+	ErrInvalidMessages     = sdkerrors.Register(ModuleName, 2, "invalid message type URLs")
+	ErrUnregisteredMessage = sdkerrors.Register(ModuleName, 3, "message not registered in the interface registry")
+	ErrAlreadyAllowed      = sdkerrors.Register(ModuleName, 4, "message already allowed")
+	ErrNotAllowed          = sdkerrors.Register(ModuleName, 5, "message not allowed")
+)
@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icahosttypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/host/types"
+)
+
+// ICAHostKeeper defines the expected interchain accounts host keeper
+type ICAHostKeeper interface {
+	GetParams(ctx sdk.Context) icahosttypes.Params
+	SetParams(ctx sdk.Context, params icahosttypes.Params)
+}
@@ -0,0 +1,12 @@
+package types
+
+const (
+	// ModuleName defines the interchain accounts host allowlist module name
+	ModuleName = "icaallowlist"
+
+	// RouterKey is the governance route for the icaallowlist module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the icaallowlist module
+	QuerierRoute = ModuleName
+)
@@ -0,0 +1,107 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+const (
+	// ProposalTypeAddAllowedMessages defines the type for an AddAllowedMessagesProposal
+	ProposalTypeAddAllowedMessages = "AddICAHostAllowedMessages"
+	// ProposalTypeRemoveAllowedMessages defines the type for a RemoveAllowedMessagesProposal
+	ProposalTypeRemoveAllowedMessages = "RemoveICAHostAllowedMessages"
+)
+
+var (
+	_ govv1beta1.Content = &AddAllowedMessagesProposal{}
+	_ govv1beta1.Content = &RemoveAllowedMessagesProposal{}
+)
+
+func init() {
+	govv1beta1.RegisterProposalType(ProposalTypeAddAllowedMessages)
+	govv1beta1.RegisterProposalType(ProposalTypeRemoveAllowedMessages)
+}
+
+// NewAddAllowedMessagesProposal creates a new AddAllowedMessagesProposal.
+func NewAddAllowedMessagesProposal(title, description string, messages []string) govv1beta1.Content {
+	return &AddAllowedMessagesProposal{
+		Title:       title,
+		Description: description,
+		Messages:    messages,
+	}
+}
+
+// GetTitle returns the title of an add allowed messages proposal.
+func (p *AddAllowedMessagesProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an add allowed messages proposal.
+func (p *AddAllowedMessagesProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an add allowed messages proposal.
+func (p *AddAllowedMessagesProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an add allowed messages proposal.
+func (p *AddAllowedMessagesProposal) ProposalType() string {
+	return ProposalTypeAddAllowedMessages
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *AddAllowedMessagesProposal) ValidateBasic() error {
+	if err := govv1beta1.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	return ValidateMessages(p.Messages)
+}
+
+// String implements the Stringer interface.
+func (p AddAllowedMessagesProposal) String() string {
+	return fmt.Sprintf(`Add ICA Host Allowed Messages Proposal:
+  Title:       %s
+  Description: %s
+  Messages:    %s
+`, p.Title, p.Description, strings.Join(p.Messages, ", "))
+}
+
+// NewRemoveAllowedMessagesProposal creates a new RemoveAllowedMessagesProposal.
+func NewRemoveAllowedMessagesProposal(title, description string, messages []string) govv1beta1.Content {
+	return &RemoveAllowedMessagesProposal{
+		Title:       title,
+		Description: description,
+		Messages:    messages,
+	}
+}
+
+// GetTitle returns the title of a remove allowed messages proposal.
+func (p *RemoveAllowedMessagesProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a remove allowed messages proposal.
+func (p *RemoveAllowedMessagesProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a remove allowed messages proposal.
+func (p *RemoveAllowedMessagesProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a remove allowed messages proposal.
+func (p *RemoveAllowedMessagesProposal) ProposalType() string {
+	return ProposalTypeRemoveAllowedMessages
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *RemoveAllowedMessagesProposal) ValidateBasic() error {
+	if err := govv1beta1.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	return ValidateMessages(p.Messages)
+}
+
+// String implements the Stringer interface.
+func (p RemoveAllowedMessagesProposal) String() string {
+	return fmt.Sprintf(`Remove ICA Host Allowed Messages Proposal:
+  Title:       %s
+  Description: %s
+  Messages:    %s
+`, p.Title, p.Description, strings.Join(p.Messages, ", "))
+}
@@ -0,0 +1,852 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: chaos/icaallowlist/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryAllowedMessagesRequest is the request type for the Query/AllowedMessages
+// RPC.
+type QueryAllowedMessagesRequest struct {
+}
+
+func (m *QueryAllowedMessagesRequest) Reset()         { *m = QueryAllowedMessagesRequest{} }
+func (m *QueryAllowedMessagesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowedMessagesRequest) ProtoMessage()    {}
+func (*QueryAllowedMessagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0320f8cb66dbfc58, []int{0}
+}
+func (m *QueryAllowedMessagesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowedMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowedMessagesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowedMessagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowedMessagesRequest.Merge(m, src)
+}
+func (m *QueryAllowedMessagesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowedMessagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowedMessagesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowedMessagesRequest proto.InternalMessageInfo
+
+// QueryAllowedMessagesResponse is the response type for the
+// Query/AllowedMessages RPC.
+type QueryAllowedMessagesResponse struct {
+	// host_enabled is false if the interchain accounts host is disabled.
+	HostEnabled bool `protobuf:"varint,1,opt,name=host_enabled,json=hostEnabled,proto3" json:"host_enabled,omitempty"`
+	// allow_all is true if the allowlist is the single "*" wildcard, which
+	// allows every message.
+	AllowAll        bool             `protobuf:"varint,2,opt,name=allow_all,json=allowAll,proto3" json:"allow_all,omitempty"`
+	AllowedMessages []AllowedMessage `protobuf:"bytes,3,rep,name=allowed_messages,json=allowedMessages,proto3" json:"allowed_messages"`
+}
+
+func (m *QueryAllowedMessagesResponse) Reset()         { *m = QueryAllowedMessagesResponse{} }
+func (m *QueryAllowedMessagesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowedMessagesResponse) ProtoMessage()    {}
+func (*QueryAllowedMessagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0320f8cb66dbfc58, []int{1}
+}
+func (m *QueryAllowedMessagesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowedMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowedMessagesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowedMessagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowedMessagesResponse.Merge(m, src)
+}
+func (m *QueryAllowedMessagesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowedMessagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowedMessagesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowedMessagesResponse proto.InternalMessageInfo
+
+func (m *QueryAllowedMessagesResponse) GetHostEnabled() bool {
+	if m != nil {
+		return m.HostEnabled
+	}
+	return false
+}
+
+func (m *QueryAllowedMessagesResponse) GetAllowAll() bool {
+	if m != nil {
+		return m.AllowAll
+	}
+	return false
+}
+
+func (m *QueryAllowedMessagesResponse) GetAllowedMessages() []AllowedMessage {
+	if m != nil {
+		return m.AllowedMessages
+	}
+	return nil
+}
+
+// AllowedMessage is an entry of the interchain accounts host allowlist.
+type AllowedMessage struct {
+	TypeUrl string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	// registered is false if no message with this type URL is registered in the
+	// interface registry, in which case the entry has no effect.
+	Registered bool `protobuf:"varint,2,opt,name=registered,proto3" json:"registered,omitempty"`
+}
+
+func (m *AllowedMessage) Reset()         { *m = AllowedMessage{} }
+func (m *AllowedMessage) String() string { return proto.CompactTextString(m) }
+func (*AllowedMessage) ProtoMessage()    {}
+func (*AllowedMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0320f8cb66dbfc58, []int{2}
+}
+func (m *AllowedMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllowedMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowedMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllowedMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowedMessage.Merge(m, src)
+}
+func (m *AllowedMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllowedMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowedMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllowedMessage proto.InternalMessageInfo
+
+func (m *AllowedMessage) GetTypeUrl() string {
+	if m != nil {
+		return m.TypeUrl
+	}
+	return ""
+}
+
+func (m *AllowedMessage) GetRegistered() bool {
+	if m != nil {
+		return m.Registered
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*QueryAllowedMessagesRequest)(nil), "chaos.icaallowlist.QueryAllowedMessagesRequest")
+	proto.RegisterType((*QueryAllowedMessagesResponse)(nil), "chaos.icaallowlist.QueryAllowedMessagesResponse")
+	proto.RegisterType((*AllowedMessage)(nil), "chaos.icaallowlist.AllowedMessage")
+}
+
+func init() { proto.RegisterFile("chaos/icaallowlist/query.proto", fileDescriptor_0320f8cb66dbfc58) }
+
+var fileDescriptor_0320f8cb66dbfc58 = []byte{
+	// 383 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4f, 0x4b, 0xe3, 0x40,
+	0x18, 0xc6, 0x33, 0xed, 0xfe, 0x69, 0xa7, 0xcb, 0x76, 0x19, 0xf6, 0xd0, 0x6d, 0xbb, 0xb3, 0xdd,
+	0x20, 0xd2, 0x83, 0x66, 0xa4, 0xe2, 0x07, 0x68, 0xc1, 0x93, 0x88, 0x18, 0xf1, 0xe2, 0x25, 0x4c,
+	0x92, 0x21, 0x0d, 0x4c, 0x33, 0x69, 0x66, 0x82, 0xf6, 0xea, 0x27, 0x10, 0xfc, 0x06, 0x9e, 0xbd,
+	0xf9, 0x25, 0x7a, 0x2c, 0x78, 0xf1, 0x24, 0xd2, 0xfa, 0x41, 0x24, 0x93, 0x1e, 0x9a, 0x5a, 0xc4,
+	0xdb, 0xcc, 0xf3, 0xbc, 0xf3, 0xf0, 0xcc, 0x8f, 0x17, 0x62, 0x6f, 0x48, 0x85, 0x24, 0xa1, 0x47,
+	0x29, 0xe7, 0xe2, 0x92, 0x87, 0x52, 0x91, 0x71, 0xca, 0x92, 0x89, 0x15, 0x27, 0x42, 0x09, 0x84,
+	0xb4, 0x6f, 0xad, 0xfa, 0xcd, 0xdf, 0x81, 0x08, 0x84, 0xb6, 0x49, 0x76, 0xca, 0x27, 0x9b, 0xed,
+	0x40, 0x88, 0x80, 0x33, 0x42, 0xe3, 0x90, 0xd0, 0x28, 0x12, 0x8a, 0xaa, 0x50, 0x44, 0x32, 0x77,
+	0xcd, 0xbf, 0xb0, 0x75, 0x9a, 0xc5, 0xf6, 0xb3, 0x14, 0xe6, 0x1f, 0x33, 0x29, 0x69, 0xc0, 0xa4,
+	0xcd, 0xc6, 0x29, 0x93, 0xca, 0x7c, 0x00, 0xb0, 0xbd, 0xd9, 0x97, 0xb1, 0x88, 0x24, 0x43, 0xff,
+	0xe1, 0x8f, 0xa1, 0x90, 0xca, 0x61, 0x11, 0x75, 0x39, 0xf3, 0x1b, 0xa0, 0x03, 0xba, 0x15, 0xbb,
+	0x96, 0x69, 0x87, 0xb9, 0x84, 0x5a, 0xb0, 0xaa, 0x3b, 0x3a, 0x94, 0xf3, 0x46, 0x49, 0xfb, 0x15,
+	0x2d, 0xf4, 0x39, 0x47, 0x67, 0xf0, 0x17, 0xcd, 0xa3, 0x9d, 0xd1, 0x32, 0xbb, 0x51, 0xee, 0x94,
+	0xbb, 0xb5, 0x9e, 0x69, 0xbd, 0xff, 0xa2, 0x55, 0xac, 0x31, 0xf8, 0x32, 0x7d, 0xfe, 0x67, 0xd8,
+	0x75, 0x5a, 0x2c, 0x67, 0x1e, 0xc1, 0x9f, 0xc5, 0x41, 0xf4, 0x07, 0x56, 0xd4, 0x24, 0x66, 0x4e,
+	0x9a, 0x70, 0x5d, 0xb1, 0x6a, 0x7f, 0xcf, 0xee, 0xe7, 0x09, 0x47, 0x18, 0xc2, 0x84, 0x05, 0xa1,
+	0x54, 0x2c, 0x61, 0xfe, 0xb2, 0xdf, 0x8a, 0xd2, 0xbb, 0x07, 0xf0, 0xab, 0x46, 0x80, 0xee, 0x00,
+	0xac, 0xaf, 0x71, 0x40, 0x64, 0x53, 0xcb, 0x0f, 0x88, 0x36, 0xf7, 0x3e, 0xff, 0x20, 0x47, 0x6c,
+	0xee, 0x5c, 0x3f, 0xbe, 0xde, 0x96, 0xb6, 0xd1, 0x16, 0xd9, 0xb0, 0x13, 0xeb, 0xf0, 0x06, 0x27,
+	0xd3, 0x39, 0x06, 0xb3, 0x39, 0x06, 0x2f, 0x73, 0x0c, 0x6e, 0x16, 0xd8, 0x98, 0x2d, 0xb0, 0xf1,
+	0xb4, 0xc0, 0xc6, 0xc5, 0x41, 0x10, 0xaa, 0x61, 0xea, 0x5a, 0x9e, 0x18, 0x11, 0x4f, 0xc8, 0x91,
+	0x90, 0xbb, 0x6e, 0x1a, 0x72, 0x9f, 0x25, 0x72, 0x99, 0x7c, 0x55, 0xcc, 0xce, 0x08, 0x49, 0xf7,
+	0x9b, 0x5e, 0x94, 0xfd, 0xb7, 0x01, 0x00, 0xeb, 0x1e, 0x83, 0xc2, 0x92, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// AllowedMessages returns the messages interchain accounts hosted on this
+	// chain may execute, checked against the interface registry.
+	AllowedMessages(ctx context.Context, in *QueryAllowedMessagesRequest, opts ...grpc.CallOption) (*QueryAllowedMessagesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) AllowedMessages(ctx context.Context, in *QueryAllowedMessagesRequest, opts ...grpc.CallOption) (*QueryAllowedMessagesResponse, error) {
+	out := new(QueryAllowedMessagesResponse)
+	err := c.cc.Invoke(ctx, "/chaos.icaallowlist.Query/AllowedMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// AllowedMessages returns the messages interchain accounts hosted on this
+	// chain may execute, checked against the interface registry.
+	AllowedMessages(context.Context, *QueryAllowedMessagesRequest) (*QueryAllowedMessagesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) AllowedMessages(ctx context.Context, req *QueryAllowedMessagesRequest) (*QueryAllowedMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowedMessages not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_AllowedMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowedMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowedMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaos.icaallowlist.Query/AllowedMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowedMessages(ctx, req.(*QueryAllowedMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "chaos.icaallowlist.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AllowedMessages",
+			Handler:    _Query_AllowedMessages_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "chaos/icaallowlist/query.proto",
+}
+
+func (m *QueryAllowedMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowedMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedMessages) > 0 {
+		for iNdEx := len(m.AllowedMessages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedMessages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.AllowAll {
+		i--
+		if m.AllowAll {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.HostEnabled {
+		i--
+		if m.HostEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllowedMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowedMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowedMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Registered {
+		i--
+		if m.Registered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAllowedMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllowedMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HostEnabled {
+		n += 2
+	}
+	if m.AllowAll {
+		n += 2
+	}
+	if len(m.AllowedMessages) > 0 {
+		for _, e := range m.AllowedMessages {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AllowedMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Registered {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryAllowedMessagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowedMessagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowedMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowedMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowedMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowedMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HostEnabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowAll", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowAll = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMessages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMessages = append(m.AllowedMessages, AllowedMessage{})
+			if err := m.AllowedMessages[len(m.AllowedMessages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllowedMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowedMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowedMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Registered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Registered = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
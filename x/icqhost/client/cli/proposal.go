@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/cosmos-builders/chaos/x/icqhost/types"
+)
+
+// NewCmdSubmitAddAllowedQueriesProposal implements a command handler for
+// submitting an add ICQ host allowed queries proposal transaction.
+func NewCmdSubmitAddAllowedQueriesProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-icq-host-allowed-queries [path]...",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Submit a proposal to allow counterparty chains to run interchain queries",
+		Long: "Submit a proposal to allow counterparty chains to run interchain queries along with an initial deposit.\n" +
+			"Queries are given by gRPC path, e.g. /cosmos.bank.v1beta1.Query/Balance, and must be registered on this chain.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(title, description string) govv1beta1.Content {
+				return types.NewAddAllowedQueriesProposal(title, description, args)
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitRemoveAllowedQueriesProposal implements a command handler for
+// submitting a remove ICQ host allowed queries proposal transaction.
+func NewCmdSubmitRemoveAllowedQueriesProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-icq-host-allowed-queries [path]...",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Submit a proposal to stop counterparty chains from running interchain queries",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(title, description string) govv1beta1.Content {
+				return types.NewRemoveAllowedQueriesProposal(title, description, args)
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+func submitProposal(cmd *cobra.Command, newContent func(title, description string) govv1beta1.Content) error {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	title, err := cmd.Flags().GetString(govcli.FlagTitle) //nolint:staticcheck // need this till full govv1 conversion.
+	if err != nil {
+		return err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription) //nolint:staticcheck // need this till full govv1 conversion.
+	if err != nil {
+		return err
+	}
+
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return err
+	}
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return err
+	}
+
+	msg, err := govv1beta1.NewMsgSubmitProposal(newContent(title, description), deposit, clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+
+	if err = msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
+
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")             //nolint:staticcheck // need this till full govv1 conversion.
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal") //nolint:staticcheck // need this till full govv1 conversion.
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+}
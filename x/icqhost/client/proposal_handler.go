@@ -0,0 +1,12 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/cosmos-builders/chaos/x/icqhost/client/cli"
+)
+
+var (
+	AddAllowedQueriesProposalHandler    = govclient.NewProposalHandler(cli.NewCmdSubmitAddAllowedQueriesProposal)
+	RemoveAllowedQueriesProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitRemoveAllowedQueriesProposal)
+)
@@ -0,0 +1,245 @@
+package icqhost_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos-builders/chaos/app"
+	"github.com/cosmos-builders/chaos/x/icqhost"
+	"github.com/cosmos-builders/chaos/x/icqhost/types"
+)
+
+const (
+	balancePath    = "/cosmos.bank.v1beta1.Query/Balance"
+	validatorsPath = "/cosmos.staking.v1beta1.Query/Validators"
+)
+
+func chaosApp(chain *ibctesting.TestChain) *app.App {
+	return chain.App.(*app.App)
+}
+
+// ICQHostTestSuite runs interchain queries from chain A against chain B.
+type ICQHostTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	client *app.ICQTestClient
+}
+
+func TestICQHostTestSuite(t *testing.T) {
+	suite.Run(t, new(ICQHostTestSuite))
+}
+
+func (suite *ICQHostTestSuite) SetupTest() {
+	ibctesting.DefaultTestingAppInit = app.SetupTestingApp
+
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	var err error
+	suite.client, err = app.NewICQTestClient(suite.coordinator, suite.chainA, suite.chainB)
+	suite.Require().NoError(err)
+	suite.Require().Equal(channeltypes.OPEN, suite.client.Path.EndpointA.GetChannel().State)
+	suite.Require().Equal(channeltypes.OPEN, suite.client.Path.EndpointB.GetChannel().State)
+}
+
+func (suite *ICQHostTestSuite) balanceQuery(addr sdk.AccAddress) abci.RequestQuery {
+	return abci.RequestQuery{
+		Path: balancePath,
+		Data: chaosApp(suite.chainB).AppCodec().MustMarshal(&banktypes.QueryBalanceRequest{Address: addr.String(), Denom: sdk.DefaultBondDenom}),
+	}
+}
+
+func (suite *ICQHostTestSuite) validatorsQuery() abci.RequestQuery {
+	return abci.RequestQuery{
+		Path: validatorsPath,
+		Data: chaosApp(suite.chainB).AppCodec().MustMarshal(&stakingtypes.QueryValidatorsRequest{}),
+	}
+}
+
+// executeProposal runs a proposal through the icqhost proposal handler of
+// chain B.
+func (suite *ICQHostTestSuite) executeProposal(content govv1beta1.Content) error {
+	suite.Require().NoError(content.ValidateBasic())
+	suite.Require().Equal(types.RouterKey, content.ProposalRoute())
+
+	handler := icqhost.NewICQHostProposalHandler(chaosApp(suite.chainB).ICQHostKeeper)
+	if err := handler(suite.chainB.GetContext(), content); err != nil {
+		return err
+	}
+	suite.chainB.NextBlock()
+
+	return nil
+}
+
+func (suite *ICQHostTestSuite) TestQuery() {
+	hostApp := chaosApp(suite.chainB)
+	addr := suite.chainB.SenderAccount.GetAddress()
+	expBalance := hostApp.BankKeeper.GetBalance(suite.chainB.GetContext(), addr, sdk.DefaultBondDenom)
+	suite.Require().False(expBalance.IsZero())
+
+	resps, err := suite.client.Query(suite.balanceQuery(addr), suite.balanceQuery(suite.chainB.SenderAccounts[1].SenderAccount.GetAddress()))
+	suite.Require().NoError(err)
+	suite.Require().Len(resps, 2)
+
+	for _, resp := range resps {
+		var balance banktypes.QueryBalanceResponse
+		suite.Require().NoError(hostApp.AppCodec().Unmarshal(resp.Value, &balance))
+		suite.Require().Equal(expBalance, *balance.Balance)
+		suite.Require().NotZero(resp.Height)
+	}
+}
+
+func (suite *ICQHostTestSuite) TestAllowlist() {
+	suite.Require().Equal(types.DefaultParams(), chaosApp(suite.chainB).ICQHostKeeper.GetParams(suite.chainB.GetContext()))
+
+	// queries outside of the allowlist fail the whole packet
+	ack, err := suite.client.SendQuery(suite.balanceQuery(suite.chainB.SenderAccount.GetAddress()), suite.validatorsQuery())
+	suite.Require().NoError(err)
+	suite.Require().Equal(channeltypes.NewErrorAcknowledgement(types.ErrUnauthorizedQuery), ack)
+
+	suite.Require().NoError(suite.executeProposal(types.NewAddAllowedQueriesProposal("title", "description", []string{validatorsPath})))
+	resps, err := suite.client.Query(suite.validatorsQuery())
+	suite.Require().NoError(err)
+
+	var validators stakingtypes.QueryValidatorsResponse
+	suite.Require().NoError(chaosApp(suite.chainB).AppCodec().Unmarshal(resps[0].Value, &validators))
+	suite.Require().Len(validators.Validators, len(suite.chainB.Vals.Validators))
+
+	suite.Require().NoError(suite.executeProposal(types.NewRemoveAllowedQueriesProposal("title", "description", []string{validatorsPath, balancePath})))
+	ack, err = suite.client.SendQuery(suite.balanceQuery(suite.chainB.SenderAccount.GetAddress()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(channeltypes.NewErrorAcknowledgement(types.ErrUnauthorizedQuery), ack)
+}
+
+func (suite *ICQHostTestSuite) TestInvalidQueries() {
+	hostApp := chaosApp(suite.chainB)
+	addr := suite.chainB.SenderAccount.GetAddress()
+
+	testCases := []struct {
+		name     string
+		reqs     []abci.RequestQuery
+		malleate func()
+		expErr   error
+	}{
+		{
+			"past height",
+			[]abci.RequestQuery{{Path: balancePath, Data: suite.balanceQuery(addr).Data, Height: 1}},
+			func() {},
+			types.ErrInvalidQuery,
+		},
+		{
+			"proof",
+			[]abci.RequestQuery{{Path: balancePath, Data: suite.balanceQuery(addr).Data, Prove: true}},
+			func() {},
+			types.ErrInvalidQuery,
+		},
+		{
+			"invalid request",
+			[]abci.RequestQuery{{Path: balancePath, Data: []byte("invalid")}},
+			func() {},
+			nil,
+		},
+		{
+			"no query",
+			nil,
+			func() {},
+			types.ErrInvalidQuery,
+		},
+		{
+			"host disabled",
+			[]abci.RequestQuery{suite.balanceQuery(addr)},
+			func() {
+				hostApp.ICQHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, types.DefaultAllowQueries))
+				suite.chainB.NextBlock()
+			},
+			types.ErrHostDisabled,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc.malleate()
+
+		ack, err := suite.client.SendQuery(tc.reqs...)
+		suite.Require().NoError(err, tc.name)
+		suite.Require().False(ack.Success(), tc.name)
+		if tc.expErr != nil {
+			suite.Require().Equal(channeltypes.NewErrorAcknowledgement(tc.expErr), ack, tc.name)
+		}
+	}
+}
+
+func (suite *ICQHostTestSuite) TestProposals() {
+	testCases := []struct {
+		name    string
+		content govv1beta1.Content
+		expErr  error
+	}{
+		{
+			"add unknown query",
+			types.NewAddAllowedQueriesProposal("title", "description", []string{"/chaos.unknown.Query/Unknown"}),
+			types.ErrUnknownQuery,
+		},
+		{
+			"add allowed query",
+			types.NewAddAllowedQueriesProposal("title", "description", []string{validatorsPath, balancePath}),
+			types.ErrAlreadyAllowed,
+		},
+		{
+			"remove query not allowed",
+			types.NewRemoveAllowedQueriesProposal("title", "description", []string{validatorsPath}),
+			types.ErrNotAllowed,
+		},
+		{
+			"add query",
+			types.NewAddAllowedQueriesProposal("title", "description", []string{validatorsPath}),
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := suite.executeProposal(tc.content)
+		if tc.expErr != nil {
+			suite.Require().ErrorIs(err, tc.expErr, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+		}
+	}
+
+	res, err := chaosApp(suite.chainB).ICQHostKeeper.Params(sdk.WrapSDKContext(suite.chainB.GetContext()), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(append(types.DefaultAllowQueries, validatorsPath), res.Params.AllowQueries)
+
+	for _, queries := range [][]string{
+		nil,
+		{"cosmos.bank.v1beta1.Query/Balance"},
+		{"/cosmos.bank.v1beta1.Query"},
+		{balancePath, balancePath},
+	} {
+		content := types.NewAddAllowedQueriesProposal("title", "description", queries)
+		suite.Require().ErrorIs(content.ValidateBasic(), types.ErrInvalidQueries)
+	}
+}
+
+func (suite *ICQHostTestSuite) TestGenesis() {
+	hostApp := chaosApp(suite.chainB)
+
+	genesis := hostApp.ICQHostKeeper.ExportGenesis(suite.chainB.GetContext())
+	suite.Require().Equal(types.DefaultGenesis(), genesis)
+	suite.Require().NoError(genesis.Validate())
+	suite.Require().True(hostApp.ICQHostKeeper.IsBound(suite.chainB.GetContext(), types.PortID))
+}
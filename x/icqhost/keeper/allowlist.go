@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos-builders/chaos/x/icqhost/types"
+)
+
+// AddAllowedQueries allows counterparty chains to run queries. Every query
+// must be registered in the gRPC query router and not allowed yet.
+func (k Keeper) AddAllowedQueries(ctx sdk.Context, queries []string) error {
+	params := k.GetParams(ctx)
+
+	for _, path := range queries {
+		if k.queryRouter.Route(path) == nil {
+			return sdkerrors.Wrap(types.ErrUnknownQuery, path)
+		}
+		if params.IsAllowedQuery(path) {
+			return sdkerrors.Wrap(types.ErrAlreadyAllowed, path)
+		}
+	}
+
+	params.AllowQueries = append(params.AllowQueries, queries...)
+	k.SetParams(ctx, params)
+
+	k.Logger(ctx).Info("allowed interchain queries", "queries", queries)
+
+	return nil
+}
+
+// RemoveAllowedQueries stops counterparty chains from running queries. Every
+// query must be allowed.
+func (k Keeper) RemoveAllowedQueries(ctx sdk.Context, queries []string) error {
+	params := k.GetParams(ctx)
+
+	removed := make(map[string]bool)
+	for _, path := range queries {
+		if !params.IsAllowedQuery(path) {
+			return sdkerrors.Wrap(types.ErrNotAllowed, path)
+		}
+		removed[path] = true
+	}
+
+	allowQueries := make([]string, 0, len(params.AllowQueries))
+	for _, path := range params.AllowQueries {
+		if !removed[path] {
+			allowQueries = append(allowQueries, path)
+		}
+	}
+
+	params.AllowQueries = allowQueries
+	k.SetParams(ctx, params)
+
+	k.Logger(ctx).Info("disallowed interchain queries", "queries", queries)
+
+	return nil
+}
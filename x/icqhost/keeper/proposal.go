@@ -0,0 +1,17 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos-builders/chaos/x/icqhost/types"
+)
+
+// HandleAddAllowedQueriesProposal handles an AddAllowedQueriesProposal
+func (k Keeper) HandleAddAllowedQueriesProposal(ctx sdk.Context, p *types.AddAllowedQueriesProposal) error {
+	return k.AddAllowedQueries(ctx, p.Queries)
+}
+
+// HandleRemoveAllowedQueriesProposal handles a RemoveAllowedQueriesProposal
+func (k Keeper) HandleRemoveAllowedQueriesProposal(ctx sdk.Context, p *types.RemoveAllowedQueriesProposal) error {
+	return k.RemoveAllowedQueries(ctx, p.Queries)
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos-builders/chaos/x/icqhost/types"
+)
+
+// OnRecvPacket runs the queries of an interchain query packet and returns
+// the serialized CosmosResponse holding their results.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byte, error) {
+	if !k.GetParams(ctx).HostEnabled {
+		return nil, types.ErrHostDisabled
+	}
+
+	var data types.InterchainQueryPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal interchain query packet data: %s", err)
+	}
+	if err := data.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	reqs, err := types.DeserializeCosmosQuery(data.Data)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidQuery, "cannot unmarshal cosmos query: %s", err)
+	}
+
+	return k.ExecuteQueries(ctx, reqs)
+}
+
+// ExecuteQueries runs queries against the latest state of the chain and
+// returns the serialized CosmosResponse holding their results. Every query
+// must be allowed, none may ask for a past height or a proof.
+func (k Keeper) ExecuteQueries(ctx sdk.Context, reqs []abci.RequestQuery) ([]byte, error) {
+	if len(reqs) == 0 {
+		return nil, sdkerrors.Wrap(types.ErrInvalidQuery, "no query")
+	}
+
+	params := k.GetParams(ctx)
+
+	// queries cannot write state, the cache context guards against handlers
+	// that would
+	cacheCtx, _ := ctx.CacheContext()
+
+	resps := make([]abci.ResponseQuery, len(reqs))
+	for i, req := range reqs {
+		if !params.IsAllowedQuery(req.Path) {
+			return nil, sdkerrors.Wrap(types.ErrUnauthorizedQuery, req.Path)
+		}
+		if req.Height != 0 || req.Prove {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidQuery, "query %s: only queries against the latest height without proof are supported", req.Path)
+		}
+
+		route := k.queryRouter.Route(req.Path)
+		if route == nil {
+			return nil, sdkerrors.Wrap(types.ErrUnknownQuery, req.Path)
+		}
+
+		res, err := route(cacheCtx, req)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "query %s", req.Path)
+		}
+
+		resps[i] = abci.ResponseQuery{
+			Value:  res.Value,
+			Height: ctx.BlockHeight(),
+		}
+	}
+
+	return types.SerializeCosmosResponse(resps)
+}
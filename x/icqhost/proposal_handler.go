@@ -0,0 +1,25 @@
+package icqhost
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/cosmos-builders/chaos/x/icqhost/keeper"
+	"github.com/cosmos-builders/chaos/x/icqhost/types"
+)
+
+// NewICQHostProposalHandler defines the icqhost proposal handler
+func NewICQHostProposalHandler(k keeper.Keeper) govv1beta1.Handler {
+	return func(ctx sdk.Context, content govv1beta1.Content) error {
+		switch c := content.(type) {
+		case *types.AddAllowedQueriesProposal:
+			return k.HandleAddAllowedQueriesProposal(ctx, c)
+		case *types.RemoveAllowedQueriesProposal:
+			return k.HandleRemoveAllowedQueriesProposal(ctx, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized icqhost proposal content type: %T", c)
+		}
+	}
+}
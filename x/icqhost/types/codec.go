@@ -0,0 +1,35 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+var (
+	amino = codec.NewLegacyAmino()
+
+	// ModuleCdc references the global icqhost module codec. It encodes the
+	// interchain query packets and acknowledgements.
+	ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	amino.Seal()
+}
+
+// RegisterLegacyAminoCodec registers the icqhost types on the provided LegacyAmino codec.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&AddAllowedQueriesProposal{}, "icqhost/AddAllowedQueriesProposal", nil)
+	cdc.RegisterConcrete(&RemoveAllowedQueriesProposal{}, "icqhost/RemoveAllowedQueriesProposal", nil)
+}
+
+// RegisterInterfaces registers the icqhost proposals as governance content
+// with the interface registry
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*govv1beta1.Content)(nil),
+		&AddAllowedQueriesProposal{},
+		&RemoveAllowedQueriesProposal{},
+	)
+}
@@ -0,0 +1,18 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// icqhost sentinel errors
+var (
+	ErrInvalidVersion     = sdkerrors.Register(ModuleName, 2, "invalid interchain query version")
+	ErrInvalidChannelFlow = sdkerrors.Register(ModuleName, 3, "invalid message sent to channel end")
+	ErrHostDisabled       = sdkerrors.Register(ModuleName, 4, "interchain query host is disabled")
+	ErrInvalidQueries     = sdkerrors.Register(ModuleName, 5, "invalid query paths")
+	ErrUnauthorizedQuery  = sdkerrors.Register(ModuleName, 6, "query path not allowed")
+	ErrUnknownQuery       = sdkerrors.Register(ModuleName, 7, "query path not registered in the gRPC query router")
+	ErrInvalidQuery       = sdkerrors.Register(ModuleName, 8, "invalid query")
+	ErrAlreadyAllowed     = sdkerrors.Register(ModuleName, 9, "query path already allowed")
+	ErrNotAllowed         = sdkerrors.Register(ModuleName, 10, "query path not allowed")
+)
@@ -0,0 +1,11 @@
+package types
+
+// icqhost events
+const (
+	EventTypePacket = "icq_packet"
+
+	AttributeKeyChannelID = "channel_id"
+	AttributeKeySequence  = "sequence"
+	AttributeKeySuccess   = "success"
+	AttributeKeyError     = "error"
+)
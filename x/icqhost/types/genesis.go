@@ -0,0 +1,28 @@
+package types
+
+import (
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+)
+
+// DefaultGenesis returns the default icqhost genesis state
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState(PortID, DefaultParams())
+}
+
+// NewGenesisState creates a new icqhost GenesisState
+func NewGenesisState(portID string, params Params) *GenesisState {
+	return &GenesisState{
+		PortId: portID,
+		Params: params,
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := host.PortIdentifierValidator(gs.PortId); err != nil {
+		return err
+	}
+
+	return gs.Params.Validate()
+}
@@ -0,0 +1,24 @@
+package types
+
+const (
+	// ModuleName defines the interchain query host module name
+	ModuleName = "icqhost"
+
+	// StoreKey is the store key string for the icqhost module
+	StoreKey = ModuleName
+
+	// RouterKey is the governance route for the icqhost module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the icqhost module
+	QuerierRoute = ModuleName
+
+	// PortID is the default port id the icqhost module binds to
+	PortID = "icqhost"
+
+	// Version defines the current version of the interchain query protocol
+	Version = "icq-1"
+)
+
+// PortKey defines the key to store the port ID in store
+var PortKey = []byte{0x01}
@@ -0,0 +1,71 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// NewInterchainQueryPacketData creates a new InterchainQueryPacketData
+// running the queries of reqs.
+func NewInterchainQueryPacketData(reqs []abci.RequestQuery, memo string) (InterchainQueryPacketData, error) {
+	bz, err := SerializeCosmosQuery(reqs)
+	if err != nil {
+		return InterchainQueryPacketData{}, err
+	}
+
+	return InterchainQueryPacketData{
+		Data: bz,
+		Memo: memo,
+	}, nil
+}
+
+// ValidateBasic performs basic validation of the interchain query packet data.
+func (pd InterchainQueryPacketData) ValidateBasic() error {
+	if len(pd.Data) == 0 {
+		return sdkerrors.Wrap(ErrInvalidQuery, "packet data cannot be empty")
+	}
+
+	return nil
+}
+
+// GetBytes returns the JSON marshalled interchain query packet data.
+func (pd InterchainQueryPacketData) GetBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&pd)
+}
+
+// GetBytes returns the JSON marshalled interchain query packet acknowledgement.
+func (ack InterchainQueryPacketAck) GetBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&ack)
+}
+
+// SerializeCosmosQuery encodes the queries of an interchain query packet.
+func SerializeCosmosQuery(reqs []abci.RequestQuery) ([]byte, error) {
+	return ModuleCdc.Marshal(&CosmosQuery{Requests: reqs})
+}
+
+// DeserializeCosmosQuery decodes the queries of an interchain query packet.
+func DeserializeCosmosQuery(bz []byte) ([]abci.RequestQuery, error) {
+	var query CosmosQuery
+	if err := ModuleCdc.Unmarshal(bz, &query); err != nil {
+		return nil, err
+	}
+
+	return query.Requests, nil
+}
+
+// SerializeCosmosResponse encodes the responses of an interchain query
+// packet acknowledgement.
+func SerializeCosmosResponse(resps []abci.ResponseQuery) ([]byte, error) {
+	return ModuleCdc.Marshal(&CosmosResponse{Responses: resps})
+}
+
+// DeserializeCosmosResponse decodes the responses of an interchain query
+// packet acknowledgement.
+func DeserializeCosmosResponse(bz []byte) ([]abci.ResponseQuery, error) {
+	var resp CosmosResponse
+	if err := ModuleCdc.Unmarshal(bz, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Responses, nil
+}
@@ -0,0 +1,118 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var (
+	// KeyHostEnabled is the store key for the HostEnabled param
+	KeyHostEnabled = []byte("HostEnabled")
+	// KeyAllowQueries is the store key for the AllowQueries param
+	KeyAllowQueries = []byte("AllowQueries")
+)
+
+// DefaultAllowQueries are the queries counterparty chains may run on chaos
+// unless governance changes it: balances, supply and delegations.
+var DefaultAllowQueries = []string{
+	"/cosmos.bank.v1beta1.Query/Balance",
+	"/cosmos.bank.v1beta1.Query/AllBalances",
+	"/cosmos.bank.v1beta1.Query/SupplyOf",
+	"/cosmos.staking.v1beta1.Query/Validator",
+	"/cosmos.staking.v1beta1.Query/Delegation",
+}
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable returns the param key table for the icqhost module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new icqhost Params instance
+func NewParams(enableHost bool, allowQueries []string) Params {
+	return Params{
+		HostEnabled:  enableHost,
+		AllowQueries: allowQueries,
+	}
+}
+
+// DefaultParams returns the default icqhost parameters
+func DefaultParams() Params {
+	return NewParams(true, DefaultAllowQueries)
+}
+
+// Validate validates all icqhost parameters
+func (p Params) Validate() error {
+	if err := validateEnabled(p.HostEnabled); err != nil {
+		return err
+	}
+
+	return validateAllowList(p.AllowQueries)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyHostEnabled, &p.HostEnabled, validateEnabled),
+		paramtypes.NewParamSetPair(KeyAllowQueries, &p.AllowQueries, validateAllowList),
+	}
+}
+
+// IsAllowedQuery returns true if counterparty chains may run the query at
+// path.
+func (p Params) IsAllowedQuery(path string) bool {
+	for _, allowed := range p.AllowQueries {
+		if allowed == path {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateQueries checks that queries is a non-empty list of distinct gRPC
+// query paths.
+func ValidateQueries(queries []string) error {
+	if len(queries) == 0 {
+		return fmt.Errorf("queries cannot be empty")
+	}
+
+	return validateQueryPaths(queries)
+}
+
+func validateEnabled(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateAllowList(i interface{}) error {
+	queries, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return validateQueryPaths(queries)
+}
+
+func validateQueryPaths(queries []string) error {
+	seen := make(map[string]bool)
+	for _, path := range queries {
+		// gRPC query paths have the form /<package>.<service>/<method>
+		parts := strings.Split(path, "/")
+		if len(parts) != 3 || parts[0] != "" || strings.TrimSpace(parts[1]) == "" || strings.TrimSpace(parts[2]) == "" {
+			return fmt.Errorf("%q is not a gRPC query path", path)
+		}
+		if seen[path] {
+			return fmt.Errorf("duplicate query path %s", path)
+		}
+		seen[path] = true
+	}
+
+	return nil
+}
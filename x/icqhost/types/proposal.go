@@ -0,0 +1,116 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+const (
+	// ProposalTypeAddAllowedQueries defines the type for an AddAllowedQueriesProposal
+	ProposalTypeAddAllowedQueries = "AddICQHostAllowedQueries"
+	// ProposalTypeRemoveAllowedQueries defines the type for a RemoveAllowedQueriesProposal
+	ProposalTypeRemoveAllowedQueries = "RemoveICQHostAllowedQueries"
+)
+
+var (
+	_ govv1beta1.Content = &AddAllowedQueriesProposal{}
+	_ govv1beta1.Content = &RemoveAllowedQueriesProposal{}
+)
+
+func init() {
+	govv1beta1.RegisterProposalType(ProposalTypeAddAllowedQueries)
+	govv1beta1.RegisterProposalType(ProposalTypeRemoveAllowedQueries)
+}
+
+// NewAddAllowedQueriesProposal creates a new AddAllowedQueriesProposal.
+func NewAddAllowedQueriesProposal(title, description string, queries []string) govv1beta1.Content {
+	return &AddAllowedQueriesProposal{
+		Title:       title,
+		Description: description,
+		Queries:     queries,
+	}
+}
+
+// GetTitle returns the title of an add allowed queries proposal.
+func (p *AddAllowedQueriesProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an add allowed queries proposal.
+func (p *AddAllowedQueriesProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an add allowed queries proposal.
+func (p *AddAllowedQueriesProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an add allowed queries proposal.
+func (p *AddAllowedQueriesProposal) ProposalType() string {
+	return ProposalTypeAddAllowedQueries
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *AddAllowedQueriesProposal) ValidateBasic() error {
+	if err := govv1beta1.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if err := ValidateQueries(p.Queries); err != nil {
+		return sdkerrors.Wrap(ErrInvalidQueries, err.Error())
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p AddAllowedQueriesProposal) String() string {
+	return fmt.Sprintf(`Add ICQ Host Allowed Queries Proposal:
+  Title:       %s
+  Description: %s
+  Queries:     %s
+`, p.Title, p.Description, strings.Join(p.Queries, ", "))
+}
+
+// NewRemoveAllowedQueriesProposal creates a new RemoveAllowedQueriesProposal.
+func NewRemoveAllowedQueriesProposal(title, description string, queries []string) govv1beta1.Content {
+	return &RemoveAllowedQueriesProposal{
+		Title:       title,
+		Description: description,
+		Queries:     queries,
+	}
+}
+
+// GetTitle returns the title of a remove allowed queries proposal.
+func (p *RemoveAllowedQueriesProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a remove allowed queries proposal.
+func (p *RemoveAllowedQueriesProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a remove allowed queries proposal.
+func (p *RemoveAllowedQueriesProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a remove allowed queries proposal.
+func (p *RemoveAllowedQueriesProposal) ProposalType() string {
+	return ProposalTypeRemoveAllowedQueries
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *RemoveAllowedQueriesProposal) ValidateBasic() error {
+	if err := govv1beta1.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if err := ValidateQueries(p.Queries); err != nil {
+		return sdkerrors.Wrap(ErrInvalidQueries, err.Error())
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p RemoveAllowedQueriesProposal) String() string {
+	return fmt.Sprintf(`Remove ICQ Host Allowed Queries Proposal:
+  Title:       %s
+  Description: %s
+  Queries:     %s
+`, p.Title, p.Description, strings.Join(p.Queries, ", "))
+}
@@ -0,0 +1,143 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos-builders/chaos/x/intertx/types"
+)
+
+const (
+	// FlagConnectionID is the connection end identifier on the controller chain
+	FlagConnectionID = "connection-id"
+	// FlagVersion is the ICS-27 channel version
+	FlagVersion = "version"
+	// FlagTimeout is the relative packet timeout
+	FlagTimeout = "timeout"
+)
+
+// DefaultRelativePacketTimeout is the default packet timeout relative to the
+// current block time.
+var DefaultRelativePacketTimeout = 10 * time.Minute
+
+// GetTxCmd creates and returns the intertx tx command
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		getRegisterAccountCmd(),
+		getSubmitTxCmd(),
+	)
+
+	return cmd
+}
+
+func getRegisterAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register",
+		Short: "Register an interchain account on the host chain of the given connection",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			connectionID, err := cmd.Flags().GetString(FlagConnectionID)
+			if err != nil {
+				return err
+			}
+
+			version, err := cmd.Flags().GetString(FlagVersion)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterAccount(clientCtx.GetFromAddress().String(), connectionID, version)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagConnectionID, "", "connection identifier of the host chain")
+	cmd.Flags().String(FlagVersion, "", "channel version, defaults to a fee enabled channel with the connection's default ICS-27 metadata")
+	_ = cmd.MarkFlagRequired(FlagConnectionID)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getSubmitTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit [path/to/sdk_msg.json]",
+		Short: "Submit a transaction to be executed by the interchain account on the host chain",
+		Long: `Submit a transaction to be executed by the interchain account on the host chain.
+The argument is either a path to a JSON file or a raw JSON string containing a
+single proto3 JSON encoded sdk.Msg, e.g. a /cosmos.bank.v1beta1.MsgSend.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var txMsg sdk.Msg
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON([]byte(args[0]), &txMsg); err != nil {
+				// check for file path if JSON input is not provided
+				contents, err := os.ReadFile(args[0])
+				if err != nil {
+					return fmt.Errorf("neither JSON input nor path to .json file for sdk msg were provided: %w", err)
+				}
+
+				if err := clientCtx.Codec.UnmarshalInterfaceJSON(contents, &txMsg); err != nil {
+					return fmt.Errorf("error unmarshalling sdk msg file: %w", err)
+				}
+			}
+
+			connectionID, err := cmd.Flags().GetString(FlagConnectionID)
+			if err != nil {
+				return err
+			}
+
+			timeout, err := cmd.Flags().GetDuration(FlagTimeout)
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgSubmitTx(clientCtx.GetFromAddress().String(), connectionID, []sdk.Msg{txMsg}, uint64(timeout))
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagConnectionID, "", "connection identifier of the host chain")
+	cmd.Flags().Duration(FlagTimeout, DefaultRelativePacketTimeout, "packet timeout relative to the current block time")
+	_ = cmd.MarkFlagRequired(FlagConnectionID)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
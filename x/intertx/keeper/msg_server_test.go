@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	icahosttypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+
+	"github.com/cosmos-builders/chaos/app"
+	"github.com/cosmos-builders/chaos/x/intertx/keeper"
+	"github.com/cosmos-builders/chaos/x/intertx/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// controller and host chains
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	ibctesting.DefaultTestingAppInit = app.SetupTestingApp
+
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+}
+
+func chaosApp(chain *ibctesting.TestChain) *app.App {
+	return chain.App.(*app.App)
+}
+
+// testVersion is the ICS-27 metadata of a channel without relayer incentivization
+var testVersion = string(icatypes.ModuleCdc.MustMarshalJSON(&icatypes.Metadata{
+	Version:                icatypes.Version,
+	ControllerConnectionId: ibctesting.FirstConnectionID,
+	HostConnectionId:       ibctesting.FirstConnectionID,
+	Encoding:               icatypes.EncodingProtobuf,
+	TxType:                 icatypes.TxTypeSDKMultiMsg,
+}))
+
+func newICAPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = icatypes.PortID
+	path.EndpointB.ChannelConfig.PortID = icatypes.PortID
+	path.EndpointA.ChannelConfig.Order = channeltypes.ORDERED
+	path.EndpointB.ChannelConfig.Order = channeltypes.ORDERED
+	path.EndpointA.ChannelConfig.Version = testVersion
+	path.EndpointB.ChannelConfig.Version = testVersion
+
+	return path
+}
+
+// registerInterchainAccount registers an interchain account through a
+// MsgRegisterAccount tx and completes the channel handshake.
+func (suite *KeeperTestSuite) registerInterchainAccount(path *ibctesting.Path, owner string) {
+	channelSequence := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextChannelSequence(suite.chainA.GetContext())
+
+	_, err := suite.chainA.SendMsgs(types.NewMsgRegisterAccount(owner, path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.Version))
+	suite.Require().NoError(err)
+
+	portID, err := icatypes.NewControllerPortID(owner)
+	suite.Require().NoError(err)
+
+	path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(channelSequence)
+	path.EndpointA.ChannelConfig.PortID = portID
+
+	suite.Require().NoError(path.EndpointB.ChanOpenTry())
+	suite.Require().NoError(path.EndpointA.ChanOpenAck())
+	suite.Require().NoError(path.EndpointB.ChanOpenConfirm())
+}
+
+func (suite *KeeperTestSuite) TestRegisterAccountAndSubmitTx() {
+	path := newICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	owner := suite.chainA.SenderAccount.GetAddress().String()
+	suite.registerInterchainAccount(path, owner)
+
+	res, err := chaosApp(suite.chainA).InterTxKeeper.InterchainAccount(
+		sdk.WrapSDKContext(suite.chainA.GetContext()),
+		&types.QueryInterchainAccountRequest{Owner: owner, ConnectionId: path.EndpointA.ConnectionID},
+	)
+	suite.Require().NoError(err)
+	icaAddr, err := sdk.AccAddressFromBech32(res.InterchainAccountAddress)
+	suite.Require().NoError(err)
+
+	hostApp := chaosApp(suite.chainB)
+	hostApp.ICAHostKeeper.SetParams(suite.chainB.GetContext(), icahosttypes.NewParams(true, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}))
+
+	// fund the interchain account on the host chain
+	amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000)))
+	_, err = suite.chainB.SendMsgs(banktypes.NewMsgSend(suite.chainB.SenderAccount.GetAddress(), icaAddr, amount))
+	suite.Require().NoError(err)
+
+	// send the funds back through the interchain account
+	recipient := suite.chainB.SenderAccount.GetAddress()
+	msg, err := types.NewMsgSubmitTx(owner, path.EndpointA.ConnectionID, []sdk.Msg{banktypes.NewMsgSend(icaAddr, recipient, amount)}, uint64(time.Hour))
+	suite.Require().NoError(err)
+
+	result, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(result.GetEvents())
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(path.EndpointB.UpdateClient())
+	suite.Require().NoError(path.RelayPacket(packet))
+
+	balance := hostApp.BankKeeper.GetBalance(suite.chainB.GetContext(), icaAddr, sdk.DefaultBondDenom)
+	suite.Require().True(balance.IsZero())
+}
+
+func (suite *KeeperTestSuite) TestSubmitTxWithoutAccount() {
+	path := newICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	owner := suite.chainA.SenderAccount.GetAddress().String()
+	msgSend := banktypes.NewMsgSend(suite.chainB.SenderAccount.GetAddress(), suite.chainB.SenderAccount.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+	msg, err := types.NewMsgSubmitTx(owner, path.EndpointA.ConnectionID, []sdk.Msg{msgSend}, uint64(time.Hour))
+	suite.Require().NoError(err)
+
+	_, err = keeper.NewMsgServerImpl(chaosApp(suite.chainA).InterTxKeeper).SubmitTx(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+	suite.Require().ErrorIs(err, types.ErrNoActiveChannel)
+}
+
+func (suite *KeeperTestSuite) TestSubmitTxTimeoutOverflow() {
+	path := newICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	owner := suite.chainA.SenderAccount.GetAddress().String()
+	suite.registerInterchainAccount(path, owner)
+
+	msgSend := banktypes.NewMsgSend(suite.chainB.SenderAccount.GetAddress(), suite.chainB.SenderAccount.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+	msgServer := keeper.NewMsgServerImpl(chaosApp(suite.chainA).InterTxKeeper)
+
+	// a timeout above the maximum duration would wrap to a negative one
+	msg, err := types.NewMsgSubmitTx(owner, path.EndpointA.ConnectionID, []sdk.Msg{msgSend}, math.MaxUint64)
+	suite.Require().NoError(err)
+	suite.Require().ErrorIs(msg.ValidateBasic(), sdkerrors.ErrInvalidRequest)
+	_, err = msgServer.SubmitTx(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// the maximum duration added to the block time overflows the timestamp
+	msg, err = types.NewMsgSubmitTx(owner, path.EndpointA.ConnectionID, []sdk.Msg{msgSend}, math.MaxInt64)
+	suite.Require().NoError(err)
+	suite.Require().NoError(msg.ValidateBasic())
+	_, err = msgServer.SubmitTx(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+}
@@ -0,0 +1,13 @@
+package types
+
+// intertx events
+const (
+	EventTypeAcknowledgement = "intertx_acknowledgement"
+	EventTypeTimeout         = "intertx_timeout"
+
+	AttributeKeyPortID    = "port_id"
+	AttributeKeyChannelID = "channel_id"
+	AttributeKeySequence  = "sequence"
+	AttributeKeySuccess   = "success"
+	AttributeKeyError     = "error"
+)
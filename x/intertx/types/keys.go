@@ -0,0 +1,12 @@
+package types
+
+const (
+	// ModuleName defines the interchain account authentication module name
+	ModuleName = "intertx"
+
+	// RouterKey is the message route for the intertx module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the intertx module
+	QuerierRoute = ModuleName
+)
@@ -0,0 +1,166 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+)
+
+const (
+	TypeMsgRegisterAccount = "register_account"
+	TypeMsgSubmitTx        = "submit_tx"
+)
+
+var (
+	_ sdk.Msg            = &MsgRegisterAccount{}
+	_ legacytx.LegacyMsg = &MsgRegisterAccount{}
+
+	_ sdk.Msg                            = &MsgSubmitTx{}
+	_ legacytx.LegacyMsg                 = &MsgSubmitTx{}
+	_ codectypes.UnpackInterfacesMessage = MsgSubmitTx{}
+)
+
+// NewMsgRegisterAccount creates a new MsgRegisterAccount instance
+func NewMsgRegisterAccount(owner, connectionID, version string) *MsgRegisterAccount {
+	return &MsgRegisterAccount{
+		Owner:        owner,
+		ConnectionId: connectionID,
+		Version:      version,
+	}
+}
+
+// Route implements legacytx.LegacyMsg
+func (msg MsgRegisterAccount) Route() string { return RouterKey }
+
+// Type implements legacytx.LegacyMsg
+func (msg MsgRegisterAccount) Type() string { return TypeMsgRegisterAccount }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgRegisterAccount) ValidateBasic() error {
+	if strings.TrimSpace(msg.Owner) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "failed to parse address")
+	}
+
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return sdkerrors.Wrap(err, "invalid connection ID")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements legacytx.LegacyMsg
+func (msg MsgRegisterAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRegisterAccount) GetSigners() []sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{accAddr}
+}
+
+// NewMsgSubmitTx creates and returns a new MsgSubmitTx instance
+func NewMsgSubmitTx(owner, connectionID string, msgs []sdk.Msg, timeout uint64) (*MsgSubmitTx, error) {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+		anys[i] = any
+	}
+
+	return &MsgSubmitTx{
+		Owner:        owner,
+		ConnectionId: connectionID,
+		Msgs:         anys,
+		Timeout:      timeout,
+	}, nil
+}
+
+// GetTxMsgs fetches cached any message
+func (msg MsgSubmitTx) GetTxMsgs() ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(msg.Msgs))
+	for i, any := range msg.Msgs {
+		sdkMsg, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, fmt.Errorf("expected %T, got %T", (sdk.Msg)(nil), any.GetCachedValue())
+		}
+		msgs[i] = sdkMsg
+	}
+
+	return msgs, nil
+}
+
+// Route implements legacytx.LegacyMsg
+func (msg MsgSubmitTx) Route() string { return RouterKey }
+
+// Type implements legacytx.LegacyMsg
+func (msg MsgSubmitTx) Type() string { return TypeMsgSubmitTx }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgSubmitTx) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "failed to parse address")
+	}
+
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return sdkerrors.Wrap(err, "invalid connection ID")
+	}
+
+	if len(msg.Msgs) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no messages to submit")
+	}
+
+	if msg.Timeout == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "timeout must be positive")
+	}
+
+	// the timeout is converted to a time.Duration
+	if msg.Timeout > math.MaxInt64 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "timeout must not exceed %d nanoseconds", int64(math.MaxInt64))
+	}
+
+	return nil
+}
+
+// GetSignBytes implements legacytx.LegacyMsg
+func (msg MsgSubmitTx) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgSubmitTx) GetSigners() []sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{accAddr}
+}
+
+// UnpackInterfaces implements codectypes.UnpackInterfacesMessage
+func (msg MsgSubmitTx) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, any := range msg.Msgs {
+		var sdkMsg sdk.Msg
+		if err := unpacker.UnpackAny(any, &sdkMsg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,290 @@
+package nfttransfer_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+
+	"github.com/cosmos-builders/chaos/app"
+	"github.com/cosmos-builders/chaos/x/nfttransfer/types"
+)
+
+const (
+	classID  = "kitty"
+	classURI = "https://example.com/kitty"
+)
+
+var tokenIDs = []string{"kitty1", "kitty2"}
+
+func chaosApp(chain *ibctesting.TestChain) *app.App {
+	return chain.App.(*app.App)
+}
+
+// NFTTransferTestSuite transfers the NFTs of a class created on chain A to
+// chain B and back.
+type NFTTransferTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+}
+
+func TestNFTTransferTestSuite(t *testing.T) {
+	suite.Run(t, new(NFTTransferTestSuite))
+}
+
+func (suite *NFTTransferTestSuite) SetupTest() {
+	ibctesting.DefaultTestingAppInit = app.SetupTestingApp
+
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.path.EndpointA.ChannelConfig.PortID = types.PortID
+	suite.path.EndpointB.ChannelConfig.PortID = types.PortID
+	suite.path.EndpointA.ChannelConfig.Version = types.Version
+	suite.path.EndpointB.ChannelConfig.Version = types.Version
+	suite.coordinator.Setup(suite.path)
+
+	// x/nft has no message to create classes and mint tokens
+	ctx := suite.chainA.GetContext()
+	nftKeeper := chaosApp(suite.chainA).NFTKeeper
+	suite.Require().NoError(nftKeeper.SaveClass(ctx, nft.Class{Id: classID, Uri: classURI}))
+	for _, id := range tokenIDs {
+		token := nft.NFT{ClassId: classID, Id: id, Uri: classURI + "/" + id}
+		suite.Require().NoError(nftKeeper.Mint(ctx, token, suite.chainA.SenderAccount.GetAddress()))
+	}
+}
+
+// transfer sends the tokens of the class from the sender of the source chain
+// to the sender of the counterparty chain and returns the packet, which is
+// not relayed.
+func (suite *NFTTransferTestSuite) transfer(source *ibctesting.Endpoint, classID string, receiver string, timeoutHeight clienttypes.Height) channeltypes.Packet {
+	msg := types.NewMsgTransfer(
+		source.ChannelConfig.PortID, source.ChannelID, classID, tokenIDs,
+		source.Chain.SenderAccount.GetAddress().String(), receiver,
+		timeoutHeight, 0, "",
+	)
+
+	res, err := source.Chain.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	return packet
+}
+
+// relay receives packet on the counterparty of source and relays the
+// acknowledgement back to source.
+func (suite *NFTTransferTestSuite) relay(source *ibctesting.Endpoint, packet channeltypes.Packet) []byte {
+	dest := source.Counterparty
+
+	suite.Require().NoError(dest.UpdateClient())
+	res, err := dest.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(source.UpdateClient())
+	suite.Require().NoError(source.AcknowledgePacket(packet, ack))
+
+	return ack
+}
+
+func (suite *NFTTransferTestSuite) defaultTimeout() clienttypes.Height {
+	return clienttypes.NewHeight(1, 110)
+}
+
+// voucherClassID returns the class id of the vouchers minted on chain B for
+// the class of chain A.
+func (suite *NFTTransferTestSuite) voucherClassID() string {
+	return types.ParseClassTrace(
+		types.GetClassPrefix(suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID) + classID,
+	).IBCClassID()
+}
+
+func (suite *NFTTransferTestSuite) requireOwner(chain *ibctesting.TestChain, classID string, owner sdk.AccAddress) {
+	for _, id := range tokenIDs {
+		suite.Require().Equal(owner, chaosApp(chain).NFTKeeper.GetOwner(chain.GetContext(), classID, id), id)
+	}
+}
+
+func (suite *NFTTransferTestSuite) TestRoundTrip() {
+	escrow := types.GetEscrowAddress(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+	receiver := suite.chainB.SenderAccount.GetAddress()
+
+	packet := suite.transfer(suite.path.EndpointA, classID, receiver.String(), suite.defaultTimeout())
+	suite.requireOwner(suite.chainA, classID, escrow)
+
+	ack := suite.relay(suite.path.EndpointA, packet)
+	suite.Require().Equal(channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement(), ack)
+
+	// the vouchers are minted on chain B in the class of the trace
+	voucherClassID := suite.voucherClassID()
+	appB := chaosApp(suite.chainB)
+	ctxB := suite.chainB.GetContext()
+
+	class, found := appB.NFTKeeper.GetClass(ctxB, voucherClassID)
+	suite.Require().True(found)
+	suite.Require().Equal(classURI, class.Uri)
+
+	trace := types.ParseClassTrace(types.GetClassPrefix(suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID) + classID)
+	stored, found := appB.NFTTransferKeeper.GetClassTrace(ctxB, trace.Hash())
+	suite.Require().True(found)
+	suite.Require().Equal(trace, stored)
+
+	suite.requireOwner(suite.chainB, voucherClassID, receiver)
+	voucher, found := appB.NFTKeeper.GetNFT(ctxB, voucherClassID, tokenIDs[0])
+	suite.Require().True(found)
+	suite.Require().Equal(classURI+"/"+tokenIDs[0], voucher.Uri)
+
+	// sending the vouchers back burns them and releases the escrowed tokens
+	packet = suite.transfer(suite.path.EndpointB, voucherClassID, suite.chainA.SenderAccount.GetAddress().String(), suite.defaultTimeout())
+	suite.Require().Contains(string(packet.GetData()), suite.path.EndpointB.ChannelID+"/"+classID)
+	for _, id := range tokenIDs {
+		suite.Require().False(appB.NFTKeeper.HasNFT(suite.chainB.GetContext(), voucherClassID, id))
+	}
+
+	suite.relay(suite.path.EndpointB, packet)
+	suite.requireOwner(suite.chainA, classID, suite.chainA.SenderAccount.GetAddress())
+}
+
+func (suite *NFTTransferTestSuite) TestErrorAckRefundsEscrow() {
+	packet := suite.transfer(suite.path.EndpointA, classID, "invalid", suite.defaultTimeout())
+
+	ack := suite.relay(suite.path.EndpointA, packet)
+	var acknowledgement channeltypes.Acknowledgement
+	suite.Require().NoError(channeltypes.SubModuleCdc.UnmarshalJSON(ack, &acknowledgement))
+	suite.Require().False(acknowledgement.Success())
+
+	suite.Require().False(chaosApp(suite.chainB).NFTKeeper.HasClass(suite.chainB.GetContext(), suite.voucherClassID()))
+	suite.requireOwner(suite.chainA, classID, suite.chainA.SenderAccount.GetAddress())
+}
+
+func (suite *NFTTransferTestSuite) TestTimeoutRefundsEscrow() {
+	timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext()).Increment().(clienttypes.Height)
+	packet := suite.transfer(suite.path.EndpointA, classID, suite.chainB.SenderAccount.GetAddress().String(), timeoutHeight)
+
+	suite.coordinator.CommitNBlocks(suite.chainB, 2)
+	suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+	suite.Require().NoError(suite.path.EndpointA.TimeoutPacket(packet))
+
+	suite.requireOwner(suite.chainA, classID, suite.chainA.SenderAccount.GetAddress())
+}
+
+func (suite *NFTTransferTestSuite) TestErrorAckMintsVouchersBack() {
+	suite.relay(suite.path.EndpointA, suite.transfer(suite.path.EndpointA, classID, suite.chainB.SenderAccount.GetAddress().String(), suite.defaultTimeout()))
+
+	appA := chaosApp(suite.chainA)
+	appA.NFTTransferKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(true, false))
+
+	voucherClassID := suite.voucherClassID()
+	packet := suite.transfer(suite.path.EndpointB, voucherClassID, suite.chainA.SenderAccount.GetAddress().String(), suite.defaultTimeout())
+
+	ack := suite.relay(suite.path.EndpointB, packet)
+	suite.Require().Equal(channeltypes.NewErrorAcknowledgement(types.ErrReceiveDisabled).Acknowledgement(), ack)
+
+	suite.requireOwner(suite.chainB, voucherClassID, suite.chainB.SenderAccount.GetAddress())
+	escrow := types.GetEscrowAddress(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+	suite.requireOwner(suite.chainA, classID, escrow)
+}
+
+func (suite *NFTTransferTestSuite) TestSendTransferChecks() {
+	appA := chaosApp(suite.chainA)
+	ctx := suite.chainA.GetContext()
+	other := suite.chainB.SenderAccount.GetAddress()
+	receiver := suite.chainB.SenderAccount.GetAddress().String()
+	sender := suite.chainA.SenderAccount.GetAddress()
+	port, channel := suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID
+
+	_, err := appA.NFTTransferKeeper.SendTransfer(ctx, port, channel, classID, tokenIDs, other, receiver, suite.defaultTimeout(), 0, "")
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = appA.NFTTransferKeeper.SendTransfer(ctx, port, channel, "unknown", tokenIDs, sender, receiver, suite.defaultTimeout(), 0, "")
+	suite.Require().ErrorIs(err, nft.ErrClassNotExists)
+
+	_, err = appA.NFTTransferKeeper.SendTransfer(ctx, port, channel, classID, []string{"kitty3"}, sender, receiver, suite.defaultTimeout(), 0, "")
+	suite.Require().ErrorIs(err, nft.ErrNFTNotExists)
+
+	_, err = appA.NFTTransferKeeper.SendTransfer(ctx, port, "channel-9", classID, tokenIDs, sender, receiver, suite.defaultTimeout(), 0, "")
+	suite.Require().ErrorIs(err, channeltypes.ErrChannelNotFound)
+
+	appA.NFTTransferKeeper.SetParams(ctx, types.NewParams(false, true))
+	_, err = appA.NFTTransferKeeper.SendTransfer(ctx, port, channel, classID, tokenIDs, sender, receiver, suite.defaultTimeout(), 0, "")
+	suite.Require().ErrorIs(err, types.ErrSendDisabled)
+
+	suite.requireOwner(suite.chainA, classID, sender)
+}
+
+func (suite *NFTTransferTestSuite) TestGenesis() {
+	suite.relay(suite.path.EndpointA, suite.transfer(suite.path.EndpointA, classID, suite.chainB.SenderAccount.GetAddress().String(), suite.defaultTimeout()))
+
+	keeper := chaosApp(suite.chainB).NFTTransferKeeper
+	gs := keeper.ExportGenesis(suite.chainB.GetContext())
+	suite.Require().NoError(gs.Validate())
+	suite.Require().Equal(types.PortID, gs.PortId)
+	suite.Require().Len(gs.ClassTraces, 1)
+	suite.Require().Equal(suite.voucherClassID(), gs.ClassTraces[0].IBCClassID())
+	suite.Require().Equal(types.DefaultParams(), gs.Params)
+
+	// importing the exported state into a fresh chain restores the traces
+	chainC := ibctesting.NewTestChain(suite.T(), suite.coordinator, ibctesting.GetChainID(3))
+	ctx := chainC.GetContext()
+	chaosApp(chainC).NFTTransferKeeper.InitGenesis(ctx, *gs)
+	suite.Require().Equal(gs, chaosApp(chainC).NFTTransferKeeper.ExportGenesis(ctx))
+}
+
+func TestParseClassTrace(t *testing.T) {
+	testCases := []struct {
+		rawClassID string
+		expected   types.ClassTrace
+	}{
+		{"kitty", types.ClassTrace{BaseClassId: "kitty"}},
+		{"game/items", types.ClassTrace{BaseClassId: "game/items"}},
+		{"nft-transfer/channel-0/kitty", types.ClassTrace{Path: "nft-transfer/channel-0", BaseClassId: "kitty"}},
+		{"nft-transfer/channel-0/game/items", types.ClassTrace{Path: "nft-transfer/channel-0", BaseClassId: "game/items"}},
+		{"nft-transfer/channel-1/nft-transfer/channel-0/kitty", types.ClassTrace{Path: "nft-transfer/channel-1/nft-transfer/channel-0", BaseClassId: "kitty"}},
+	}
+
+	for _, tc := range testCases {
+		trace := types.ParseClassTrace(tc.rawClassID)
+		require.Equal(t, tc.expected, trace, tc.rawClassID)
+		require.Equal(t, tc.rawClassID, trace.GetFullClassPath())
+		require.NoError(t, trace.Validate())
+		require.NoError(t, types.ValidateIBCClassID(trace.IBCClassID()))
+	}
+}
+
+func TestPacketDataEncoding(t *testing.T) {
+	data := types.NewNonFungibleTokenPacketData("nft-transfer/channel-0/kitty", "", tokenIDs, nil, "sender", "receiver", "")
+	require.NoError(t, data.ValidateBasic())
+
+	// fields are camel-cased and empty fields omitted as in ICS-721
+	bz := data.GetBytes()
+	require.Equal(t, `{"classId":"nft-transfer/channel-0/kitty","receiver":"receiver","sender":"sender","tokenIds":["kitty1","kitty2"]}`, string(bz))
+
+	decoded, err := types.UnmarshalPacketData(bz)
+	require.NoError(t, err)
+	require.Equal(t, data, decoded)
+
+	_, err = types.UnmarshalPacketData([]byte("invalid"))
+	require.Error(t, err)
+
+	data.TokenIds = append(data.TokenIds, tokenIDs[0])
+	require.ErrorIs(t, data.ValidateBasic(), types.ErrInvalidTokenIDs)
+}
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos-builders/chaos/x/nfttransfer/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// ClassTrace implements the Query/ClassTrace gRPC method
+func (k Keeper) ClassTrace(goCtx context.Context, req *types.QueryClassTraceRequest) (*types.QueryClassTraceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	hash, err := types.ParseHexHash(strings.TrimPrefix(req.Hash, types.ClassPrefix+"/"))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid class trace hash: %s, error: %s", req.Hash, err))
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	classTrace, found := k.GetClassTrace(ctx, hash)
+	if !found {
+		return nil, status.Error(codes.NotFound, sdkerrors.Wrap(types.ErrTraceNotFound, req.Hash).Error())
+	}
+
+	return &types.QueryClassTraceResponse{ClassTrace: &classTrace}, nil
+}
+
+// ClassTraces implements the Query/ClassTraces gRPC method
+func (k Keeper) ClassTraces(goCtx context.Context, req *types.QueryClassTracesRequest) (*types.QueryClassTracesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	traces := types.Traces{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClassTraceKey)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var trace types.ClassTrace
+		if err := k.cdc.Unmarshal(value, &trace); err != nil {
+			return err
+		}
+
+		traces = append(traces, trace)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryClassTracesResponse{
+		ClassTraces: traces.Sort(),
+		Pagination:  pageRes,
+	}, nil
+}
+
+// ClassHash implements the Query/ClassHash gRPC method
+func (k Keeper) ClassHash(goCtx context.Context, req *types.QueryClassHashRequest) (*types.QueryClassHashResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	classTrace := types.ParseClassTrace(req.Trace)
+	if err := classTrace.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	hash := classTrace.Hash()
+	if !k.HasClassTrace(ctx, hash) {
+		return nil, status.Error(codes.NotFound, sdkerrors.Wrap(types.ErrTraceNotFound, req.Trace).Error())
+	}
+
+	return &types.QueryClassHashResponse{Hash: hash.String()}, nil
+}
+
+// EscrowAddress implements the Query/EscrowAddress gRPC method
+func (k Keeper) EscrowAddress(goCtx context.Context, req *types.QueryEscrowAddressRequest) (*types.QueryEscrowAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return &types.QueryEscrowAddressResponse{
+		EscrowAddress: types.GetEscrowAddress(req.PortId, req.ChannelId).String(),
+	}, nil
+}
+
+// Params implements the Query/Params gRPC method
+func (k Keeper) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
@@ -0,0 +1,247 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+
+	"github.com/cosmos-builders/chaos/x/nfttransfer/types"
+)
+
+// SendTransfer sends the tokens tokenIDs of the class classID owned by
+// sender over the given channel and returns the sequence of the packet.
+//
+// If the class was created on this chain, or received over another channel,
+// this chain is the source of the transfer and the tokens are escrowed in the
+// escrow account of the channel. Otherwise the tokens are vouchers of the
+// counterparty chain and are burned.
+//
+// The class and tokens URIs are sent along with their ids, the remaining
+// class metadata and the class and token data are not.
+func (k Keeper) SendTransfer(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel string,
+	classID string,
+	tokenIDs []string,
+	sender sdk.AccAddress,
+	receiver string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	memo string,
+) (uint64, error) {
+	if !k.GetParams(ctx).SendEnabled {
+		return 0, types.ErrSendDisabled
+	}
+
+	sourceChannelEnd, found := k.channelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
+	if !found {
+		return 0, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+	}
+
+	destinationPort := sourceChannelEnd.GetCounterparty().GetPortID()
+	destinationChannel := sourceChannelEnd.GetCounterparty().GetChannelID()
+
+	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, sourcePort, sourceChannel)
+	if !found {
+		return 0, sdkerrors.Wrapf(
+			channeltypes.ErrSequenceSendNotFound,
+			"source port: %s, source channel: %s", sourcePort, sourceChannel,
+		)
+	}
+
+	channelCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(sourcePort, sourceChannel))
+	if !ok {
+		return 0, sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	class, found := k.nftKeeper.GetClass(ctx, classID)
+	if !found {
+		return 0, sdkerrors.Wrap(nft.ErrClassNotExists, classID)
+	}
+
+	fullClassPath := classID
+	if strings.HasPrefix(classID, types.ClassPrefix+"/") {
+		var err error
+		if fullClassPath, err = k.ClassPathFromHash(ctx, classID); err != nil {
+			return 0, err
+		}
+	}
+
+	tokenURIs := make([]string, len(tokenIDs))
+	for i, tokenID := range tokenIDs {
+		token, found := k.nftKeeper.GetNFT(ctx, classID, tokenID)
+		if !found {
+			return 0, sdkerrors.Wrapf(nft.ErrNFTNotExists, "%s/%s", classID, tokenID)
+		}
+		if owner := k.nftKeeper.GetOwner(ctx, classID, tokenID); !owner.Equals(sender) {
+			return 0, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s/%s", sender, classID, tokenID)
+		}
+		tokenURIs[i] = token.Uri
+	}
+
+	if types.SenderChainIsSource(sourcePort, sourceChannel, fullClassPath) {
+		escrowAddress := types.GetEscrowAddress(sourcePort, sourceChannel)
+		for _, tokenID := range tokenIDs {
+			if err := k.nftKeeper.Transfer(ctx, classID, tokenID, escrowAddress); err != nil {
+				return 0, err
+			}
+		}
+	} else {
+		for _, tokenID := range tokenIDs {
+			if err := k.nftKeeper.Burn(ctx, classID, tokenID); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	packetData := types.NewNonFungibleTokenPacketData(
+		fullClassPath, class.Uri, tokenIDs, tokenURIs, sender.String(), receiver, memo,
+	)
+
+	packet := channeltypes.NewPacket(
+		packetData.GetBytes(),
+		sequence,
+		sourcePort,
+		sourceChannel,
+		destinationPort,
+		destinationChannel,
+		timeoutHeight,
+		timeoutTimestamp,
+	)
+
+	if err := k.ics4Wrapper.SendPacket(ctx, channelCap, packet); err != nil {
+		return 0, err
+	}
+
+	return sequence, nil
+}
+
+// OnRecvPacket processes an ICS-721 packet. If this chain is the source of
+// the class, the tokens are released from the escrow account of the channel.
+// Otherwise vouchers are minted in the class 'ibc/{hash}' of the class trace,
+// which is created on the first transfer.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	if err := data.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if !k.GetParams(ctx).ReceiveEnabled {
+		return types.ErrReceiveDisabled
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return err
+	}
+
+	if types.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.ClassId) {
+		// remove the prefix added by the sender chain
+		voucherPrefix := types.GetClassPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		classID := types.ParseClassTrace(data.ClassId[len(voucherPrefix):]).IBCClassID()
+
+		// x/nft transfers do not check the current owner, so the tokens must
+		// be checked to be escrowed for this channel before releasing them
+		escrowAddress := types.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
+		for _, tokenID := range data.TokenIds {
+			if owner := k.nftKeeper.GetOwner(ctx, classID, tokenID); !owner.Equals(escrowAddress) {
+				return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "nft %s/%s is not escrowed for channel %s", classID, tokenID, packet.GetDestChannel())
+			}
+			if err := k.nftKeeper.Transfer(ctx, classID, tokenID, receiver); err != nil {
+				return sdkerrors.Wrap(err, "unable to unescrow nft")
+			}
+		}
+
+		return nil
+	}
+
+	// add the prefix of this chain to the class trace
+	sourcePrefix := types.GetClassPrefix(packet.GetDestPort(), packet.GetDestChannel())
+	classTrace := types.ParseClassTrace(sourcePrefix + data.ClassId)
+
+	traceHash := classTrace.Hash()
+	if !k.HasClassTrace(ctx, traceHash) {
+		k.SetClassTrace(ctx, classTrace)
+	}
+
+	voucherClassID := classTrace.IBCClassID()
+	if !k.nftKeeper.HasClass(ctx, voucherClassID) {
+		if err := k.nftKeeper.SaveClass(ctx, nft.Class{Id: voucherClassID, Uri: data.ClassUri}); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClassTrace,
+			sdk.NewAttribute(types.AttributeKeyTraceHash, traceHash.String()),
+			sdk.NewAttribute(types.AttributeKeyClassID, voucherClassID),
+		),
+	)
+
+	for i, tokenID := range data.TokenIds {
+		voucher := nft.NFT{ClassId: voucherClassID, Id: tokenID, Uri: data.TokenURI(i)}
+		if err := k.nftKeeper.Mint(ctx, voucher, receiver); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// OnAcknowledgementPacket refunds the tokens of the packet to the sender if
+// the packet failed on the counterparty chain.
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData, ack channeltypes.Acknowledgement) error {
+	switch ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		return k.refundPacketTokens(ctx, packet, data)
+	default:
+		// the acknowledgement succeeded on the receiving chain so nothing
+		// needs to be executed and no error needs to be returned
+		return nil
+	}
+}
+
+// OnTimeoutPacket refunds the tokens of the packet to the sender.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	return k.refundPacketTokens(ctx, packet, data)
+}
+
+// refundPacketTokens returns the tokens of the packet to the sender, either
+// by releasing them from escrow or by minting back the burned vouchers.
+func (k Keeper) refundPacketTokens(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return err
+	}
+
+	classID := types.ParseClassTrace(data.ClassId).IBCClassID()
+
+	if types.SenderChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.ClassId) {
+		escrowAddress := types.GetEscrowAddress(packet.GetSourcePort(), packet.GetSourceChannel())
+		for _, tokenID := range data.TokenIds {
+			if owner := k.nftKeeper.GetOwner(ctx, classID, tokenID); !owner.Equals(escrowAddress) {
+				return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "nft %s/%s is not escrowed for channel %s", classID, tokenID, packet.GetSourceChannel())
+			}
+			if err := k.nftKeeper.Transfer(ctx, classID, tokenID, sender); err != nil {
+				return sdkerrors.Wrap(err, "unable to unescrow nft")
+			}
+		}
+
+		return nil
+	}
+
+	for i, tokenID := range data.TokenIds {
+		voucher := nft.NFT{ClassId: classID, Id: tokenID, Uri: data.TokenURI(i)}
+		if err := k.nftKeeper.Mint(ctx, voucher, sender); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
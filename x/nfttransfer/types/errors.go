@@ -0,0 +1,17 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// nft-transfer sentinel errors
+var (
+	ErrInvalidPacketTimeout = sdkerrors.Register(ModuleName, 2, "invalid packet timeout")
+	ErrInvalidClassID       = sdkerrors.Register(ModuleName, 3, "invalid class id")
+	ErrInvalidTokenIDs      = sdkerrors.Register(ModuleName, 4, "invalid token ids")
+	ErrTraceNotFound        = sdkerrors.Register(ModuleName, 5, "class trace not found")
+	ErrInvalidVersion       = sdkerrors.Register(ModuleName, 6, "invalid ICS-721 version")
+	ErrSendDisabled         = sdkerrors.Register(ModuleName, 7, "nft transfers from this chain are disabled")
+	ErrReceiveDisabled      = sdkerrors.Register(ModuleName, 8, "nft transfers to this chain are disabled")
+	ErrMaxTransferChannels  = sdkerrors.Register(ModuleName, 9, "max nft-transfer channels")
+)
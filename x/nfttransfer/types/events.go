@@ -0,0 +1,20 @@
+package types
+
+// nft-transfer events
+const (
+	EventTypeTransfer   = "nft_transfer"
+	EventTypePacket     = "non_fungible_token_packet"
+	EventTypeTimeout    = "nft_timeout"
+	EventTypeClassTrace = "class_trace"
+
+	AttributeKeySender         = "sender"
+	AttributeKeyReceiver       = "receiver"
+	AttributeKeyClassID        = "class_id"
+	AttributeKeyTokenIDs       = "token_ids"
+	AttributeKeyTraceHash      = "trace_hash"
+	AttributeKeyRefundReceiver = "refund_receiver"
+	AttributeKeyAck            = "acknowledgement"
+	AttributeKeyAckSuccess     = "success"
+	AttributeKeyAckError       = "error"
+	AttributeKeyMemo           = "memo"
+)
@@ -0,0 +1,33 @@
+package types
+
+import (
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+)
+
+// DefaultGenesis returns the default nft-transfer genesis state
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState(PortID, Traces{}, DefaultParams())
+}
+
+// NewGenesisState creates a new nft-transfer GenesisState
+func NewGenesisState(portID string, classTraces Traces, params Params) *GenesisState {
+	return &GenesisState{
+		PortId:      portID,
+		ClassTraces: classTraces,
+		Params:      params,
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := host.PortIdentifierValidator(gs.PortId); err != nil {
+		return err
+	}
+
+	if err := gs.ClassTraces.Validate(); err != nil {
+		return err
+	}
+
+	return gs.Params.Validate()
+}
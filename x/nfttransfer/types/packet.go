@@ -0,0 +1,105 @@
+package types
+
+import (
+	"bytes"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+var (
+	// DefaultRelativePacketTimeoutHeight is the default packet timeout height
+	// (in blocks) relative to the current block height of the counterparty
+	// chain. The timeout is disabled when set to 0.
+	DefaultRelativePacketTimeoutHeight = "0-1000"
+
+	// DefaultRelativePacketTimeoutTimestamp is the default packet timeout
+	// timestamp (in nanoseconds) relative to the current block timestamp of
+	// the counterparty chain. The timeout is disabled when set to 0.
+	DefaultRelativePacketTimeoutTimestamp = uint64((10 * time.Minute).Nanoseconds())
+)
+
+// NewNonFungibleTokenPacketData constructs a new NonFungibleTokenPacketData
+// instance
+func NewNonFungibleTokenPacketData(
+	classID, classURI string, tokenIDs, tokenURIs []string, sender, receiver, memo string,
+) NonFungibleTokenPacketData {
+	return NonFungibleTokenPacketData{
+		ClassId:   classID,
+		ClassUri:  classURI,
+		TokenIds:  tokenIDs,
+		TokenUris: tokenURIs,
+		Sender:    sender,
+		Receiver:  receiver,
+		Memo:      memo,
+	}
+}
+
+// ValidateBasic is used for validating the nft transfer.
+// NOTE: The addresses formats are not validated as the sender and recipient
+// can have different formats defined by their corresponding chains that are
+// not known to IBC.
+func (data NonFungibleTokenPacketData) ValidateBasic() error {
+	if strings.TrimSpace(data.Sender) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be blank")
+	}
+	if strings.TrimSpace(data.Receiver) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "receiver address cannot be blank")
+	}
+	if len(data.TokenUris) != 0 && len(data.TokenUris) != len(data.TokenIds) {
+		return sdkerrors.Wrapf(ErrInvalidTokenIDs, "got %d token uris for %d token ids", len(data.TokenUris), len(data.TokenIds))
+	}
+	if err := ValidateTokenIDs(data.TokenIds); err != nil {
+		return err
+	}
+	return ValidatePrefixedClassID(data.ClassId)
+}
+
+// GetBytes returns the JSON encoded packet data. Fields are camel-cased as
+// in the ICS-721 specification and empty fields are omitted.
+func (data NonFungibleTokenPacketData) GetBytes() []byte {
+	return sdk.MustSortJSON(mustProtoMarshalJSON(&data))
+}
+
+// TokenURI returns the uri of the i-th token of the packet
+func (data NonFungibleTokenPacketData) TokenURI(i int) string {
+	if i < len(data.TokenUris) {
+		return data.TokenUris[i]
+	}
+	return ""
+}
+
+// ValidateTokenIDs checks that ids is a non-empty list of distinct x/nft
+// token ids.
+func ValidateTokenIDs(ids []string) error {
+	if len(ids) == 0 {
+		return sdkerrors.Wrap(ErrInvalidTokenIDs, "token ids cannot be empty")
+	}
+
+	seen := make(map[string]bool)
+	for _, id := range ids {
+		if err := nft.ValidateNFTID(id); err != nil {
+			return sdkerrors.Wrap(ErrInvalidTokenIDs, err.Error())
+		}
+		if seen[id] {
+			return sdkerrors.Wrapf(ErrInvalidTokenIDs, "duplicate token id %s", id)
+		}
+		seen[id] = true
+	}
+
+	return nil
+}
+
+// UnmarshalPacketData decodes the JSON encoded packet data bz.
+func UnmarshalPacketData(bz []byte) (NonFungibleTokenPacketData, error) {
+	var data NonFungibleTokenPacketData
+	if err := jsonpb.Unmarshal(bytes.NewReader(bz), &data); err != nil {
+		return NonFungibleTokenPacketData{}, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-721 nft-transfer packet data: %s", err.Error())
+	}
+	return data, nil
+}
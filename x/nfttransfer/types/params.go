@@ -0,0 +1,59 @@
+package types
+
+import (
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var (
+	// KeySendEnabled is the store key for the SendEnabled param
+	KeySendEnabled = []byte("SendEnabled")
+	// KeyReceiveEnabled is the store key for the ReceiveEnabled param
+	KeyReceiveEnabled = []byte("ReceiveEnabled")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable returns the param key table for the nft-transfer module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new nft-transfer Params instance
+func NewParams(enableSend, enableReceive bool) Params {
+	return Params{
+		SendEnabled:    enableSend,
+		ReceiveEnabled: enableReceive,
+	}
+}
+
+// DefaultParams returns the default nft-transfer parameters
+func DefaultParams() Params {
+	return NewParams(true, true)
+}
+
+// Validate validates all nft-transfer parameters
+func (p Params) Validate() error {
+	if err := validateEnabled(p.SendEnabled); err != nil {
+		return err
+	}
+
+	return validateEnabled(p.ReceiveEnabled)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeySendEnabled, &p.SendEnabled, validateEnabled),
+		paramtypes.NewParamSetPair(KeyReceiveEnabled, &p.ReceiveEnabled, validateEnabled),
+	}
+}
+
+func validateEnabled(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -0,0 +1,238 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// ParseClassTrace parses a class id prefixed with its trace into a ClassTrace.
+//
+// Examples:
+//
+// - "nft-transfer/channel-0/kitty" => ClassTrace{Path: "nft-transfer/channel-0", BaseClassId: "kitty"}
+// - "nft-transfer/channel-0/game/items" => ClassTrace{Path: "nft-transfer/channel-0", BaseClassId: "game/items"}
+// - "kitty" => ClassTrace{Path: "", BaseClassId: "kitty"}
+func ParseClassTrace(rawClassID string) ClassTrace {
+	classSplit := strings.Split(rawClassID, "/")
+
+	if classSplit[0] == rawClassID {
+		return ClassTrace{
+			Path:        "",
+			BaseClassId: rawClassID,
+		}
+	}
+
+	path, baseClassID := extractPathAndBaseFromFullClassID(classSplit)
+	return ClassTrace{
+		Path:        path,
+		BaseClassId: baseClassID,
+	}
+}
+
+// Hash returns the hex bytes of the SHA256 hash of the ClassTrace fields using the following formula:
+//
+// hash = sha256(tracePath + "/" + baseClassId)
+func (ct ClassTrace) Hash() tmbytes.HexBytes {
+	hash := sha256.Sum256([]byte(ct.GetFullClassPath()))
+	return hash[:]
+}
+
+// GetPrefix returns the receiving class id prefix composed by the trace info and a separator.
+func (ct ClassTrace) GetPrefix() string {
+	return ct.Path + "/"
+}
+
+// IBCClassID returns the x/nft class id of the NFT vouchers of the class in
+// the format 'ibc/{hash(tracePath + baseClassId)}'. If the trace is empty, it
+// returns the base class id.
+func (ct ClassTrace) IBCClassID() string {
+	if ct.Path != "" {
+		return fmt.Sprintf("%s/%s", ClassPrefix, ct.Hash())
+	}
+	return ct.BaseClassId
+}
+
+// GetFullClassPath returns the full class id according to the ICS-721
+// specification: tracePath + "/" + baseClassId. If there exists no trace then
+// the base class id is returned.
+func (ct ClassTrace) GetFullClassPath() string {
+	if ct.Path == "" {
+		return ct.BaseClassId
+	}
+	return ct.GetPrefix() + ct.BaseClassId
+}
+
+// extractPathAndBaseFromFullClassID returns the trace path and the base class
+// id from the elements that constitute the complete class id. As for ICS-20
+// denominations, port/channel pairs are recognized by the format of the
+// channel identifiers ibc-go generates.
+func extractPathAndBaseFromFullClassID(fullClassItems []string) (string, string) {
+	var (
+		path        []string
+		baseClassID []string
+	)
+
+	length := len(fullClassItems)
+	for i := 0; i < length; i += 2 {
+		if i < length-1 && length > 2 && channeltypes.IsValidChannelID(fullClassItems[i+1]) {
+			path = append(path, fullClassItems[i], fullClassItems[i+1])
+		} else {
+			baseClassID = fullClassItems[i:]
+			break
+		}
+	}
+
+	return strings.Join(path, "/"), strings.Join(baseClassID, "/")
+}
+
+func validateTraceIdentifiers(identifiers []string) error {
+	if len(identifiers) == 0 || len(identifiers)%2 != 0 {
+		return fmt.Errorf("trace info must come in pairs of port and channel identifiers '{portID}/{channelID}', got the identifiers: %s", identifiers)
+	}
+
+	// validate correctness of port and channel identifiers
+	for i := 0; i < len(identifiers); i += 2 {
+		if err := host.PortIdentifierValidator(identifiers[i]); err != nil {
+			return sdkerrors.Wrapf(err, "invalid port ID at position %d", i)
+		}
+		if err := host.ChannelIdentifierValidator(identifiers[i+1]); err != nil {
+			return sdkerrors.Wrapf(err, "invalid channel ID at position %d", i)
+		}
+	}
+	return nil
+}
+
+// Validate performs a basic validation of the ClassTrace fields.
+func (ct ClassTrace) Validate() error {
+	// empty trace is accepted when the class lives on the original chain
+	switch {
+	case ct.Path == "" && ct.BaseClassId != "":
+		return nil
+	case strings.TrimSpace(ct.BaseClassId) == "":
+		return fmt.Errorf("base class id cannot be blank")
+	}
+
+	identifiers := strings.Split(ct.Path, "/")
+	return validateTraceIdentifiers(identifiers)
+}
+
+// Traces defines a wrapper type for a slice of ClassTrace.
+type Traces []ClassTrace
+
+// Validate performs a basic validation of each class trace info.
+func (t Traces) Validate() error {
+	seenTraces := make(map[string]bool)
+	for i, trace := range t {
+		hash := trace.Hash().String()
+		if seenTraces[hash] {
+			return fmt.Errorf("duplicated class trace with hash %s", trace.Hash())
+		}
+
+		if err := trace.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "failed class trace %d validation", i)
+		}
+		seenTraces[hash] = true
+	}
+	return nil
+}
+
+var _ sort.Interface = Traces{}
+
+// Len implements sort.Interface for Traces
+func (t Traces) Len() int { return len(t) }
+
+// Less implements sort.Interface for Traces
+func (t Traces) Less(i, j int) bool { return t[i].GetFullClassPath() < t[j].GetFullClassPath() }
+
+// Swap implements sort.Interface for Traces
+func (t Traces) Swap(i, j int) { t[i], t[j] = t[j], t[i] }
+
+// Sort is a helper function to sort the set of class traces in-place
+func (t Traces) Sort() Traces {
+	sort.Sort(t)
+	return t
+}
+
+// ValidatePrefixedClassID checks that the class id of a non-fungible token
+// packet is either a base class id or is correctly prefixed with its trace:
+// '{portIDN}/{channelIDN}/.../{portID0}/{channelID0}/baseClassId'.
+func ValidatePrefixedClassID(classID string) error {
+	classSplit := strings.Split(classID, "/")
+	if classSplit[0] == classID && strings.TrimSpace(classID) != "" {
+		return nil
+	}
+
+	if strings.TrimSpace(classSplit[len(classSplit)-1]) == "" {
+		return sdkerrors.Wrap(ErrInvalidClassID, "base class id cannot be blank")
+	}
+
+	path, _ := extractPathAndBaseFromFullClassID(classSplit)
+	if path == "" {
+		// NOTE: the base class id contains slashes
+		return nil
+	}
+
+	identifiers := strings.Split(path, "/")
+	return validateTraceIdentifiers(identifiers)
+}
+
+// ValidateIBCClassID validates that the given class id is either a valid
+// x/nft class id or the id of a class of NFT vouchers, i.e. 'ibc/{hash}'.
+func ValidateIBCClassID(classID string) error {
+	if err := nft.ValidateClassID(classID); err != nil {
+		return err
+	}
+
+	classSplit := strings.SplitN(classID, "/", 2)
+	if len(classSplit) == 2 && classSplit[0] == ClassPrefix {
+		if _, err := ParseHexHash(classSplit[1]); err != nil {
+			return sdkerrors.Wrapf(err, "invalid class trace hash %s", classSplit[1])
+		}
+	}
+
+	return nil
+}
+
+// ParseHexHash parses a hex hash in string format to bytes and validates its correctness.
+func ParseHexHash(hexHash string) (tmbytes.HexBytes, error) {
+	hash, err := hex.DecodeString(hexHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tmtypes.ValidateHash(hash); err != nil {
+		return nil, err
+	}
+
+	return hash, nil
+}
+
+// SenderChainIsSource returns false if the class was received over the
+// source port and channel, i.e. if its full class path is prefixed with
+// them. The NFTs of classes whose sender chain is the source are escrowed
+// instead of burned.
+func SenderChainIsSource(sourcePort, sourceChannel, classID string) bool {
+	return !ReceiverChainIsSource(sourcePort, sourceChannel, classID)
+}
+
+// ReceiverChainIsSource returns true if the class originally came from the
+// receiving chain, i.e. if the class id sent in the packet is prefixed with
+// the source port and channel of the packet.
+func ReceiverChainIsSource(sourcePort, sourceChannel, classID string) bool {
+	return strings.HasPrefix(classID, GetClassPrefix(sourcePort, sourceChannel))
+}
+
+// GetClassPrefix returns the receiving class id prefix
+func GetClassPrefix(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s/", portID, channelID)
+}
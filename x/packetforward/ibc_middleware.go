@@ -0,0 +1,239 @@
+package packetforward
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v5/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v5/modules/core/exported"
+
+	"github.com/cosmos-builders/chaos/x/packetforward/keeper"
+	"github.com/cosmos-builders/chaos/x/packetforward/types"
+)
+
+const (
+	// DefaultForwardTimeout is the timeout of a forwarded packet whose
+	// forwarding instruction does not set one.
+	DefaultForwardTimeout = 10 * time.Minute
+
+	// DefaultRetriesOnTimeout is the number of times a forwarded packet is
+	// re-sent after timing out if its forwarding instruction does not say.
+	DefaultRetriesOnTimeout uint8 = 2
+)
+
+var _ porttypes.IBCModule = IBCMiddleware{}
+
+// IBCMiddleware implements the ICS26 callbacks for the packet forward
+// middleware. It wraps an ICS-20 transfer application and forwards the funds
+// of the packets whose memo holds a forwarding instruction to the next hop.
+type IBCMiddleware struct {
+	app    porttypes.IBCModule
+	keeper keeper.Keeper
+
+	retriesOnTimeout uint8
+	forwardTimeout   time.Duration
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the wrapped transfer
+// application, the keeper and the defaults of the forwarding instructions.
+func NewIBCMiddleware(app porttypes.IBCModule, k keeper.Keeper, retriesOnTimeout uint8, forwardTimeout time.Duration) IBCMiddleware {
+	return IBCMiddleware{
+		app:              app,
+		keeper:           k,
+		retriesOnTimeout: retriesOnTimeout,
+		forwardTimeout:   forwardTimeout,
+	}
+}
+
+// OnChanOpenInit implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version)
+}
+
+// OnChanOpenTry implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, counterpartyVersion)
+}
+
+// OnChanOpenAck implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCModule interface
+func (im IBCMiddleware) OnChanCloseInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm implements the IBCModule interface
+func (im IBCMiddleware) OnChanCloseConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnRecvPacket implements the IBCModule interface. Packets without a
+// forwarding instruction are passed to the transfer application untouched.
+// Otherwise the funds are received into an intermediate account and sent over
+// the next channel, and the acknowledgement is written asynchronously once the
+// forwarded packet completes.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	metadata, ok, err := types.ParsePacketMetadata(data.Memo)
+	if !ok {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	// receive the funds into the intermediate account
+	data.Receiver = types.ForwardSender(packet.GetDestChannel(), data.Sender).String()
+	data.Memo = ""
+
+	overridePacket := packet
+	overridePacket.Data = data.GetBytes()
+
+	ack := im.app.OnRecvPacket(ctx, overridePacket, relayer)
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	retries := im.retriesOnTimeout
+	if metadata.Retries != nil {
+		retries = *metadata.Retries
+	}
+	timeout := im.forwardTimeout
+	if metadata.Timeout > 0 {
+		timeout = time.Duration(metadata.Timeout)
+	}
+
+	if err := im.keeper.ForwardPacket(ctx, packet, data, metadata, retries, timeout); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	// the acknowledgement is written once the forwarded packet completes
+	return nil
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. The
+// acknowledgement of a forwarded packet is propagated to the previous hop,
+// which is refunded if the forwarded packet failed.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	// refunds the intermediate account on error
+	if err := im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	inFlight, found := im.keeper.GetInFlightPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return nil
+	}
+	im.keeper.RemoveInFlightPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
+	}
+
+	if !ack.Success() {
+		return im.keeper.RefundPacket(ctx, inFlight, sdkerrors.Wrap(types.ErrForwardFailed, ack.GetError()))
+	}
+
+	return im.keeper.WriteAcknowledgement(ctx, inFlight, channeltypes.NewResultAcknowledgement([]byte{byte(1)}))
+}
+
+// OnTimeoutPacket implements the IBCModule interface. A forwarded packet that
+// timed out is re-sent while it has retries left, the previous hop is refunded
+// otherwise.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	// refunds the intermediate account
+	if err := im.app.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	inFlight, found := im.keeper.GetInFlightPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return nil
+	}
+	im.keeper.RemoveInFlightPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+	if inFlight.RetriesRemaining > 0 {
+		var data transfertypes.FungibleTokenPacketData
+		if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err)
+		}
+
+		cacheCtx, writeFn := ctx.CacheContext()
+		err := im.keeper.RetryTimeout(cacheCtx, inFlight, data)
+		if err == nil {
+			writeFn()
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+			return nil
+		}
+		im.keeper.Logger(ctx).Error("failed to retry forwarded packet", "error", err)
+	}
+
+	return im.keeper.RefundPacket(ctx, inFlight, sdkerrors.Wrap(types.ErrForwardTimeout, fmt.Sprintf("%s/%s/%d", packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())))
+}
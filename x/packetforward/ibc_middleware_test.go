@@ -0,0 +1,286 @@
+package packetforward_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+
+	"github.com/cosmos-builders/chaos/app"
+	"github.com/cosmos-builders/chaos/x/packetforward/types"
+)
+
+func chaosApp(chain *ibctesting.TestChain) *app.App {
+	return chain.App.(*app.App)
+}
+
+// PacketForwardTestSuite routes transfers from chain A to chain C through the
+// packet forward middleware of chain B.
+type PacketForwardTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+	chainC *ibctesting.TestChain
+
+	pathAB *ibctesting.Path
+	pathBC *ibctesting.Path
+}
+
+func TestPacketForwardTestSuite(t *testing.T) {
+	suite.Run(t, new(PacketForwardTestSuite))
+}
+
+func (suite *PacketForwardTestSuite) SetupTest() {
+	ibctesting.DefaultTestingAppInit = app.SetupTestingApp
+
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 3)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+	suite.chainC = suite.coordinator.GetChain(ibctesting.GetChainID(3))
+
+	suite.pathAB = newTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(suite.pathAB)
+	suite.pathBC = newTransferPath(suite.chainB, suite.chainC)
+	suite.coordinator.Setup(suite.pathBC)
+}
+
+func newTransferPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	path.EndpointB.ChannelConfig.Version = transfertypes.Version
+
+	return path
+}
+
+// forwardMemo returns a memo forwarding the funds received on chain B to
+// receiver on chain C.
+func (suite *PacketForwardTestSuite) forwardMemo(receiver string, timeout time.Duration, retries uint8, next string) string {
+	forward := &types.ForwardMetadata{
+		Receiver: receiver,
+		Port:     suite.pathBC.EndpointA.ChannelConfig.PortID,
+		Channel:  suite.pathBC.EndpointA.ChannelID,
+		Timeout:  types.Duration(timeout),
+		Retries:  &retries,
+	}
+	if next != "" {
+		forward.Next = json.RawMessage(next)
+	}
+
+	bz, err := json.Marshal(types.PacketMetadata{Forward: forward})
+	suite.Require().NoError(err)
+
+	return string(bz)
+}
+
+// transfer sends 100stake from chain A to chain B and returns the packet.
+func (suite *PacketForwardTestSuite) transfer(memo string) channeltypes.Packet {
+	msg := transfertypes.NewMsgTransfer(
+		suite.pathAB.EndpointA.ChannelConfig.PortID, suite.pathAB.EndpointA.ChannelID,
+		sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+		suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(),
+		clienttypes.NewHeight(1, 110), 0,
+	)
+	msg.Memo = memo
+
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	return packet
+}
+
+// recvAndForward receives a packet on chain B and returns the forwarded packet.
+func (suite *PacketForwardTestSuite) recvAndForward(packet channeltypes.Packet) channeltypes.Packet {
+	suite.Require().NoError(suite.pathAB.EndpointB.UpdateClient())
+	res, err := suite.pathAB.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	// the acknowledgement is written once the forwarded packet completes
+	_, err = ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().Error(err)
+
+	forwarded, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	return forwarded
+}
+
+// acknowledgePacket relays the acknowledgement of a packet to endpoint and
+// returns the result of the transaction.
+func (suite *PacketForwardTestSuite) acknowledgePacket(endpoint *ibctesting.Endpoint, packet channeltypes.Packet, ack []byte) *sdk.Result {
+	packetKey := host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	proof, proofHeight := endpoint.Counterparty.QueryProof(packetKey)
+
+	res, err := endpoint.Chain.SendMsgs(channeltypes.NewMsgAcknowledgement(packet, ack, proof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String()))
+	suite.Require().NoError(err)
+
+	return res
+}
+
+// timeoutPacket times out a packet sent by endpoint and returns the result of
+// the transaction.
+func (suite *PacketForwardTestSuite) timeoutPacket(endpoint *ibctesting.Endpoint, packet channeltypes.Packet) *sdk.Result {
+	suite.coordinator.IncrementTimeBy(time.Hour)
+	suite.coordinator.CommitBlock(endpoint.Counterparty.Chain)
+	suite.Require().NoError(endpoint.UpdateClient())
+
+	packetKey := host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	proof, proofHeight := endpoint.Counterparty.QueryProof(packetKey)
+	nextSeqRecv, found := endpoint.Counterparty.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(endpoint.Counterparty.Chain.GetContext(), packet.GetDestPort(), packet.GetDestChannel())
+	suite.Require().True(found)
+
+	res, err := endpoint.Chain.SendMsgs(channeltypes.NewMsgTimeout(packet, nextSeqRecv, proof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String()))
+	suite.Require().NoError(err)
+
+	return res
+}
+
+// relayAckToA relays the acknowledgement written by chain B for packet back to
+// chain A.
+func (suite *PacketForwardTestSuite) relayAckToA(res *sdk.Result, packet channeltypes.Packet) channeltypes.Acknowledgement {
+	bz, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.pathAB.EndpointA.UpdateClient())
+	suite.Require().NoError(suite.pathAB.EndpointA.AcknowledgePacket(packet, bz))
+
+	var ack channeltypes.Acknowledgement
+	suite.Require().NoError(transfertypes.ModuleCdc.UnmarshalJSON(bz, &ack))
+
+	return ack
+}
+
+// voucherDenom returns the denom of chain A's stake on chain B.
+func (suite *PacketForwardTestSuite) voucherDenom() string {
+	return transfertypes.ParseDenomTrace(
+		transfertypes.GetPrefixedDenom(suite.pathAB.EndpointB.ChannelConfig.PortID, suite.pathAB.EndpointB.ChannelID, sdk.DefaultBondDenom),
+	).IBCDenom()
+}
+
+func (suite *PacketForwardTestSuite) TestForward() {
+	receiver := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	next := `{"note":"final hop"}`
+
+	packet := suite.transfer(suite.forwardMemo(receiver.String(), time.Hour, 0, next))
+	forwarded := suite.recvAndForward(packet)
+
+	var data transfertypes.FungibleTokenPacketData
+	suite.Require().NoError(transfertypes.ModuleCdc.UnmarshalJSON(forwarded.GetData(), &data))
+	suite.Require().Equal(receiver.String(), data.Receiver)
+	suite.Require().Equal(next, data.Memo)
+
+	appB := chaosApp(suite.chainB)
+	suite.Require().Len(appB.PacketForwardKeeper.GetAllInFlightPackets(suite.chainB.GetContext()), 1)
+
+	// relay the forwarded packet to chain C
+	suite.Require().NoError(suite.pathBC.EndpointB.UpdateClient())
+	res, err := suite.pathBC.EndpointB.RecvPacketWithResult(forwarded)
+	suite.Require().NoError(err)
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	// the acknowledgement of the forwarded packet completes the original one
+	res = suite.acknowledgePacket(suite.pathBC.EndpointA, forwarded, ack)
+	suite.Require().True(suite.relayAckToA(res, packet).Success())
+
+	suite.Require().Empty(appB.PacketForwardKeeper.GetAllInFlightPackets(suite.chainB.GetContext()))
+
+	finalDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(
+		suite.pathBC.EndpointB.ChannelConfig.PortID, suite.pathBC.EndpointB.ChannelID,
+		transfertypes.GetPrefixedDenom(suite.pathAB.EndpointB.ChannelConfig.PortID, suite.pathAB.EndpointB.ChannelID, sdk.DefaultBondDenom),
+	)).IBCDenom()
+	balance := chaosApp(suite.chainC).BankKeeper.GetBalance(suite.chainC.GetContext(), receiver, finalDenom)
+	suite.Require().Equal(sdk.NewInt(100), balance.Amount)
+
+	// the vouchers of chain A are escrowed on chain B
+	forwardSender := types.ForwardSender(suite.pathAB.EndpointB.ChannelID, suite.chainA.SenderAccount.GetAddress().String())
+	ctxB := suite.chainB.GetContext()
+	suite.Require().True(appB.BankKeeper.GetAllBalances(ctxB, forwardSender).IsZero())
+	escrow := transfertypes.GetEscrowAddress(suite.pathBC.EndpointA.ChannelConfig.PortID, suite.pathBC.EndpointA.ChannelID)
+	suite.Require().Equal(sdk.NewInt(100), appB.BankKeeper.GetBalance(ctxB, escrow, suite.voucherDenom()).Amount)
+}
+
+func (suite *PacketForwardTestSuite) TestRefundOnFailedHop() {
+	appA := chaosApp(suite.chainA)
+	sender := suite.chainA.SenderAccount.GetAddress()
+	balanceBefore := appA.BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+
+	// chain C rejects the invalid receiver
+	packet := suite.transfer(suite.forwardMemo("invalid", time.Hour, 0, ""))
+	forwarded := suite.recvAndForward(packet)
+
+	suite.Require().NoError(suite.pathBC.EndpointB.UpdateClient())
+	res, err := suite.pathBC.EndpointB.RecvPacketWithResult(forwarded)
+	suite.Require().NoError(err)
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	res = suite.acknowledgePacket(suite.pathBC.EndpointA, forwarded, ack)
+	suite.Require().False(suite.relayAckToA(res, packet).Success())
+
+	// the vouchers minted on chain B are burnt and chain A refunds the sender
+	appB := chaosApp(suite.chainB)
+	ctxB := suite.chainB.GetContext()
+	suite.Require().True(appB.BankKeeper.GetSupply(ctxB, suite.voucherDenom()).IsZero())
+	suite.Require().Empty(appB.PacketForwardKeeper.GetAllInFlightPackets(ctxB))
+	suite.Require().Equal(balanceBefore, appA.BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom))
+}
+
+func (suite *PacketForwardTestSuite) TestRetryAndRefundOnTimeout() {
+	appA := chaosApp(suite.chainA)
+	sender := suite.chainA.SenderAccount.GetAddress()
+	balanceBefore := appA.BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+
+	receiver := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	packet := suite.transfer(suite.forwardMemo(receiver.String(), time.Minute, 1, ""))
+	forwarded := suite.recvAndForward(packet)
+
+	// the first timeout re-sends the forwarded packet
+	res := suite.timeoutPacket(suite.pathBC.EndpointA, forwarded)
+	retried, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().Equal(forwarded.GetSequence()+1, retried.GetSequence())
+	suite.Require().Equal(forwarded.GetData(), retried.GetData())
+
+	appB := chaosApp(suite.chainB)
+	inFlight := appB.PacketForwardKeeper.GetAllInFlightPackets(suite.chainB.GetContext())
+	suite.Require().Len(inFlight, 1)
+	suite.Require().Equal(retried.GetSequence(), inFlight[0].ForwardSequence)
+	suite.Require().Zero(inFlight[0].RetriesRemaining)
+
+	// the second timeout refunds the original packet
+	res = suite.timeoutPacket(suite.pathBC.EndpointA, retried)
+	suite.Require().False(suite.relayAckToA(res, packet).Success())
+
+	ctxB := suite.chainB.GetContext()
+	suite.Require().True(appB.BankKeeper.GetSupply(ctxB, suite.voucherDenom()).IsZero())
+	suite.Require().Empty(appB.PacketForwardKeeper.GetAllInFlightPackets(ctxB))
+	suite.Require().Equal(balanceBefore, appA.BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom))
+}
+
+func (suite *PacketForwardTestSuite) TestPassThrough() {
+	// packets without a forwarding instruction are handled by transfer alone
+	packet := suite.transfer(`{"note":"not a forward"}`)
+	suite.Require().NoError(suite.pathAB.RelayPacket(packet))
+
+	appB := chaosApp(suite.chainB)
+	balance := appB.BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), suite.voucherDenom())
+	suite.Require().Equal(sdk.NewInt(100), balance.Amount)
+	suite.Require().Empty(appB.PacketForwardKeeper.GetAllInFlightPackets(suite.chainB.GetContext()))
+}
@@ -0,0 +1,187 @@
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+
+	"github.com/cosmos-builders/chaos/x/packetforward/types"
+)
+
+// ForwardPacket sends the funds of a received ICS-20 packet over the next
+// channel. The funds must already have been received into the forward sender
+// account of the packet. The acknowledgement of the received packet is
+// written once the forwarded packet completes.
+func (k Keeper) ForwardPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+	metadata *types.ForwardMetadata,
+	retries uint8,
+	timeout time.Duration,
+) error {
+	token, err := receivedToken(packet, data)
+	if err != nil {
+		return err
+	}
+
+	memo, err := metadata.NextMemo()
+	if err != nil {
+		return err
+	}
+
+	inFlight := types.InFlightPacket{
+		OriginalPacket:   packet,
+		ForwardSender:    types.ForwardSender(packet.GetDestChannel(), data.Sender).String(),
+		ForwardPortId:    metadata.Port,
+		ForwardChannelId: metadata.Channel,
+		Timeout:          uint64(timeout),
+		RetriesRemaining: uint32(retries),
+	}
+
+	return k.forward(ctx, inFlight, token, metadata.Receiver, memo)
+}
+
+// RetryTimeout re-sends a forwarded packet that timed out. The funds must
+// already have been refunded to the forward sender account.
+func (k Keeper) RetryTimeout(ctx sdk.Context, inFlight types.InFlightPacket, data transfertypes.FungibleTokenPacketData) error {
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return sdkerrors.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount (%s)", data.Amount)
+	}
+	token := sdk.NewCoin(transfertypes.ParseDenomTrace(data.Denom).IBCDenom(), amount)
+
+	inFlight.RetriesRemaining--
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRetry,
+			sdk.NewAttribute(types.AttributeKeyPortID, inFlight.ForwardPortId),
+			sdk.NewAttribute(types.AttributeKeyChannelID, inFlight.ForwardChannelId),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(inFlight.ForwardSequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyRetriesRemaining, strconv.FormatUint(uint64(inFlight.RetriesRemaining), 10)),
+		),
+	)
+
+	return k.forward(ctx, inFlight, token, data.Receiver, data.Memo)
+}
+
+// forward sends token from the forward sender account and keeps track of the
+// forwarded packet.
+func (k Keeper) forward(ctx sdk.Context, inFlight types.InFlightPacket, token sdk.Coin, receiver, memo string) error {
+	msg := transfertypes.NewMsgTransfer(
+		inFlight.ForwardPortId, inFlight.ForwardChannelId, token,
+		inFlight.ForwardSender, receiver,
+		clienttypes.ZeroHeight(), uint64(ctx.BlockTime().UnixNano())+inFlight.Timeout,
+	)
+	msg.Memo = memo
+
+	res, err := k.transferKeeper.Transfer(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrForwardFailed, err.Error())
+	}
+
+	inFlight.ForwardSequence = res.Sequence
+	k.SetInFlightPacket(ctx, inFlight)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForward,
+			sdk.NewAttribute(types.AttributeKeyPortID, inFlight.ForwardPortId),
+			sdk.NewAttribute(types.AttributeKeyChannelID, inFlight.ForwardChannelId),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(res.Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyReceiver, receiver),
+		),
+	)
+
+	return nil
+}
+
+// RefundPacket reverts the receipt of the original packet of a forwarded
+// packet that failed and acknowledges the original packet with an error, so
+// that the previous hop refunds its sender in turn. The funds must already
+// have been refunded to the forward sender account.
+func (k Keeper) RefundPacket(ctx sdk.Context, inFlight types.InFlightPacket, reason error) error {
+	packet := inFlight.OriginalPacket
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err)
+	}
+
+	token, err := receivedToken(packet, data)
+	if err != nil {
+		return err
+	}
+
+	forwardSender, err := sdk.AccAddressFromBech32(inFlight.ForwardSender)
+	if err != nil {
+		return err
+	}
+
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		// the tokens were unescrowed on receipt, escrow them again
+		escrowAddress := transfertypes.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
+		if err := k.bankKeeper.SendCoins(ctx, forwardSender, escrowAddress, sdk.NewCoins(token)); err != nil {
+			return err
+		}
+	} else {
+		// the vouchers were minted on receipt, burn them
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, forwardSender, transfertypes.ModuleName, sdk.NewCoins(token)); err != nil {
+			return err
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, transfertypes.ModuleName, sdk.NewCoins(token)); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRefund,
+			sdk.NewAttribute(types.AttributeKeyPortID, packet.GetDestPort()),
+			sdk.NewAttribute(types.AttributeKeyChannelID, packet.GetDestChannel()),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.GetSequence(), 10)),
+			sdk.NewAttribute(types.AttributeKeyError, reason.Error()),
+		),
+	)
+
+	return k.WriteAcknowledgement(ctx, inFlight, channeltypes.NewErrorAcknowledgement(reason))
+}
+
+// WriteAcknowledgement writes the acknowledgement of the original packet of a
+// forwarded packet.
+func (k Keeper) WriteAcknowledgement(ctx sdk.Context, inFlight types.InFlightPacket, ack channeltypes.Acknowledgement) error {
+	packet := inFlight.OriginalPacket
+
+	_, chanCap, err := k.channelKeeper.LookupModuleByChannel(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	if err != nil {
+		return sdkerrors.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// receivedToken returns the token credited on this chain upon receipt of an
+// ICS-20 packet.
+func receivedToken(packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) (sdk.Coin, error) {
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return sdk.Coin{}, sdkerrors.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount (%s)", data.Amount)
+	}
+
+	var denom string
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		voucherPrefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		denom = transfertypes.ParseDenomTrace(data.Denom[len(voucherPrefix):]).IBCDenom()
+	} else {
+		prefixedDenom := transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), data.Denom)
+		denom = transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+	}
+
+	return sdk.NewCoin(denom, amount), nil
+}
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	porttypes "github.com/cosmos/ibc-go/v5/modules/core/05-port/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos-builders/chaos/x/packetforward/types"
+)
+
+// Keeper is the packetforward keeper. It keeps track of the packets forwarded
+// to the next hop until their acknowledgement or timeout is relayed back.
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
+
+	transferKeeper types.TransferKeeper
+	channelKeeper  types.ChannelKeeper
+	ics4Wrapper    porttypes.ICS4Wrapper
+	bankKeeper     types.BankKeeper
+}
+
+// NewKeeper creates a new packetforward Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	key storetypes.StoreKey,
+	transferKeeper types.TransferKeeper,
+	channelKeeper types.ChannelKeeper,
+	ics4Wrapper porttypes.ICS4Wrapper,
+	bankKeeper types.BankKeeper,
+) Keeper {
+	return Keeper{
+		cdc:            cdc,
+		storeKey:       key,
+		transferKeeper: transferKeeper,
+		channelKeeper:  channelKeeper,
+		ics4Wrapper:    ics4Wrapper,
+		bankKeeper:     bankKeeper,
+	}
+}
+
+// Logger returns the application logger, scoped to the associated module
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// SetInFlightPacket stores a forwarded packet until it completes.
+func (k Keeper) SetInFlightPacket(ctx sdk.Context, packet types.InFlightPacket) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(
+		types.InFlightPacketKey(packet.ForwardPortId, packet.ForwardChannelId, packet.ForwardSequence),
+		k.cdc.MustMarshal(&packet),
+	)
+}
+
+// GetInFlightPacket returns the forwarded packet sent with the given port,
+// channel and sequence.
+func (k Keeper) GetInFlightPacket(ctx sdk.Context, portID, channelID string, sequence uint64) (types.InFlightPacket, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.InFlightPacketKey(portID, channelID, sequence))
+	if bz == nil {
+		return types.InFlightPacket{}, false
+	}
+
+	var packet types.InFlightPacket
+	k.cdc.MustUnmarshal(bz, &packet)
+
+	return packet, true
+}
+
+// RemoveInFlightPacket deletes a forwarded packet.
+func (k Keeper) RemoveInFlightPacket(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.InFlightPacketKey(portID, channelID, sequence))
+}
+
+// GetAllInFlightPackets returns every forwarded packet still in flight.
+func (k Keeper) GetAllInFlightPackets(ctx sdk.Context) []types.InFlightPacket {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.InFlightPacketKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var packets []types.InFlightPacket
+	for ; iterator.Valid(); iterator.Next() {
+		var packet types.InFlightPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &packet)
+		packets = append(packets, packet)
+	}
+
+	return packets
+}
+
+// InitGenesis initializes the packetforward state from a genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
+	for _, packet := range gs.InFlightPackets {
+		k.SetInFlightPacket(ctx, packet)
+	}
+}
+
+// ExportGenesis exports the packetforward state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetAllInFlightPackets(ctx))
+}
@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// packetforward sentinel errors
+var (
+	ErrInvalidForwardMetadata = sdkerrors.Register(ModuleName, 2, "invalid forward metadata")
+	ErrForwardFailed          = sdkerrors.Register(ModuleName, 3, "packet forward failed")
+	ErrForwardTimeout         = sdkerrors.Register(ModuleName, 4, "forwarded packet timed out")
+)
@@ -0,0 +1,15 @@
+package types
+
+// packetforward events
+const (
+	EventTypeForward = "packet_forward"
+	EventTypeRetry   = "packet_forward_retry"
+	EventTypeRefund  = "packet_forward_refund"
+
+	AttributeKeyPortID           = "port_id"
+	AttributeKeyChannelID        = "channel_id"
+	AttributeKeySequence         = "sequence"
+	AttributeKeyReceiver         = "receiver"
+	AttributeKeyRetriesRemaining = "retries_remaining"
+	AttributeKeyError            = "error"
+)
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"fmt"
+
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+)
+
+// DefaultGenesis returns the default packetforward genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{}
+}
+
+// NewGenesisState creates a new packetforward GenesisState
+func NewGenesisState(inFlightPackets []InFlightPacket) *GenesisState {
+	return &GenesisState{InFlightPackets: inFlightPackets}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool)
+	for _, p := range gs.InFlightPackets {
+		if err := host.PortIdentifierValidator(p.ForwardPortId); err != nil {
+			return err
+		}
+		if err := host.ChannelIdentifierValidator(p.ForwardChannelId); err != nil {
+			return err
+		}
+		if err := p.OriginalPacket.ValidateBasic(); err != nil {
+			return err
+		}
+
+		key := string(InFlightPacketKey(p.ForwardPortId, p.ForwardChannelId, p.ForwardSequence))
+		if seen[key] {
+			return fmt.Errorf("duplicate in-flight packet %s/%s/%d", p.ForwardPortId, p.ForwardChannelId, p.ForwardSequence)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName defines the packet forward middleware module name
+	ModuleName = "packetforward"
+
+	// StoreKey is the store key string for the packetforward module
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the packetforward module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the packetforward module
+	QuerierRoute = ModuleName
+)
+
+// InFlightPacketKeyPrefix is the prefix of the in-flight packet store.
+var InFlightPacketKeyPrefix = []byte{0x01}
+
+// InFlightPacketKey returns the store key of the in-flight packet forwarded
+// with the given port, channel and sequence.
+func InFlightPacketKey(portID, channelID string, sequence uint64) []byte {
+	return append(InFlightPacketKeyPrefix, []byte(fmt.Sprintf("%s/%s/%d", portID, channelID, sequence))...)
+}
+
+// ForwardSender returns the intermediate account that receives funds sent
+// by sender over the given channel before they are forwarded to the next hop.
+// The account is derived so that nobody holds its private key.
+func ForwardSender(channelID, sender string) sdk.AccAddress {
+	return address.Module(ModuleName, []byte(channelID+"/"+sender))
+}
@@ -0,0 +1,123 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+)
+
+// PacketMetadata is the ICS-20 memo of a packet to be forwarded, e.g.
+//
+//	{"forward":{"receiver":"cosmos1...","port":"transfer","channel":"channel-1","timeout":"10m","retries":2}}
+//
+// The optional next field holds the memo of the forwarded packet, which allows
+// forwarding over several hops.
+type PacketMetadata struct {
+	Forward *ForwardMetadata `json:"forward"`
+}
+
+// ForwardMetadata is the forwarding instruction of a packet.
+type ForwardMetadata struct {
+	Receiver string   `json:"receiver"`
+	Port     string   `json:"port"`
+	Channel  string   `json:"channel"`
+	Timeout  Duration `json:"timeout,omitempty"`
+	Retries  *uint8   `json:"retries,omitempty"`
+
+	// Next is either a JSON object or a JSON string.
+	Next json.RawMessage `json:"next,omitempty"`
+}
+
+// ParsePacketMetadata parses the forwarding instruction of an ICS-20 memo.
+// It returns false if the memo does not hold one.
+func ParsePacketMetadata(memo string) (*ForwardMetadata, bool, error) {
+	if memo == "" {
+		return nil, false, nil
+	}
+
+	var metadata PacketMetadata
+	if err := json.Unmarshal([]byte(memo), &metadata); err != nil || metadata.Forward == nil {
+		// the memo is either not JSON or meant for somebody else
+		return nil, false, nil
+	}
+
+	if err := metadata.Forward.Validate(); err != nil {
+		return nil, true, err
+	}
+
+	return metadata.Forward, true, nil
+}
+
+// Validate performs a stateless validation of the forwarding instruction.
+func (m ForwardMetadata) Validate() error {
+	if m.Receiver == "" {
+		return sdkerrors.Wrap(ErrInvalidForwardMetadata, "receiver cannot be empty")
+	}
+	if err := host.PortIdentifierValidator(m.Port); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidForwardMetadata, "invalid port: %s", err)
+	}
+	if err := host.ChannelIdentifierValidator(m.Channel); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidForwardMetadata, "invalid channel: %s", err)
+	}
+	if m.Timeout < 0 {
+		return sdkerrors.Wrap(ErrInvalidForwardMetadata, "timeout cannot be negative")
+	}
+
+	return nil
+}
+
+// NextMemo returns the memo of the forwarded packet.
+func (m ForwardMetadata) NextMemo() (string, error) {
+	if len(m.Next) == 0 {
+		return "", nil
+	}
+
+	if m.Next[0] == '"' {
+		var memo string
+		if err := json.Unmarshal(m.Next, &memo); err != nil {
+			return "", sdkerrors.Wrapf(ErrInvalidForwardMetadata, "invalid next: %s", err)
+		}
+		return memo, nil
+	}
+
+	return string(m.Next), nil
+}
+
+// Duration is a time.Duration encoded either as a duration string such as
+// "10m" or as a number of nanoseconds.
+type Duration time.Duration
+
+// MarshalJSON implements json.Marshaler.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *Duration) UnmarshalJSON(bz []byte) error {
+	var s string
+	if err := json.Unmarshal(bz, &s); err != nil {
+		var ns int64
+		if err := json.Unmarshal(bz, &ns); err != nil {
+			return fmt.Errorf("invalid duration %s", bz)
+		}
+		*d = Duration(ns)
+		return nil
+	}
+
+	duration, err := time.ParseDuration(s)
+	if err != nil {
+		// accept a quoted number of nanoseconds as well
+		ns, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid duration %q", s)
+		}
+		duration = time.Duration(ns)
+	}
+	*d = Duration(duration)
+
+	return nil
+}
@@ -0,0 +1,167 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/cosmos-builders/chaos/x/ratelimit/types"
+)
+
+// NewCmdSubmitAddRateLimitProposal implements a command handler for submitting
+// an add rate limit proposal transaction.
+func NewCmdSubmitAddRateLimitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-rate-limit [channel-id] [denom] [max-percent-send] [max-percent-recv] [duration-hours]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Submit a proposal to rate limit a channel and denom",
+		Long: "Submit a proposal to rate limit a channel and denom along with an initial deposit.\n" +
+			"The net amount sent or received over the channel within each window of duration-hours\n" +
+			"is bounded by the given percentage of the denom supply. A percentage of zero blocks the direction.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			quota, err := parseQuota(args[2], args[3], args[4])
+			if err != nil {
+				return err
+			}
+
+			return submitProposal(cmd, func(title, description string) govv1beta1.Content {
+				return types.NewAddRateLimitProposal(title, description, args[1], args[0], quota.MaxPercentSend, quota.MaxPercentRecv, quota.DurationHours)
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitUpdateRateLimitProposal implements a command handler for
+// submitting an update rate limit proposal transaction.
+func NewCmdSubmitUpdateRateLimitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-rate-limit [channel-id] [denom] [max-percent-send] [max-percent-recv] [duration-hours]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Submit a proposal to change the quota of a rate limit",
+		Long: "Submit a proposal to change the quota of a rate limit along with an initial deposit.\n" +
+			"The flow of the rate limit is reset once the proposal passes.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			quota, err := parseQuota(args[2], args[3], args[4])
+			if err != nil {
+				return err
+			}
+
+			return submitProposal(cmd, func(title, description string) govv1beta1.Content {
+				return types.NewUpdateRateLimitProposal(title, description, args[1], args[0], quota.MaxPercentSend, quota.MaxPercentRecv, quota.DurationHours)
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitRemoveRateLimitProposal implements a command handler for
+// submitting a remove rate limit proposal transaction.
+func NewCmdSubmitRemoveRateLimitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-rate-limit [channel-id] [denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to stop rate limiting a channel and denom",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(title, description string) govv1beta1.Content {
+				return types.NewRemoveRateLimitProposal(title, description, args[1], args[0])
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitResetRateLimitProposal implements a command handler for
+// submitting a reset rate limit proposal transaction.
+func NewCmdSubmitResetRateLimitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reset-rate-limit [channel-id] [denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to reset the flow of a rate limit",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(title, description string) govv1beta1.Content {
+				return types.NewResetRateLimitProposal(title, description, args[1], args[0])
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+func parseQuota(maxPercentSend, maxPercentRecv, durationHours string) (types.Quota, error) {
+	send, ok := sdk.NewIntFromString(maxPercentSend)
+	if !ok {
+		return types.Quota{}, fmt.Errorf("invalid max percent send %s", maxPercentSend)
+	}
+	recv, ok := sdk.NewIntFromString(maxPercentRecv)
+	if !ok {
+		return types.Quota{}, fmt.Errorf("invalid max percent recv %s", maxPercentRecv)
+	}
+	hours, err := strconv.ParseUint(durationHours, 10, 64)
+	if err != nil {
+		return types.Quota{}, fmt.Errorf("invalid duration hours %s: %w", durationHours, err)
+	}
+
+	return types.NewQuota(send, recv, hours), nil
+}
+
+func submitProposal(cmd *cobra.Command, newContent func(title, description string) govv1beta1.Content) error {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	title, err := cmd.Flags().GetString(govcli.FlagTitle) //nolint:staticcheck // need this till full govv1 conversion.
+	if err != nil {
+		return err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription) //nolint:staticcheck // need this till full govv1 conversion.
+	if err != nil {
+		return err
+	}
+
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return err
+	}
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return err
+	}
+
+	msg, err := govv1beta1.NewMsgSubmitProposal(newContent(title, description), deposit, clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+
+	if err = msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
+
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")             //nolint:staticcheck // need this till full govv1 conversion.
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal") //nolint:staticcheck // need this till full govv1 conversion.
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+}
@@ -0,0 +1,14 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/cosmos-builders/chaos/x/ratelimit/client/cli"
+)
+
+var (
+	AddRateLimitProposalHandler    = govclient.NewProposalHandler(cli.NewCmdSubmitAddRateLimitProposal)
+	UpdateRateLimitProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitUpdateRateLimitProposal)
+	RemoveRateLimitProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitRemoveRateLimitProposal)
+	ResetRateLimitProposalHandler  = govclient.NewProposalHandler(cli.NewCmdSubmitResetRateLimitProposal)
+)
@@ -0,0 +1,309 @@
+package ratelimit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	transfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+
+	"github.com/cosmos-builders/chaos/app"
+	"github.com/cosmos-builders/chaos/x/ratelimit"
+	"github.com/cosmos-builders/chaos/x/ratelimit/types"
+)
+
+func chaosApp(chain *ibctesting.TestChain) *app.App {
+	return chain.App.(*app.App)
+}
+
+type RateLimitTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+}
+
+func TestRateLimitTestSuite(t *testing.T) {
+	suite.Run(t, new(RateLimitTestSuite))
+}
+
+func (suite *RateLimitTestSuite) SetupTest() {
+	ibctesting.DefaultTestingAppInit = app.SetupTestingApp
+
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	suite.path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	suite.path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	suite.path.EndpointB.ChannelConfig.Version = transfertypes.Version
+	suite.coordinator.Setup(suite.path)
+}
+
+// addRateLimit rate limits denom on the channel of endpoint through a
+// governance proposal and returns the send and receive thresholds.
+func (suite *RateLimitTestSuite) addRateLimit(endpoint *ibctesting.Endpoint, denom string, maxPercent int64) sdk.Int {
+	chain := endpoint.Chain
+	content := types.NewAddRateLimitProposal("title", "description", denom, endpoint.ChannelID, sdk.NewInt(maxPercent), sdk.NewInt(maxPercent), 24)
+	suite.Require().NoError(content.ValidateBasic())
+
+	handler := ratelimit.NewRateLimitProposalHandler(chaosApp(chain).RateLimitKeeper)
+	suite.Require().NoError(handler(chain.GetContext(), content))
+	chain.NextBlock()
+
+	rateLimit := suite.rateLimit(endpoint, denom)
+	return rateLimit.Quota.Threshold(types.PacketSend, rateLimit.Flow.ChannelValue)
+}
+
+func (suite *RateLimitTestSuite) rateLimit(endpoint *ibctesting.Endpoint, denom string) types.RateLimit {
+	res, err := chaosApp(endpoint.Chain).RateLimitKeeper.RateLimit(
+		sdk.WrapSDKContext(endpoint.Chain.GetContext()),
+		&types.QueryRateLimitRequest{Denom: denom, ChannelId: endpoint.ChannelID},
+	)
+	suite.Require().NoError(err)
+
+	return *res.RateLimit
+}
+
+func (suite *RateLimitTestSuite) newMsgTransfer(endpoint *ibctesting.Endpoint, denom string, amount sdk.Int, receiver string) *transfertypes.MsgTransfer {
+	return transfertypes.NewMsgTransfer(
+		endpoint.ChannelConfig.PortID, endpoint.ChannelID,
+		sdk.NewCoin(denom, amount),
+		endpoint.Chain.SenderAccount.GetAddress().String(), receiver,
+		clienttypes.NewHeight(1, 110), 0,
+	)
+}
+
+// transfer sends amount of denom from the chain of endpoint to its
+// counterparty and returns the packet.
+func (suite *RateLimitTestSuite) transfer(endpoint *ibctesting.Endpoint, denom string, amount sdk.Int, receiver string) channeltypes.Packet {
+	res, err := endpoint.Chain.SendMsgs(suite.newMsgTransfer(endpoint, denom, amount, receiver))
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	return packet
+}
+
+func (suite *RateLimitTestSuite) TestSendQuota() {
+	threshold := suite.addRateLimit(suite.path.EndpointA, sdk.DefaultBondDenom, 1)
+	receiver := suite.chainB.SenderAccount.GetAddress().String()
+
+	// the whole quota can be used
+	packet := suite.transfer(suite.path.EndpointA, sdk.DefaultBondDenom, threshold, receiver)
+	suite.Require().Equal(threshold, suite.rateLimit(suite.path.EndpointA, sdk.DefaultBondDenom).Flow.Outflow)
+
+	// but not exceeded
+	appA := chaosApp(suite.chainA)
+	msg := suite.newMsgTransfer(suite.path.EndpointA, sdk.DefaultBondDenom, sdk.OneInt(), receiver)
+	_, err := appA.TransferKeeper.Transfer(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+	suite.Require().ErrorIs(err, types.ErrQuotaExceeded)
+
+	// a successful acknowledgement settles the packet
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+	suite.Require().Empty(appA.RateLimitKeeper.GetAllPendingSendPackets(suite.chainA.GetContext()))
+	suite.Require().Equal(threshold, suite.rateLimit(suite.path.EndpointA, sdk.DefaultBondDenom).Flow.Outflow)
+
+	// other denoms and channels are not rate limited
+	suite.Require().Len(appA.RateLimitKeeper.GetRateLimitsByChannel(suite.chainA.GetContext(), suite.path.EndpointA.ChannelID), 1)
+	suite.Require().Empty(appA.RateLimitKeeper.GetRateLimitsByChannel(suite.chainA.GetContext(), "channel-1"))
+}
+
+func (suite *RateLimitTestSuite) TestFailedAckRestoresOutflow() {
+	threshold := suite.addRateLimit(suite.path.EndpointA, sdk.DefaultBondDenom, 1)
+
+	// chain B rejects the invalid receiver
+	packet := suite.transfer(suite.path.EndpointA, sdk.DefaultBondDenom, threshold, "invalid")
+
+	appA := chaosApp(suite.chainA)
+	suite.Require().Len(appA.RateLimitKeeper.GetAllPendingSendPackets(suite.chainA.GetContext()), 1)
+
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+	suite.Require().True(suite.rateLimit(suite.path.EndpointA, sdk.DefaultBondDenom).Flow.Outflow.IsZero())
+	suite.Require().Empty(appA.RateLimitKeeper.GetAllPendingSendPackets(suite.chainA.GetContext()))
+}
+
+func (suite *RateLimitTestSuite) TestTimeoutRestoresOutflow() {
+	threshold := suite.addRateLimit(suite.path.EndpointA, sdk.DefaultBondDenom, 1)
+
+	msg := suite.newMsgTransfer(suite.path.EndpointA, sdk.DefaultBondDenom, threshold, suite.chainB.SenderAccount.GetAddress().String())
+	msg.TimeoutHeight = clienttypes.ZeroHeight()
+	msg.TimeoutTimestamp = uint64(suite.chainB.CurrentHeader.Time.Add(time.Minute).UnixNano())
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	suite.coordinator.IncrementTimeBy(time.Hour)
+	suite.coordinator.CommitBlock(suite.chainB)
+	suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+	suite.Require().NoError(suite.path.EndpointA.TimeoutPacket(packet))
+
+	suite.Require().True(suite.rateLimit(suite.path.EndpointA, sdk.DefaultBondDenom).Flow.Outflow.IsZero())
+	suite.Require().Empty(chaosApp(suite.chainA).RateLimitKeeper.GetAllPendingSendPackets(suite.chainA.GetContext()))
+}
+
+func (suite *RateLimitTestSuite) TestRecvQuota() {
+	voucherDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(
+		suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, sdk.DefaultBondDenom,
+	)).IBCDenom()
+	receiver := suite.chainB.SenderAccount.GetAddress()
+
+	packet := suite.transfer(suite.path.EndpointA, sdk.DefaultBondDenom, sdk.NewInt(1000), receiver.String())
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+
+	// chain B accepts at most half of the voucher supply per window
+	threshold := suite.addRateLimit(suite.path.EndpointB, voucherDenom, 50)
+	suite.Require().Equal(sdk.NewInt(500), threshold)
+
+	appA := chaosApp(suite.chainA)
+	sender := suite.chainA.SenderAccount.GetAddress()
+	balanceBefore := appA.BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+
+	packet = suite.transfer(suite.path.EndpointA, sdk.DefaultBondDenom, threshold.AddRaw(1), receiver.String())
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+
+	// the packet is rejected and refunded
+	appB := chaosApp(suite.chainB)
+	suite.Require().Equal(sdk.NewInt(1000), appB.BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, voucherDenom).Amount)
+	suite.Require().True(suite.rateLimit(suite.path.EndpointB, voucherDenom).Flow.Inflow.IsZero())
+	suite.Require().Equal(balanceBefore, appA.BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom))
+
+	// returning vouchers offsets the inflow
+	packet = suite.transfer(suite.path.EndpointB, voucherDenom, sdk.NewInt(100), sender.String())
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+
+	packet = suite.transfer(suite.path.EndpointA, sdk.DefaultBondDenom, threshold.AddRaw(1), receiver.String())
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+	suite.Require().Equal(sdk.NewInt(1401), appB.BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, voucherDenom).Amount)
+}
+
+func (suite *RateLimitTestSuite) TestWindowReset() {
+	threshold := suite.addRateLimit(suite.path.EndpointA, sdk.DefaultBondDenom, 1)
+	suite.transfer(suite.path.EndpointA, sdk.DefaultBondDenom, threshold, suite.chainB.SenderAccount.GetAddress().String())
+
+	appA := chaosApp(suite.chainA)
+	suite.Require().Len(appA.RateLimitKeeper.GetAllPendingSendPackets(suite.chainA.GetContext()), 1)
+
+	// the next window starts in the first block after the quota duration
+	suite.coordinator.IncrementTimeBy(24 * time.Hour)
+	suite.coordinator.CommitBlock(suite.chainA)
+	suite.chainA.NextBlock()
+
+	rateLimit := suite.rateLimit(suite.path.EndpointA, sdk.DefaultBondDenom)
+	suite.Require().True(rateLimit.Flow.Outflow.IsZero())
+	suite.Require().True(suite.chainA.GetContext().BlockTime().Add(-rateLimit.Quota.Duration()).Before(rateLimit.WindowStart))
+	suite.Require().Empty(appA.RateLimitKeeper.GetAllPendingSendPackets(suite.chainA.GetContext()))
+}
+
+func (suite *RateLimitTestSuite) TestProposals() {
+	appA := chaosApp(suite.chainA)
+	handler := ratelimit.NewRateLimitProposalHandler(appA.RateLimitKeeper)
+	channelID := suite.path.EndpointA.ChannelID
+
+	suite.addRateLimit(suite.path.EndpointA, sdk.DefaultBondDenom, 1)
+
+	testCases := []struct {
+		name    string
+		content govv1beta1.Content
+		expErr  error
+	}{
+		{
+			"add existing rate limit",
+			types.NewAddRateLimitProposal("title", "description", sdk.DefaultBondDenom, channelID, sdk.NewInt(1), sdk.NewInt(1), 24),
+			types.ErrRateLimitAlreadyExists,
+		},
+		{
+			"add rate limit on unknown channel",
+			types.NewAddRateLimitProposal("title", "description", sdk.DefaultBondDenom, "channel-9", sdk.NewInt(1), sdk.NewInt(1), 24),
+			types.ErrChannelNotFound,
+		},
+		{
+			"add rate limit on denom without supply",
+			types.NewAddRateLimitProposal("title", "description", "nosupply", channelID, sdk.NewInt(1), sdk.NewInt(1), 24),
+			types.ErrZeroChannelValue,
+		},
+		{
+			"update rate limit",
+			types.NewUpdateRateLimitProposal("title", "description", sdk.DefaultBondDenom, channelID, sdk.NewInt(10), sdk.NewInt(5), 12),
+			nil,
+		},
+		{
+			"reset unknown rate limit",
+			types.NewResetRateLimitProposal("title", "description", "nosupply", channelID),
+			types.ErrRateLimitNotFound,
+		},
+		{
+			"remove rate limit",
+			types.NewRemoveRateLimitProposal("title", "description", sdk.DefaultBondDenom, channelID),
+			nil,
+		},
+		{
+			"remove unknown rate limit",
+			types.NewRemoveRateLimitProposal("title", "description", sdk.DefaultBondDenom, channelID),
+			types.ErrRateLimitNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Require().NoError(tc.content.ValidateBasic(), tc.name)
+
+		suite.Require().Equal(types.RouterKey, tc.content.ProposalRoute(), tc.name)
+
+		err := handler(suite.chainA.GetContext(), tc.content)
+		if tc.expErr != nil {
+			suite.Require().ErrorIs(err, tc.expErr, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+		}
+	}
+
+	suite.Require().Empty(appA.RateLimitKeeper.GetAllRateLimits(suite.chainA.GetContext()))
+}
+
+func (suite *RateLimitTestSuite) TestInvalidQuota() {
+	channelID := suite.path.EndpointA.ChannelID
+
+	for _, quota := range []types.Quota{
+		types.NewQuota(sdk.ZeroInt(), sdk.ZeroInt(), 24),
+		types.NewQuota(sdk.NewInt(101), sdk.NewInt(1), 24),
+		types.NewQuota(sdk.NewInt(1), sdk.NewInt(-1), 24),
+		types.NewQuota(sdk.NewInt(1), sdk.NewInt(1), 0),
+	} {
+		content := types.NewAddRateLimitProposal("title", "description", sdk.DefaultBondDenom, channelID, quota.MaxPercentSend, quota.MaxPercentRecv, quota.DurationHours)
+		suite.Require().ErrorIs(content.ValidateBasic(), types.ErrInvalidQuota)
+	}
+}
+
+func (suite *RateLimitTestSuite) TestGenesis() {
+	threshold := suite.addRateLimit(suite.path.EndpointA, sdk.DefaultBondDenom, 1)
+	suite.transfer(suite.path.EndpointA, sdk.DefaultBondDenom, threshold, suite.chainB.SenderAccount.GetAddress().String())
+
+	appA := chaosApp(suite.chainA)
+	gs := appA.RateLimitKeeper.ExportGenesis(suite.chainA.GetContext())
+	suite.Require().NoError(gs.Validate())
+	suite.Require().Len(gs.RateLimits, 1)
+	suite.Require().Len(gs.PendingSendPackets, 1)
+
+	// importing the exported state into a new chain restores it
+	appB := chaosApp(suite.chainB)
+	ctxB := suite.chainB.GetContext()
+	appB.RateLimitKeeper.InitGenesis(ctxB, *gs)
+	suite.Require().Equal(gs, appB.RateLimitKeeper.ExportGenesis(ctxB))
+
+	gs.PendingSendPackets = append(gs.PendingSendPackets, gs.PendingSendPackets[0])
+	suite.Require().Error(gs.Validate())
+}
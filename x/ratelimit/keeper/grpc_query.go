@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos-builders/chaos/x/ratelimit/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// AllRateLimits implements the Query/AllRateLimits gRPC method
+func (k Keeper) AllRateLimits(goCtx context.Context, req *types.QueryAllRateLimitsRequest) (*types.QueryAllRateLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryAllRateLimitsResponse{RateLimits: k.GetAllRateLimits(ctx)}, nil
+}
+
+// RateLimit implements the Query/RateLimit gRPC method
+func (k Keeper) RateLimit(goCtx context.Context, req *types.QueryRateLimitRequest) (*types.QueryRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rateLimit, found := k.GetRateLimit(ctx, req.Denom, req.ChannelId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no rate limit for channel %s and denom %s", req.ChannelId, req.Denom)
+	}
+
+	return &types.QueryRateLimitResponse{RateLimit: &rateLimit}, nil
+}
+
+// RateLimitsByChannel implements the Query/RateLimitsByChannel gRPC method
+func (k Keeper) RateLimitsByChannel(goCtx context.Context, req *types.QueryRateLimitsByChannelRequest) (*types.QueryRateLimitsByChannelResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryRateLimitsByChannelResponse{RateLimits: k.GetRateLimitsByChannel(ctx, req.ChannelId)}, nil
+}
@@ -0,0 +1,194 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	porttypes "github.com/cosmos/ibc-go/v5/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v5/modules/core/exported"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos-builders/chaos/x/ratelimit/types"
+)
+
+var _ porttypes.ICS4Wrapper = Keeper{}
+
+// Keeper is the ratelimit keeper. It is the ICS4Wrapper of the transfer
+// application and checks outgoing packets against their quota.
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
+
+	bankKeeper    types.BankKeeper
+	channelKeeper types.ChannelKeeper
+	ics4Wrapper   porttypes.ICS4Wrapper
+}
+
+// NewKeeper creates a new ratelimit Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	key storetypes.StoreKey,
+	bankKeeper types.BankKeeper,
+	channelKeeper types.ChannelKeeper,
+	ics4Wrapper porttypes.ICS4Wrapper,
+) Keeper {
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      key,
+		bankKeeper:    bankKeeper,
+		channelKeeper: channelKeeper,
+		ics4Wrapper:   ics4Wrapper,
+	}
+}
+
+// Logger returns the application logger, scoped to the associated module
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// SendPacket implements the ICS4Wrapper interface. The packet is rejected if
+// it exceeds the send quota of its channel and denom.
+func (k Keeper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI) error {
+	if err := k.SendRateLimitedPacket(ctx, packet); err != nil {
+		return err
+	}
+
+	return k.ics4Wrapper.SendPacket(ctx, chanCap, packet)
+}
+
+// WriteAcknowledgement implements the ICS4Wrapper interface
+func (k Keeper) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI, ack ibcexported.Acknowledgement) error {
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// GetAppVersion implements the ICS4Wrapper interface
+func (k Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
+// SetRateLimit stores a rate limit.
+func (k Keeper) SetRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.RateLimitKey(rateLimit.Path.Denom, rateLimit.Path.ChannelId), k.cdc.MustMarshal(&rateLimit))
+}
+
+// GetRateLimit returns the rate limit of a channel and denom.
+func (k Keeper) GetRateLimit(ctx sdk.Context, denom, channelID string) (types.RateLimit, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.RateLimitKey(denom, channelID))
+	if bz == nil {
+		return types.RateLimit{}, false
+	}
+
+	var rateLimit types.RateLimit
+	k.cdc.MustUnmarshal(bz, &rateLimit)
+
+	return rateLimit, true
+}
+
+// RemoveRateLimit deletes the rate limit of a channel and denom.
+func (k Keeper) RemoveRateLimit(ctx sdk.Context, denom, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.RateLimitKey(denom, channelID))
+}
+
+// GetAllRateLimits returns every rate limit.
+func (k Keeper) GetAllRateLimits(ctx sdk.Context) []types.RateLimit {
+	return k.getRateLimits(ctx, types.RateLimitKeyPrefix)
+}
+
+// GetRateLimitsByChannel returns the rate limits of a channel.
+func (k Keeper) GetRateLimitsByChannel(ctx sdk.Context, channelID string) []types.RateLimit {
+	return k.getRateLimits(ctx, types.RateLimitChannelPrefix(channelID))
+}
+
+func (k Keeper) getRateLimits(ctx sdk.Context, keyPrefix []byte) []types.RateLimit {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var rateLimits []types.RateLimit
+	for ; iterator.Valid(); iterator.Next() {
+		var rateLimit types.RateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &rateLimit)
+		rateLimits = append(rateLimits, rateLimit)
+	}
+
+	return rateLimits
+}
+
+// SetPendingSendPacket stores a packet sent in the current window of its rate
+// limit, so that its outflow can be undone if it fails.
+func (k Keeper) SetPendingSendPacket(ctx sdk.Context, packet types.PendingSendPacket) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PendingSendPacketKey(packet.ChannelId, packet.Sequence), k.cdc.MustMarshal(&packet))
+}
+
+// GetPendingSendPacket returns a pending send packet.
+func (k Keeper) GetPendingSendPacket(ctx sdk.Context, channelID string, sequence uint64) (types.PendingSendPacket, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PendingSendPacketKey(channelID, sequence))
+	if bz == nil {
+		return types.PendingSendPacket{}, false
+	}
+
+	var packet types.PendingSendPacket
+	k.cdc.MustUnmarshal(bz, &packet)
+
+	return packet, true
+}
+
+// RemovePendingSendPacket deletes a pending send packet.
+func (k Keeper) RemovePendingSendPacket(ctx sdk.Context, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PendingSendPacketKey(channelID, sequence))
+}
+
+// RemovePendingSendPackets deletes the pending send packets of a channel and
+// denom. It is called when their window is over.
+func (k Keeper) RemovePendingSendPackets(ctx sdk.Context, denom, channelID string) {
+	for _, packet := range k.getPendingSendPackets(ctx, types.PendingSendPacketChannelPrefix(channelID)) {
+		if packet.Denom == denom {
+			k.RemovePendingSendPacket(ctx, packet.ChannelId, packet.Sequence)
+		}
+	}
+}
+
+// GetAllPendingSendPackets returns every pending send packet.
+func (k Keeper) GetAllPendingSendPackets(ctx sdk.Context) []types.PendingSendPacket {
+	return k.getPendingSendPackets(ctx, types.PendingSendPacketKeyPrefix)
+}
+
+func (k Keeper) getPendingSendPackets(ctx sdk.Context, keyPrefix []byte) []types.PendingSendPacket {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var packets []types.PendingSendPacket
+	for ; iterator.Valid(); iterator.Next() {
+		var packet types.PendingSendPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &packet)
+		packets = append(packets, packet)
+	}
+
+	return packets
+}
+
+// InitGenesis initializes the ratelimit state from a genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
+	for _, rateLimit := range gs.RateLimits {
+		k.SetRateLimit(ctx, rateLimit)
+	}
+	for _, packet := range gs.PendingSendPackets {
+		k.SetPendingSendPacket(ctx, packet)
+	}
+}
+
+// ExportGenesis exports the ratelimit state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetAllRateLimits(ctx), k.GetAllPendingSendPackets(ctx))
+}
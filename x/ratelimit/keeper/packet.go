@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	ibcexported "github.com/cosmos/ibc-go/v5/modules/core/exported"
+
+	"github.com/cosmos-builders/chaos/x/ratelimit/types"
+)
+
+// SendRateLimitedPacket records the outflow of an outgoing ICS-20 packet and
+// returns an error if it exceeds the send quota of its channel and denom.
+func (k Keeper) SendRateLimitedPacket(ctx sdk.Context, packet ibcexported.PacketI) error {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		// not an ICS-20 packet
+		return nil
+	}
+
+	// the packet denom is the full path of the token sent from this chain
+	denom := transfertypes.ParseDenomTrace(data.Denom).IBCDenom()
+	channelID := packet.GetSourceChannel()
+
+	found, err := k.addFlow(ctx, types.PacketSend, denom, channelID, data.Amount)
+	if err != nil || !found {
+		return err
+	}
+
+	k.SetPendingSendPacket(ctx, types.PendingSendPacket{
+		ChannelId: channelID,
+		Sequence:  packet.GetSequence(),
+		Denom:     denom,
+	})
+
+	return nil
+}
+
+// ReceiveRateLimitedPacket records the inflow of an incoming ICS-20 packet and
+// returns an error if it exceeds the receive quota of its channel and denom.
+func (k Keeper) ReceiveRateLimitedPacket(ctx sdk.Context, packet ibcexported.PacketI) error {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		// not an ICS-20 packet
+		return nil
+	}
+
+	_, err := k.addFlow(ctx, types.PacketRecv, receivedDenom(packet, data.Denom), packet.GetDestChannel(), data.Amount)
+	return err
+}
+
+// AcknowledgeRateLimitedPacket settles a packet sent in the current window.
+// Its outflow is undone if the packet failed on the counterparty chain.
+func (k Keeper) AcknowledgeRateLimitedPacket(ctx sdk.Context, packet ibcexported.PacketI, success bool) error {
+	pending, found := k.GetPendingSendPacket(ctx, packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		// no rate limit or sent in a previous window
+		return nil
+	}
+	k.RemovePendingSendPacket(ctx, pending.ChannelId, pending.Sequence)
+
+	if success {
+		return nil
+	}
+
+	return k.undoSend(ctx, pending, packet)
+}
+
+// TimeoutRateLimitedPacket undoes the outflow of a packet sent in the current
+// window that timed out.
+func (k Keeper) TimeoutRateLimitedPacket(ctx sdk.Context, packet ibcexported.PacketI) error {
+	return k.AcknowledgeRateLimitedPacket(ctx, packet, false)
+}
+
+func (k Keeper) undoSend(ctx sdk.Context, pending types.PendingSendPacket, packet ibcexported.PacketI) error {
+	rateLimit, found := k.GetRateLimit(ctx, pending.Denom, pending.ChannelId)
+	if !found {
+		return nil
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err)
+	}
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return sdkerrors.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount (%s)", data.Amount)
+	}
+
+	rateLimit.Flow.RemoveOutflow(amount)
+	k.SetRateLimit(ctx, rateLimit)
+
+	return nil
+}
+
+// addFlow records amount on the rate limit of a channel and denom. It returns
+// false if the channel and denom are not rate limited.
+func (k Keeper) addFlow(ctx sdk.Context, direction types.PacketDirection, denom, channelID, amountStr string) (bool, error) {
+	rateLimit, found := k.GetRateLimit(ctx, denom, channelID)
+	if !found {
+		return false, nil
+	}
+
+	amount, ok := sdk.NewIntFromString(amountStr)
+	if !ok {
+		return true, sdkerrors.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount (%s)", amountStr)
+	}
+
+	if err := rateLimit.Flow.AddFlow(direction, amount, rateLimit.Quota); err != nil {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeQuotaExceeded,
+				sdk.NewAttribute(types.AttributeKeyDirection, string(direction)),
+				sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+				sdk.NewAttribute(types.AttributeKeyDenom, denom),
+				sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+				sdk.NewAttribute(types.AttributeKeyThreshold, rateLimit.Quota.Threshold(direction, rateLimit.Flow.ChannelValue).String()),
+			),
+		)
+		return true, err
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+
+	return true, nil
+}
+
+// receivedDenom returns the bank denom credited on this chain upon receipt of
+// an ICS-20 packet.
+func receivedDenom(packet ibcexported.PacketI, packetDenom string) string {
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), packetDenom) {
+		voucherPrefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		return transfertypes.ParseDenomTrace(packetDenom[len(voucherPrefix):]).IBCDenom()
+	}
+
+	prefixedDenom := transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), packetDenom)
+	return transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+}
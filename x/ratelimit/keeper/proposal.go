@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos-builders/chaos/x/ratelimit/types"
+)
+
+// HandleAddRateLimitProposal handles an AddRateLimitProposal
+func (k Keeper) HandleAddRateLimitProposal(ctx sdk.Context, p *types.AddRateLimitProposal) error {
+	return k.AddRateLimit(ctx, p.Denom, p.ChannelId, types.NewQuota(p.MaxPercentSend, p.MaxPercentRecv, p.DurationHours))
+}
+
+// HandleUpdateRateLimitProposal handles an UpdateRateLimitProposal
+func (k Keeper) HandleUpdateRateLimitProposal(ctx sdk.Context, p *types.UpdateRateLimitProposal) error {
+	return k.UpdateRateLimit(ctx, p.Denom, p.ChannelId, types.NewQuota(p.MaxPercentSend, p.MaxPercentRecv, p.DurationHours))
+}
+
+// HandleRemoveRateLimitProposal handles a RemoveRateLimitProposal
+func (k Keeper) HandleRemoveRateLimitProposal(ctx sdk.Context, p *types.RemoveRateLimitProposal) error {
+	return k.DeleteRateLimit(ctx, p.Denom, p.ChannelId)
+}
+
+// HandleResetRateLimitProposal handles a ResetRateLimitProposal
+func (k Keeper) HandleResetRateLimitProposal(ctx sdk.Context, p *types.ResetRateLimitProposal) error {
+	return k.ResetRateLimit(ctx, p.Denom, p.ChannelId)
+}
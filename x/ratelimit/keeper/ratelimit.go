@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+
+	"github.com/cosmos-builders/chaos/x/ratelimit/types"
+)
+
+// AddRateLimit rate limits a channel and denom. The channel value of the first
+// window is the current supply of the denom.
+func (k Keeper) AddRateLimit(ctx sdk.Context, denom, channelID string, quota types.Quota) error {
+	if _, found := k.GetRateLimit(ctx, denom, channelID); found {
+		return sdkerrors.Wrapf(types.ErrRateLimitAlreadyExists, "channel %s, denom %s", channelID, denom)
+	}
+	if _, found := k.channelKeeper.GetChannel(ctx, transfertypes.PortID, channelID); !found {
+		return sdkerrors.Wrapf(types.ErrChannelNotFound, "channel %s", channelID)
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, denom)
+	if supply.IsZero() {
+		return sdkerrors.Wrapf(types.ErrZeroChannelValue, "denom %s has no supply", denom)
+	}
+
+	k.SetRateLimit(ctx, types.NewRateLimit(denom, channelID, quota, supply.Amount, ctx.BlockTime()))
+
+	return nil
+}
+
+// UpdateRateLimit changes the quota of a rate limit and starts a new window.
+func (k Keeper) UpdateRateLimit(ctx sdk.Context, denom, channelID string, quota types.Quota) error {
+	rateLimit, found := k.GetRateLimit(ctx, denom, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrRateLimitNotFound, "channel %s, denom %s", channelID, denom)
+	}
+
+	rateLimit.Quota = quota
+	k.SetRateLimit(ctx, rateLimit)
+
+	return k.ResetRateLimit(ctx, denom, channelID)
+}
+
+// DeleteRateLimit stops rate limiting a channel and denom.
+func (k Keeper) DeleteRateLimit(ctx sdk.Context, denom, channelID string) error {
+	if _, found := k.GetRateLimit(ctx, denom, channelID); !found {
+		return sdkerrors.Wrapf(types.ErrRateLimitNotFound, "channel %s, denom %s", channelID, denom)
+	}
+
+	k.RemoveRateLimit(ctx, denom, channelID)
+	k.RemovePendingSendPackets(ctx, denom, channelID)
+
+	return nil
+}
+
+// ResetRateLimit starts a new window for a rate limit: the flow is cleared
+// and the channel value is set to the current supply of the denom. A denom
+// without supply blocks the path until the next window.
+func (k Keeper) ResetRateLimit(ctx sdk.Context, denom, channelID string) error {
+	rateLimit, found := k.GetRateLimit(ctx, denom, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrRateLimitNotFound, "channel %s, denom %s", channelID, denom)
+	}
+
+	rateLimit.Flow = types.NewFlow(k.bankKeeper.GetSupply(ctx, denom).Amount)
+	rateLimit.WindowStart = ctx.BlockTime()
+	k.SetRateLimit(ctx, rateLimit)
+
+	// packets of the previous window no longer count against the quota
+	k.RemovePendingSendPackets(ctx, denom, channelID)
+
+	return nil
+}
+
+// BeginBlocker starts a new window for every rate limit whose window is over.
+func (k Keeper) BeginBlocker(ctx sdk.Context) {
+	for _, rateLimit := range k.GetAllRateLimits(ctx) {
+		if !rateLimit.WindowExpired(ctx.BlockTime()) {
+			continue
+		}
+
+		if err := k.ResetRateLimit(ctx, rateLimit.Path.Denom, rateLimit.Path.ChannelId); err != nil {
+			k.Logger(ctx).Error("failed to reset rate limit", "channel", rateLimit.Path.ChannelId, "denom", rateLimit.Path.Denom, "error", err)
+		}
+	}
+}
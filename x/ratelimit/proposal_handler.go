@@ -0,0 +1,29 @@
+package ratelimit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/cosmos-builders/chaos/x/ratelimit/keeper"
+	"github.com/cosmos-builders/chaos/x/ratelimit/types"
+)
+
+// NewRateLimitProposalHandler defines the ratelimit proposal handler
+func NewRateLimitProposalHandler(k keeper.Keeper) govv1beta1.Handler {
+	return func(ctx sdk.Context, content govv1beta1.Content) error {
+		switch c := content.(type) {
+		case *types.AddRateLimitProposal:
+			return k.HandleAddRateLimitProposal(ctx, c)
+		case *types.UpdateRateLimitProposal:
+			return k.HandleUpdateRateLimitProposal(ctx, c)
+		case *types.RemoveRateLimitProposal:
+			return k.HandleRemoveRateLimitProposal(ctx, c)
+		case *types.ResetRateLimitProposal:
+			return k.HandleResetRateLimitProposal(ctx, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized ratelimit proposal content type: %T", c)
+		}
+	}
+}
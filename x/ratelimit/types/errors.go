@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ratelimit sentinel errors
+var (
+	ErrRateLimitAlreadyExists = sdkerrors.Register(ModuleName, 2, "rate limit already exists")
+	ErrRateLimitNotFound      = sdkerrors.Register(ModuleName, 3, "rate limit not found")
+	ErrInvalidQuota           = sdkerrors.Register(ModuleName, 4, "invalid quota")
+	ErrQuotaExceeded          = sdkerrors.Register(ModuleName, 5, "quota exceeded")
+	ErrChannelNotFound        = sdkerrors.Register(ModuleName, 6, "channel not found")
+	ErrZeroChannelValue       = sdkerrors.Register(ModuleName, 7, "channel value is zero")
+)
@@ -0,0 +1,12 @@
+package types
+
+// ratelimit events
+const (
+	EventTypeQuotaExceeded = "rate_limit_quota_exceeded"
+
+	AttributeKeyDirection = "direction"
+	AttributeKeyChannelID = "channel_id"
+	AttributeKeyDenom     = "denom"
+	AttributeKeyAmount    = "amount"
+	AttributeKeyThreshold = "threshold"
+)
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"fmt"
+)
+
+// DefaultGenesis returns the default ratelimit genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{}
+}
+
+// NewGenesisState creates a new ratelimit GenesisState
+func NewGenesisState(rateLimits []RateLimit, pendingSendPackets []PendingSendPacket) *GenesisState {
+	return &GenesisState{
+		RateLimits:         rateLimits,
+		PendingSendPackets: pendingSendPackets,
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	paths := make(map[string]bool)
+	for _, rateLimit := range gs.RateLimits {
+		if err := validatePath(rateLimit.Path.Denom, rateLimit.Path.ChannelId); err != nil {
+			return err
+		}
+		if err := rateLimit.Quota.Validate(); err != nil {
+			return err
+		}
+		if rateLimit.Flow.Inflow.IsNil() || rateLimit.Flow.Outflow.IsNil() || rateLimit.Flow.ChannelValue.IsNil() {
+			return fmt.Errorf("flow of rate limit %s/%s is not set", rateLimit.Path.ChannelId, rateLimit.Path.Denom)
+		}
+
+		key := string(RateLimitKey(rateLimit.Path.Denom, rateLimit.Path.ChannelId))
+		if paths[key] {
+			return fmt.Errorf("duplicate rate limit %s/%s", rateLimit.Path.ChannelId, rateLimit.Path.Denom)
+		}
+		paths[key] = true
+	}
+
+	packets := make(map[string]bool)
+	for _, packet := range gs.PendingSendPackets {
+		if err := validatePath(packet.Denom, packet.ChannelId); err != nil {
+			return err
+		}
+
+		key := string(PendingSendPacketKey(packet.ChannelId, packet.Sequence))
+		if packets[key] {
+			return fmt.Errorf("duplicate pending send packet %s/%d", packet.ChannelId, packet.Sequence)
+		}
+		packets[key] = true
+	}
+
+	return nil
+}
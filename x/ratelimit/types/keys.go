@@ -0,0 +1,49 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the IBC rate limit module name
+	ModuleName = "ratelimit"
+
+	// StoreKey is the store key string for the ratelimit module
+	StoreKey = ModuleName
+
+	// RouterKey is the message and proposal route for the ratelimit module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the ratelimit module
+	QuerierRoute = ModuleName
+)
+
+var (
+	// RateLimitKeyPrefix is the prefix of the rate limit store
+	RateLimitKeyPrefix = []byte{0x01}
+
+	// PendingSendPacketKeyPrefix is the prefix of the pending send packet store
+	PendingSendPacketKeyPrefix = []byte{0x02}
+)
+
+// RateLimitChannelPrefix returns the store key prefix of the rate limits of a
+// channel.
+func RateLimitChannelPrefix(channelID string) []byte {
+	return append(append(RateLimitKeyPrefix, []byte(channelID)...), '/')
+}
+
+// RateLimitKey returns the store key of the rate limit of a channel and denom.
+func RateLimitKey(denom, channelID string) []byte {
+	return append(RateLimitChannelPrefix(channelID), []byte(denom)...)
+}
+
+// PendingSendPacketChannelPrefix returns the store key prefix of the pending
+// send packets of a channel.
+func PendingSendPacketChannelPrefix(channelID string) []byte {
+	return append(append(PendingSendPacketKeyPrefix, []byte(channelID)...), '/')
+}
+
+// PendingSendPacketKey returns the store key of a pending send packet.
+func PendingSendPacketKey(channelID string, sequence uint64) []byte {
+	return append(PendingSendPacketChannelPrefix(channelID), sdk.Uint64ToBigEndian(sequence)...)
+}
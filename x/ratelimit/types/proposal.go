@@ -0,0 +1,228 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+)
+
+const (
+	// ProposalTypeAddRateLimit defines the type for an AddRateLimitProposal
+	ProposalTypeAddRateLimit = "AddRateLimit"
+	// ProposalTypeUpdateRateLimit defines the type for an UpdateRateLimitProposal
+	ProposalTypeUpdateRateLimit = "UpdateRateLimit"
+	// ProposalTypeRemoveRateLimit defines the type for a RemoveRateLimitProposal
+	ProposalTypeRemoveRateLimit = "RemoveRateLimit"
+	// ProposalTypeResetRateLimit defines the type for a ResetRateLimitProposal
+	ProposalTypeResetRateLimit = "ResetRateLimit"
+)
+
+var (
+	_ govv1beta1.Content = &AddRateLimitProposal{}
+	_ govv1beta1.Content = &UpdateRateLimitProposal{}
+	_ govv1beta1.Content = &RemoveRateLimitProposal{}
+	_ govv1beta1.Content = &ResetRateLimitProposal{}
+)
+
+func init() {
+	govv1beta1.RegisterProposalType(ProposalTypeAddRateLimit)
+	govv1beta1.RegisterProposalType(ProposalTypeUpdateRateLimit)
+	govv1beta1.RegisterProposalType(ProposalTypeRemoveRateLimit)
+	govv1beta1.RegisterProposalType(ProposalTypeResetRateLimit)
+}
+
+// NewAddRateLimitProposal creates a new AddRateLimitProposal.
+func NewAddRateLimitProposal(title, description, denom, channelID string, maxPercentSend, maxPercentRecv sdk.Int, durationHours uint64) govv1beta1.Content {
+	return &AddRateLimitProposal{
+		Title:          title,
+		Description:    description,
+		Denom:          denom,
+		ChannelId:      channelID,
+		MaxPercentSend: maxPercentSend,
+		MaxPercentRecv: maxPercentRecv,
+		DurationHours:  durationHours,
+	}
+}
+
+// GetTitle returns the title of an add rate limit proposal.
+func (p *AddRateLimitProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an add rate limit proposal.
+func (p *AddRateLimitProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an add rate limit proposal.
+func (p *AddRateLimitProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an add rate limit proposal.
+func (p *AddRateLimitProposal) ProposalType() string { return ProposalTypeAddRateLimit }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *AddRateLimitProposal) ValidateBasic() error {
+	if err := govv1beta1.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if err := validatePath(p.Denom, p.ChannelId); err != nil {
+		return err
+	}
+
+	return NewQuota(p.MaxPercentSend, p.MaxPercentRecv, p.DurationHours).Validate()
+}
+
+// String implements the Stringer interface.
+func (p AddRateLimitProposal) String() string {
+	return fmt.Sprintf(`Add Rate Limit Proposal:
+  Title:            %s
+  Description:      %s
+  Denom:            %s
+  Channel:          %s
+  Max Percent Send: %s
+  Max Percent Recv: %s
+  Duration Hours:   %d
+`, p.Title, p.Description, p.Denom, p.ChannelId, p.MaxPercentSend, p.MaxPercentRecv, p.DurationHours)
+}
+
+// NewUpdateRateLimitProposal creates a new UpdateRateLimitProposal.
+func NewUpdateRateLimitProposal(title, description, denom, channelID string, maxPercentSend, maxPercentRecv sdk.Int, durationHours uint64) govv1beta1.Content {
+	return &UpdateRateLimitProposal{
+		Title:          title,
+		Description:    description,
+		Denom:          denom,
+		ChannelId:      channelID,
+		MaxPercentSend: maxPercentSend,
+		MaxPercentRecv: maxPercentRecv,
+		DurationHours:  durationHours,
+	}
+}
+
+// GetTitle returns the title of an update rate limit proposal.
+func (p *UpdateRateLimitProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an update rate limit proposal.
+func (p *UpdateRateLimitProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an update rate limit proposal.
+func (p *UpdateRateLimitProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an update rate limit proposal.
+func (p *UpdateRateLimitProposal) ProposalType() string { return ProposalTypeUpdateRateLimit }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *UpdateRateLimitProposal) ValidateBasic() error {
+	if err := govv1beta1.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if err := validatePath(p.Denom, p.ChannelId); err != nil {
+		return err
+	}
+
+	return NewQuota(p.MaxPercentSend, p.MaxPercentRecv, p.DurationHours).Validate()
+}
+
+// String implements the Stringer interface.
+func (p UpdateRateLimitProposal) String() string {
+	return fmt.Sprintf(`Update Rate Limit Proposal:
+  Title:            %s
+  Description:      %s
+  Denom:            %s
+  Channel:          %s
+  Max Percent Send: %s
+  Max Percent Recv: %s
+  Duration Hours:   %d
+`, p.Title, p.Description, p.Denom, p.ChannelId, p.MaxPercentSend, p.MaxPercentRecv, p.DurationHours)
+}
+
+// NewRemoveRateLimitProposal creates a new RemoveRateLimitProposal.
+func NewRemoveRateLimitProposal(title, description, denom, channelID string) govv1beta1.Content {
+	return &RemoveRateLimitProposal{
+		Title:       title,
+		Description: description,
+		Denom:       denom,
+		ChannelId:   channelID,
+	}
+}
+
+// GetTitle returns the title of a remove rate limit proposal.
+func (p *RemoveRateLimitProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a remove rate limit proposal.
+func (p *RemoveRateLimitProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a remove rate limit proposal.
+func (p *RemoveRateLimitProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a remove rate limit proposal.
+func (p *RemoveRateLimitProposal) ProposalType() string { return ProposalTypeRemoveRateLimit }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *RemoveRateLimitProposal) ValidateBasic() error {
+	if err := govv1beta1.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	return validatePath(p.Denom, p.ChannelId)
+}
+
+// String implements the Stringer interface.
+func (p RemoveRateLimitProposal) String() string {
+	return fmt.Sprintf(`Remove Rate Limit Proposal:
+  Title:       %s
+  Description: %s
+  Denom:       %s
+  Channel:     %s
+`, p.Title, p.Description, p.Denom, p.ChannelId)
+}
+
+// NewResetRateLimitProposal creates a new ResetRateLimitProposal.
+func NewResetRateLimitProposal(title, description, denom, channelID string) govv1beta1.Content {
+	return &ResetRateLimitProposal{
+		Title:       title,
+		Description: description,
+		Denom:       denom,
+		ChannelId:   channelID,
+	}
+}
+
+// GetTitle returns the title of a reset rate limit proposal.
+func (p *ResetRateLimitProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a reset rate limit proposal.
+func (p *ResetRateLimitProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a reset rate limit proposal.
+func (p *ResetRateLimitProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a reset rate limit proposal.
+func (p *ResetRateLimitProposal) ProposalType() string { return ProposalTypeResetRateLimit }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *ResetRateLimitProposal) ValidateBasic() error {
+	if err := govv1beta1.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	return validatePath(p.Denom, p.ChannelId)
+}
+
+// String implements the Stringer interface.
+func (p ResetRateLimitProposal) String() string {
+	return fmt.Sprintf(`Reset Rate Limit Proposal:
+  Title:       %s
+  Description: %s
+  Denom:       %s
+  Channel:     %s
+`, p.Title, p.Description, p.Denom, p.ChannelId)
+}
+
+func validatePath(denom, channelID string) error {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return err
+	}
+	if err := host.ChannelIdentifierValidator(channelID); err != nil {
+		return sdkerrors.Wrapf(err, "invalid channel")
+	}
+
+	return nil
+}
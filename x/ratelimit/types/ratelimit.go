@@ -0,0 +1,115 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// PacketDirection is the direction of a rate limited packet.
+type PacketDirection string
+
+const (
+	PacketSend PacketDirection = "send"
+	PacketRecv PacketDirection = "recv"
+)
+
+// NewQuota creates a new Quota.
+func NewQuota(maxPercentSend, maxPercentRecv sdk.Int, durationHours uint64) Quota {
+	return Quota{
+		MaxPercentSend: maxPercentSend,
+		MaxPercentRecv: maxPercentRecv,
+		DurationHours:  durationHours,
+	}
+}
+
+// Validate performs a stateless validation of the quota. A percentage of zero
+// blocks the direction, but at least one direction must be allowed.
+func (q Quota) Validate() error {
+	hundred := sdk.NewInt(100)
+	if q.MaxPercentSend.IsNil() || q.MaxPercentSend.IsNegative() || q.MaxPercentSend.GT(hundred) {
+		return sdkerrors.Wrapf(ErrInvalidQuota, "max percent send must be between 0 and 100, got %s", q.MaxPercentSend)
+	}
+	if q.MaxPercentRecv.IsNil() || q.MaxPercentRecv.IsNegative() || q.MaxPercentRecv.GT(hundred) {
+		return sdkerrors.Wrapf(ErrInvalidQuota, "max percent recv must be between 0 and 100, got %s", q.MaxPercentRecv)
+	}
+	if q.MaxPercentSend.IsZero() && q.MaxPercentRecv.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidQuota, "max percent send and max percent recv cannot both be zero")
+	}
+	if q.DurationHours == 0 {
+		return sdkerrors.Wrap(ErrInvalidQuota, "duration cannot be zero")
+	}
+
+	return nil
+}
+
+// Duration returns the window length of the quota.
+func (q Quota) Duration() time.Duration {
+	return time.Duration(q.DurationHours) * time.Hour
+}
+
+// Threshold returns the maximum net flow allowed in a direction.
+func (q Quota) Threshold(direction PacketDirection, channelValue sdk.Int) sdk.Int {
+	maxPercent := q.MaxPercentRecv
+	if direction == PacketSend {
+		maxPercent = q.MaxPercentSend
+	}
+
+	return channelValue.Mul(maxPercent).QuoRaw(100)
+}
+
+// NewFlow creates a new, empty Flow.
+func NewFlow(channelValue sdk.Int) Flow {
+	return Flow{
+		Inflow:       sdk.ZeroInt(),
+		Outflow:      sdk.ZeroInt(),
+		ChannelValue: channelValue,
+	}
+}
+
+// AddFlow records amount in the given direction and returns an error if the
+// net flow in that direction then exceeds the quota.
+func (f *Flow) AddFlow(direction PacketDirection, amount sdk.Int, quota Quota) error {
+	var netFlow sdk.Int
+	switch direction {
+	case PacketSend:
+		f.Outflow = f.Outflow.Add(amount)
+		netFlow = f.Outflow.Sub(f.Inflow)
+	case PacketRecv:
+		f.Inflow = f.Inflow.Add(amount)
+		netFlow = f.Inflow.Sub(f.Outflow)
+	default:
+		return fmt.Errorf("unknown packet direction %s", direction)
+	}
+
+	if threshold := quota.Threshold(direction, f.ChannelValue); netFlow.GT(threshold) {
+		return sdkerrors.Wrapf(ErrQuotaExceeded, "net %s flow %s exceeds threshold %s", direction, netFlow, threshold)
+	}
+
+	return nil
+}
+
+// RemoveOutflow undoes the outflow of a packet that failed.
+func (f *Flow) RemoveOutflow(amount sdk.Int) {
+	f.Outflow = f.Outflow.Sub(amount)
+	if f.Outflow.IsNegative() {
+		f.Outflow = sdk.ZeroInt()
+	}
+}
+
+// NewRateLimit creates a new RateLimit with an empty flow.
+func NewRateLimit(denom, channelID string, quota Quota, channelValue sdk.Int, windowStart time.Time) RateLimit {
+	return RateLimit{
+		Path:        Path{Denom: denom, ChannelId: channelID},
+		Quota:       quota,
+		Flow:        NewFlow(channelValue),
+		WindowStart: windowStart,
+	}
+}
+
+// WindowExpired returns true if the current window of the rate limit is over.
+func (r RateLimit) WindowExpired(now time.Time) bool {
+	return !now.Before(r.WindowStart.Add(r.Quota.Duration()))
+}